@@ -22,6 +22,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NotificationOptOut_Category int32
+
+const (
+	NotificationOptOut_BUILD      NotificationOptOut_Category = 0 // test results for a new submission
+	NotificationOptOut_SUBMISSION NotificationOptOut_Category = 1 // submission approved, rejected, or sent back for revision
+	NotificationOptOut_RELEASE    NotificationOptOut_Category = 2 // reviews and scores released
+	NotificationOptOut_GROUP      NotificationOptOut_Category = 3 // group approved
+	NotificationOptOut_ENROLLMENT NotificationOptOut_Category = 4 // enrollment accepted, changed or rejected
+)
+
+// Enum value maps for NotificationOptOut_Category.
+var (
+	NotificationOptOut_Category_name = map[int32]string{
+		0: "BUILD",
+		1: "SUBMISSION",
+		2: "RELEASE",
+		3: "GROUP",
+		4: "ENROLLMENT",
+	}
+	NotificationOptOut_Category_value = map[string]int32{
+		"BUILD":      0,
+		"SUBMISSION": 1,
+		"RELEASE":    2,
+		"GROUP":      3,
+		"ENROLLMENT": 4,
+	}
+)
+
+func (x NotificationOptOut_Category) Enum() *NotificationOptOut_Category {
+	p := new(NotificationOptOut_Category)
+	*p = x
+	return p
+}
+
+func (x NotificationOptOut_Category) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationOptOut_Category) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationOptOut_Category) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[0]
+}
+
+func (x NotificationOptOut_Category) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationOptOut_Category.Descriptor instead.
+func (NotificationOptOut_Category) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type ChatIntegration_Platform int32
+
+const (
+	ChatIntegration_DISCORD    ChatIntegration_Platform = 0
+	ChatIntegration_SLACK      ChatIntegration_Platform = 1
+	ChatIntegration_MATTERMOST ChatIntegration_Platform = 2
+)
+
+// Enum value maps for ChatIntegration_Platform.
+var (
+	ChatIntegration_Platform_name = map[int32]string{
+		0: "DISCORD",
+		1: "SLACK",
+		2: "MATTERMOST",
+	}
+	ChatIntegration_Platform_value = map[string]int32{
+		"DISCORD":    0,
+		"SLACK":      1,
+		"MATTERMOST": 2,
+	}
+)
+
+func (x ChatIntegration_Platform) Enum() *ChatIntegration_Platform {
+	p := new(ChatIntegration_Platform)
+	*p = x
+	return p
+}
+
+func (x ChatIntegration_Platform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatIntegration_Platform) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[1].Descriptor()
+}
+
+func (ChatIntegration_Platform) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[1]
+}
+
+func (x ChatIntegration_Platform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatIntegration_Platform.Descriptor instead.
+func (ChatIntegration_Platform) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type Group_GroupStatus int32
 
 const (
@@ -52,11 +156,11 @@ func (x Group_GroupStatus) String() string {
 }
 
 func (Group_GroupStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[0].Descriptor()
+	return file_ag_ag_proto_enumTypes[2].Descriptor()
 }
 
 func (Group_GroupStatus) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[0]
+	return &file_ag_ag_proto_enumTypes[2]
 }
 
 func (x Group_GroupStatus) Number() protoreflect.EnumNumber {
@@ -65,7 +169,194 @@ func (x Group_GroupStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Group_GroupStatus.Descriptor instead.
 func (Group_GroupStatus) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{3, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type Course_SlipDayUnit int32
+
+const (
+	Course_DAYS  Course_SlipDayUnit = 0 // slip days are counted in started days after the deadline
+	Course_HOURS Course_SlipDayUnit = 1 // slip days are counted in started hours after the deadline
+)
+
+// Enum value maps for Course_SlipDayUnit.
+var (
+	Course_SlipDayUnit_name = map[int32]string{
+		0: "DAYS",
+		1: "HOURS",
+	}
+	Course_SlipDayUnit_value = map[string]int32{
+		"DAYS":  0,
+		"HOURS": 1,
+	}
+)
+
+func (x Course_SlipDayUnit) Enum() *Course_SlipDayUnit {
+	p := new(Course_SlipDayUnit)
+	*p = x
+	return p
+}
+
+func (x Course_SlipDayUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_SlipDayUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[3].Descriptor()
+}
+
+func (Course_SlipDayUnit) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[3]
+}
+
+func (x Course_SlipDayUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_SlipDayUnit.Descriptor instead.
+func (Course_SlipDayUnit) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type Course_GroupSlipDays int32
+
+const (
+	Course_PER_MEMBER Course_GroupSlipDays = 0 // each group member uses the slip days of a group submission
+	Course_SHARED     Course_GroupSlipDays = 1 // the slip days of a group submission are divided between the group members
+)
+
+// Enum value maps for Course_GroupSlipDays.
+var (
+	Course_GroupSlipDays_name = map[int32]string{
+		0: "PER_MEMBER",
+		1: "SHARED",
+	}
+	Course_GroupSlipDays_value = map[string]int32{
+		"PER_MEMBER": 0,
+		"SHARED":     1,
+	}
+)
+
+func (x Course_GroupSlipDays) Enum() *Course_GroupSlipDays {
+	p := new(Course_GroupSlipDays)
+	*p = x
+	return p
+}
+
+func (x Course_GroupSlipDays) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_GroupSlipDays) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[4].Descriptor()
+}
+
+func (Course_GroupSlipDays) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[4]
+}
+
+func (x Course_GroupSlipDays) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_GroupSlipDays.Descriptor instead.
+func (Course_GroupSlipDays) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30, 1}
+}
+
+type Course_SubmissionMode int32
+
+const (
+	Course_PUSH         Course_SubmissionMode = 0 // pushes to the default branch are tested and submitted
+	Course_PULL_REQUEST Course_SubmissionMode = 1 // pull requests against the default branch are tested and submitted; approval merges them
+)
+
+// Enum value maps for Course_SubmissionMode.
+var (
+	Course_SubmissionMode_name = map[int32]string{
+		0: "PUSH",
+		1: "PULL_REQUEST",
+	}
+	Course_SubmissionMode_value = map[string]int32{
+		"PUSH":         0,
+		"PULL_REQUEST": 1,
+	}
+)
+
+func (x Course_SubmissionMode) Enum() *Course_SubmissionMode {
+	p := new(Course_SubmissionMode)
+	*p = x
+	return p
+}
+
+func (x Course_SubmissionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_SubmissionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[5].Descriptor()
+}
+
+func (Course_SubmissionMode) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[5]
+}
+
+func (x Course_SubmissionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_SubmissionMode.Descriptor instead.
+func (Course_SubmissionMode) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30, 2}
+}
+
+type Course_SubmissionTrigger int32
+
+const (
+	Course_CHANGED_FILES  Course_SubmissionTrigger = 0 // assignments are tested when files in their directories are changed
+	Course_TAG            Course_SubmissionTrigger = 1 // assignments are tested when a tag named <assignment>-submit is pushed
+	Course_COMMIT_MESSAGE Course_SubmissionTrigger = 2 // assignments are tested when a commit message contains [submit <assignment>]
+)
+
+// Enum value maps for Course_SubmissionTrigger.
+var (
+	Course_SubmissionTrigger_name = map[int32]string{
+		0: "CHANGED_FILES",
+		1: "TAG",
+		2: "COMMIT_MESSAGE",
+	}
+	Course_SubmissionTrigger_value = map[string]int32{
+		"CHANGED_FILES":  0,
+		"TAG":            1,
+		"COMMIT_MESSAGE": 2,
+	}
+)
+
+func (x Course_SubmissionTrigger) Enum() *Course_SubmissionTrigger {
+	p := new(Course_SubmissionTrigger)
+	*p = x
+	return p
+}
+
+func (x Course_SubmissionTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_SubmissionTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[6].Descriptor()
+}
+
+func (Course_SubmissionTrigger) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[6]
+}
+
+func (x Course_SubmissionTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_SubmissionTrigger.Descriptor instead.
+func (Course_SubmissionTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30, 3}
 }
 
 type Repository_Type int32
@@ -110,11 +401,11 @@ func (x Repository_Type) String() string {
 }
 
 func (Repository_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[1].Descriptor()
+	return file_ag_ag_proto_enumTypes[7].Descriptor()
 }
 
 func (Repository_Type) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[1]
+	return &file_ag_ag_proto_enumTypes[7]
 }
 
 func (x Repository_Type) Number() protoreflect.EnumNumber {
@@ -123,7 +414,7 @@ func (x Repository_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Repository_Type.Descriptor instead.
 func (Repository_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{7, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type Enrollment_UserStatus int32
@@ -133,6 +424,7 @@ const (
 	Enrollment_PENDING Enrollment_UserStatus = 1
 	Enrollment_STUDENT Enrollment_UserStatus = 2
 	Enrollment_TEACHER Enrollment_UserStatus = 3
+	Enrollment_TA      Enrollment_UserStatus = 4 // teaching assistant; can view, review and rebuild submissions, but not manage the course
 )
 
 // Enum value maps for Enrollment_UserStatus.
@@ -142,12 +434,14 @@ var (
 		1: "PENDING",
 		2: "STUDENT",
 		3: "TEACHER",
+		4: "TA",
 	}
 	Enrollment_UserStatus_value = map[string]int32{
 		"NONE":    0,
 		"PENDING": 1,
 		"STUDENT": 2,
 		"TEACHER": 3,
+		"TA":      4,
 	}
 )
 
@@ -162,11 +456,11 @@ func (x Enrollment_UserStatus) String() string {
 }
 
 func (Enrollment_UserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[2].Descriptor()
+	return file_ag_ag_proto_enumTypes[8].Descriptor()
 }
 
 func (Enrollment_UserStatus) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[2]
+	return &file_ag_ag_proto_enumTypes[8]
 }
 
 func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
@@ -175,7 +469,7 @@ func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Enrollment_UserStatus.Descriptor instead.
 func (Enrollment_UserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{8, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{33, 0}
 }
 
 type Enrollment_DisplayState int32
@@ -214,11 +508,11 @@ func (x Enrollment_DisplayState) String() string {
 }
 
 func (Enrollment_DisplayState) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[3].Descriptor()
+	return file_ag_ag_proto_enumTypes[9].Descriptor()
 }
 
 func (Enrollment_DisplayState) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[3]
+	return &file_ag_ag_proto_enumTypes[9]
 }
 
 func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
@@ -227,7 +521,105 @@ func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Enrollment_DisplayState.Descriptor instead.
 func (Enrollment_DisplayState) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{8, 1}
+	return file_ag_ag_proto_rawDescGZIP(), []int{33, 1}
+}
+
+type SlipDayLedgerEntry_Source int32
+
+const (
+	SlipDayLedgerEntry_AUTOMATIC SlipDayLedgerEntry_Source = 0 // slip days updated by a test run
+	SlipDayLedgerEntry_MANUAL    SlipDayLedgerEntry_Source = 1 // slip days adjusted by a teacher
+)
+
+// Enum value maps for SlipDayLedgerEntry_Source.
+var (
+	SlipDayLedgerEntry_Source_name = map[int32]string{
+		0: "AUTOMATIC",
+		1: "MANUAL",
+	}
+	SlipDayLedgerEntry_Source_value = map[string]int32{
+		"AUTOMATIC": 0,
+		"MANUAL":    1,
+	}
+)
+
+func (x SlipDayLedgerEntry_Source) Enum() *SlipDayLedgerEntry_Source {
+	p := new(SlipDayLedgerEntry_Source)
+	*p = x
+	return p
+}
+
+func (x SlipDayLedgerEntry_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlipDayLedgerEntry_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[10].Descriptor()
+}
+
+func (SlipDayLedgerEntry_Source) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[10]
+}
+
+func (x SlipDayLedgerEntry_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlipDayLedgerEntry_Source.Descriptor instead.
+func (SlipDayLedgerEntry_Source) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type Assignment_LatePolicy int32
+
+const (
+	Assignment_NONE    Assignment_LatePolicy = 0 // late submissions only consume slip days
+	Assignment_PENALTY Assignment_LatePolicy = 1 // score is reduced by latePenalty percent for each day late
+	Assignment_CUTOFF  Assignment_LatePolicy = 2 // submissions more than lateDays days late get zero score
+	Assignment_NO_LATE Assignment_LatePolicy = 3 // submissions after the deadline get zero score
+)
+
+// Enum value maps for Assignment_LatePolicy.
+var (
+	Assignment_LatePolicy_name = map[int32]string{
+		0: "NONE",
+		1: "PENALTY",
+		2: "CUTOFF",
+		3: "NO_LATE",
+	}
+	Assignment_LatePolicy_value = map[string]int32{
+		"NONE":    0,
+		"PENALTY": 1,
+		"CUTOFF":  2,
+		"NO_LATE": 3,
+	}
+)
+
+func (x Assignment_LatePolicy) Enum() *Assignment_LatePolicy {
+	p := new(Assignment_LatePolicy)
+	*p = x
+	return p
+}
+
+func (x Assignment_LatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Assignment_LatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[11].Descriptor()
+}
+
+func (Assignment_LatePolicy) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[11]
+}
+
+func (x Assignment_LatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Assignment_LatePolicy.Descriptor instead.
+func (Assignment_LatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type Submission_Status int32
@@ -266,11 +658,11 @@ func (x Submission_Status) String() string {
 }
 
 func (Submission_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[4].Descriptor()
+	return file_ag_ag_proto_enumTypes[12].Descriptor()
 }
 
 func (Submission_Status) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[4]
+	return &file_ag_ag_proto_enumTypes[12]
 }
 
 func (x Submission_Status) Number() protoreflect.EnumNumber {
@@ -279,7 +671,7 @@ func (x Submission_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Submission_Status.Descriptor instead.
 func (Submission_Status) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{47, 0}
 }
 
 type GradingCriterion_Grade int32
@@ -315,11 +707,11 @@ func (x GradingCriterion_Grade) String() string {
 }
 
 func (GradingCriterion_Grade) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[5].Descriptor()
+	return file_ag_ag_proto_enumTypes[13].Descriptor()
 }
 
 func (GradingCriterion_Grade) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[5]
+	return &file_ag_ag_proto_enumTypes[13]
 }
 
 func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
@@ -328,7 +720,7 @@ func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GradingCriterion_Grade.Descriptor instead.
 func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{53, 0}
 }
 
 type SubmissionsForCourseRequest_Type int32
@@ -364,11 +756,11 @@ func (x SubmissionsForCourseRequest_Type) String() string {
 }
 
 func (SubmissionsForCourseRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[6].Descriptor()
+	return file_ag_ag_proto_enumTypes[14].Descriptor()
 }
 
 func (SubmissionsForCourseRequest_Type) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[6]
+	return &file_ag_ag_proto_enumTypes[14]
 }
 
 func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
@@ -377,7 +769,7 @@ func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubmissionsForCourseRequest_Type.Descriptor instead.
 func (SubmissionsForCourseRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{96, 0}
 }
 
 type User struct {
@@ -538,20 +930,26 @@ func (x *Users) GetUsers() []*User {
 	return nil
 }
 
-type RemoteIdentity struct {
+// APIToken is a personal token that authenticates gRPC requests from scripts and tools as the token's user.
+// Only the hash of the token is stored; the token itself is only returned when the token is created.
+type APIToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Provider    string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
-	RemoteID    uint64 `protobuf:"varint,3,opt,name=remoteID,proto3" json:"remoteID,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
-	AccessToken string `protobuf:"bytes,4,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	UserID      uint64 `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`
-}
-
-func (x *RemoteIdentity) Reset() {
-	*x = RemoteIdentity{}
+	UserID      uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty" gorm:"index"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CourseID    uint64 `protobuf:"varint,4,opt,name=courseID,proto3" json:"courseID,omitempty"` // the token can only access this course; zero for all courses
+	ReadOnly    bool   `protobuf:"varint,5,opt,name=readOnly,proto3" json:"readOnly,omitempty"` // the token can only call methods that do not change anything
+	CreatedDate string `protobuf:"bytes,6,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	ExpiryDate  string `protobuf:"bytes,7,opt,name=expiryDate,proto3" json:"expiryDate,omitempty"`
+	Hash        string `protobuf:"bytes,8,opt,name=hash,proto3" json:"hash,omitempty" gorm:"uniqueIndex"` // SHA-256 hash of the token; never sent to clients
+	Token       string `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty" gorm:"-"`         // only set in the response to CreateAPIToken
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,13 +957,13 @@ func (x *RemoteIdentity) Reset() {
 	}
 }
 
-func (x *RemoteIdentity) String() string {
+func (x *APIToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteIdentity) ProtoMessage() {}
+func (*APIToken) ProtoMessage() {}
 
-func (x *RemoteIdentity) ProtoReflect() protoreflect.Message {
+func (x *APIToken) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,166 +975,99 @@ func (x *RemoteIdentity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoteIdentity.ProtoReflect.Descriptor instead.
-func (*RemoteIdentity) Descriptor() ([]byte, []int) {
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RemoteIdentity) GetID() uint64 {
+func (x *APIToken) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *RemoteIdentity) GetProvider() string {
+func (x *APIToken) GetUserID() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoteIdentity) GetRemoteID() uint64 {
+func (x *APIToken) GetName() string {
 	if x != nil {
-		return x.RemoteID
-	}
-	return 0
-}
-
-func (x *RemoteIdentity) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RemoteIdentity) GetUserID() uint64 {
+func (x *APIToken) GetCourseID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.CourseID
 	}
 	return 0
 }
 
-type Group struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID          uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
-	CourseID    uint64            `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
-	TeamID      uint64            `protobuf:"varint,4,opt,name=teamID,proto3" json:"teamID,omitempty"`
-	Status      Group_GroupStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Group_GroupStatus" json:"status,omitempty"`
-	Users       []*User           `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty" gorm:"many2many:group_users;"`
-	Enrollments []*Enrollment     `protobuf:"bytes,7,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-}
-
-func (x *Group) Reset() {
-	*x = Group{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Group) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Group) ProtoMessage() {}
-
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Group) GetID() uint64 {
+func (x *APIToken) GetReadOnly() bool {
 	if x != nil {
-		return x.ID
+		return x.ReadOnly
 	}
-	return 0
+	return false
 }
 
-func (x *Group) GetName() string {
+func (x *APIToken) GetCreatedDate() string {
 	if x != nil {
-		return x.Name
+		return x.CreatedDate
 	}
 	return ""
 }
 
-func (x *Group) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
-}
-
-func (x *Group) GetTeamID() uint64 {
-	if x != nil {
-		return x.TeamID
-	}
-	return 0
-}
-
-func (x *Group) GetStatus() Group_GroupStatus {
+func (x *APIToken) GetExpiryDate() string {
 	if x != nil {
-		return x.Status
+		return x.ExpiryDate
 	}
-	return Group_PENDING
+	return ""
 }
 
-func (x *Group) GetUsers() []*User {
+func (x *APIToken) GetHash() string {
 	if x != nil {
-		return x.Users
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *Group) GetEnrollments() []*Enrollment {
+func (x *APIToken) GetToken() string {
 	if x != nil {
-		return x.Enrollments
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type Groups struct {
+type APITokens struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Tokens []*APIToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (x *Groups) Reset() {
-	*x = Groups{}
+func (x *APITokens) Reset() {
+	*x = APITokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[4]
+		mi := &file_ag_ag_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Groups) String() string {
+func (x *APITokens) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Groups) ProtoMessage() {}
+func (*APITokens) ProtoMessage() {}
 
-func (x *Groups) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[4]
+func (x *APITokens) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,56 +1078,46 @@ func (x *Groups) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Groups.ProtoReflect.Descriptor instead.
-func (*Groups) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use APITokens.ProtoReflect.Descriptor instead.
+func (*APITokens) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Groups) GetGroups() []*Group {
+func (x *APITokens) GetTokens() []*APIToken {
 	if x != nil {
-		return x.Groups
+		return x.Tokens
 	}
 	return nil
 }
 
-type Course struct {
+type APITokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID               uint64                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseCreatorID  uint64                `protobuf:"varint,2,opt,name=courseCreatorID,proto3" json:"courseCreatorID,omitempty"`
-	Name             string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Code             string                `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	Year             uint32                `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
-	Tag              string                `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
-	Provider         string                `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
-	OrganizationID   uint64                `protobuf:"varint,8,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
-	OrganizationPath string                `protobuf:"bytes,9,opt,name=organizationPath,proto3" json:"organizationPath,omitempty"` // The organization's SCM name, e.g. uis-dat520-2020.
-	SlipDays         uint32                `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
-	Enrolled         Enrollment_UserStatus `protobuf:"varint,11,opt,name=enrolled,proto3,enum=ag.Enrollment_UserStatus" json:"enrolled,omitempty" sql:"-"`
-	Enrollments      []*Enrollment         `protobuf:"bytes,12,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-	Assignments      []*Assignment         `protobuf:"bytes,13,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	Groups           []*Group              `protobuf:"bytes,14,rep,name=groups,proto3" json:"groups,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseID uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"` // zero for all courses
+	ReadOnly bool   `protobuf:"varint,3,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	Days     uint32 `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"` // number of days until the token expires
 }
 
-func (x *Course) Reset() {
-	*x = Course{}
+func (x *APITokenRequest) Reset() {
+	*x = APITokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[5]
+		mi := &file_ag_ag_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Course) String() string {
+func (x *APITokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Course) ProtoMessage() {}
+func (*APITokenRequest) ProtoMessage() {}
 
-func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[5]
+func (x *APITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,119 +1128,130 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Course.ProtoReflect.Descriptor instead.
-func (*Course) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use APITokenRequest.ProtoReflect.Descriptor instead.
+func (*APITokenRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Course) GetID() uint64 {
+func (x *APITokenRequest) GetName() string {
 	if x != nil {
-		return x.ID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Course) GetCourseCreatorID() uint64 {
+func (x *APITokenRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.CourseCreatorID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Course) GetName() string {
+func (x *APITokenRequest) GetReadOnly() bool {
 	if x != nil {
-		return x.Name
+		return x.ReadOnly
 	}
-	return ""
+	return false
 }
 
-func (x *Course) GetCode() string {
+func (x *APITokenRequest) GetDays() uint32 {
 	if x != nil {
-		return x.Code
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *Course) GetYear() uint32 {
-	if x != nil {
-		return x.Year
-	}
-	return 0
+// Session is a user's login session in a browser.
+// Only the hash of the session cookie is stored.
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID      uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty" gorm:"index"`
+	CreatedDate string `protobuf:"bytes,3,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	ExpiryDate  string `protobuf:"bytes,4,opt,name=expiryDate,proto3" json:"expiryDate,omitempty"`
+	Hash        string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty" gorm:"uniqueIndex"` // SHA-256 hash of the session cookie; never sent to clients
 }
 
-func (x *Course) GetTag() string {
-	if x != nil {
-		return x.Tag
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Course) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Course) GetOrganizationID() uint64 {
-	if x != nil {
-		return x.OrganizationID
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Course) GetOrganizationPath() string {
-	if x != nil {
-		return x.OrganizationPath
-	}
-	return ""
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Course) GetSlipDays() uint32 {
+func (x *Session) GetID() uint64 {
 	if x != nil {
-		return x.SlipDays
+		return x.ID
 	}
 	return 0
 }
 
-func (x *Course) GetEnrolled() Enrollment_UserStatus {
+func (x *Session) GetUserID() uint64 {
 	if x != nil {
-		return x.Enrolled
+		return x.UserID
 	}
-	return Enrollment_NONE
+	return 0
 }
 
-func (x *Course) GetEnrollments() []*Enrollment {
+func (x *Session) GetCreatedDate() string {
 	if x != nil {
-		return x.Enrollments
+		return x.CreatedDate
 	}
-	return nil
+	return ""
 }
 
-func (x *Course) GetAssignments() []*Assignment {
+func (x *Session) GetExpiryDate() string {
 	if x != nil {
-		return x.Assignments
+		return x.ExpiryDate
 	}
-	return nil
+	return ""
 }
 
-func (x *Course) GetGroups() []*Group {
+func (x *Session) GetHash() string {
 	if x != nil {
-		return x.Groups
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-type Courses struct {
+type Sessions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 }
 
-func (x *Courses) Reset() {
-	*x = Courses{}
+func (x *Sessions) Reset() {
+	*x = Sessions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,13 +1259,13 @@ func (x *Courses) Reset() {
 	}
 }
 
-func (x *Courses) String() string {
+func (x *Sessions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Courses) ProtoMessage() {}
+func (*Sessions) ProtoMessage() {}
 
-func (x *Courses) ProtoReflect() protoreflect.Message {
+func (x *Sessions) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,34 +1277,34 @@ func (x *Courses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
-func (*Courses) Descriptor() ([]byte, []int) {
+// Deprecated: Use Sessions.ProtoReflect.Descriptor instead.
+func (*Sessions) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Courses) GetCourses() []*Course {
+func (x *Sessions) GetSessions() []*Session {
 	if x != nil {
-		return x.Courses
+		return x.Sessions
 	}
 	return nil
 }
 
-type Repository struct {
+// OAuthState is a login in progress, waiting for the callback from the OAuth provider.
+// Only the hash of the state value is stored, and the state can only be used once.
+type OAuthState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	OrganizationID uint64          `protobuf:"varint,2,opt,name=organizationID,proto3" json:"organizationID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
-	RepositoryID   uint64          `protobuf:"varint,3,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"` // ID of a github repository
-	UserID         uint64          `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
-	GroupID        uint64          `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
-	HTMLURL        string          `protobuf:"bytes,6,opt,name=HTMLURL,proto3" json:"HTMLURL,omitempty"`
-	RepoType       Repository_Type `protobuf:"varint,7,opt,name=repoType,proto3,enum=ag.Repository_Type" json:"repoType,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	ID         uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Hash       string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty" gorm:"uniqueIndex"` // SHA-256 hash of the state value
+	Teacher    bool   `protobuf:"varint,3,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	Redirect   string `protobuf:"bytes,4,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	ExpiryDate string `protobuf:"bytes,5,opt,name=expiryDate,proto3" json:"expiryDate,omitempty"`
 }
 
-func (x *Repository) Reset() {
-	*x = Repository{}
+func (x *OAuthState) Reset() {
+	*x = OAuthState{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,13 +1312,13 @@ func (x *Repository) Reset() {
 	}
 }
 
-func (x *Repository) String() string {
+func (x *OAuthState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repository) ProtoMessage() {}
+func (*OAuthState) ProtoMessage() {}
 
-func (x *Repository) ProtoReflect() protoreflect.Message {
+func (x *OAuthState) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,83 +1330,59 @@ func (x *Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
-func (*Repository) Descriptor() ([]byte, []int) {
+// Deprecated: Use OAuthState.ProtoReflect.Descriptor instead.
+func (*OAuthState) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Repository) GetID() uint64 {
+func (x *OAuthState) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Repository) GetOrganizationID() uint64 {
-	if x != nil {
-		return x.OrganizationID
-	}
-	return 0
-}
-
-func (x *Repository) GetRepositoryID() uint64 {
-	if x != nil {
-		return x.RepositoryID
-	}
-	return 0
-}
-
-func (x *Repository) GetUserID() uint64 {
+func (x *OAuthState) GetHash() string {
 	if x != nil {
-		return x.UserID
+		return x.Hash
 	}
-	return 0
+	return ""
 }
 
-func (x *Repository) GetGroupID() uint64 {
+func (x *OAuthState) GetTeacher() bool {
 	if x != nil {
-		return x.GroupID
+		return x.Teacher
 	}
-	return 0
+	return false
 }
 
-func (x *Repository) GetHTMLURL() string {
+func (x *OAuthState) GetRedirect() string {
 	if x != nil {
-		return x.HTMLURL
+		return x.Redirect
 	}
 	return ""
 }
 
-func (x *Repository) GetRepoType() Repository_Type {
+func (x *OAuthState) GetExpiryDate() string {
 	if x != nil {
-		return x.RepoType
+		return x.ExpiryDate
 	}
-	return Repository_NONE
+	return ""
 }
 
-type Enrollment struct {
+// NotificationOptOut records that a user does not want notifications of a category.
+type NotificationOptOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64                  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
-	UserID            uint64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
-	GroupID           uint64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	HasTeacherScopes  bool                    `protobuf:"varint,5,opt,name=hasTeacherScopes,proto3" json:"hasTeacherScopes,omitempty"`
-	User              *User                   `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
-	Course            *Course                 `protobuf:"bytes,7,opt,name=course,proto3" json:"course,omitempty"`
-	Group             *Group                  `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
-	Status            Enrollment_UserStatus   `protobuf:"varint,9,opt,name=status,proto3,enum=ag.Enrollment_UserStatus" json:"status,omitempty"`
-	State             Enrollment_DisplayState `protobuf:"varint,10,opt,name=state,proto3,enum=ag.Enrollment_DisplayState" json:"state,omitempty"`
-	SlipDaysRemaining uint32                  `protobuf:"varint,11,opt,name=slipDaysRemaining,proto3" json:"slipDaysRemaining,omitempty" sql:"-"`
-	LastActivityDate  string                  `protobuf:"bytes,12,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty"`
-	TotalApproved     uint64                  `protobuf:"varint,13,opt,name=totalApproved,proto3" json:"totalApproved,omitempty"`
-	UsedSlipDays      []*UsedSlipDays         `protobuf:"bytes,14,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	ID       uint64                      `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID   uint64                      `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_opt_out"`
+	Category NotificationOptOut_Category `protobuf:"varint,3,opt,name=category,proto3,enum=ag.NotificationOptOut_Category" json:"category,omitempty" gorm:"uniqueIndex:idx_unique_opt_out"`
 }
 
-func (x *Enrollment) Reset() {
-	*x = Enrollment{}
+func (x *NotificationOptOut) Reset() {
+	*x = NotificationOptOut{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,13 +1390,13 @@ func (x *Enrollment) Reset() {
 	}
 }
 
-func (x *Enrollment) String() string {
+func (x *NotificationOptOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollment) ProtoMessage() {}
+func (*NotificationOptOut) ProtoMessage() {}
 
-func (x *Enrollment) ProtoReflect() protoreflect.Message {
+func (x *NotificationOptOut) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1100,137 +1408,114 @@ func (x *Enrollment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
-func (*Enrollment) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotificationOptOut.ProtoReflect.Descriptor instead.
+func (*NotificationOptOut) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Enrollment) GetID() uint64 {
+func (x *NotificationOptOut) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Enrollment) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
-}
-
-func (x *Enrollment) GetUserID() uint64 {
+func (x *NotificationOptOut) GetUserID() uint64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *Enrollment) GetGroupID() uint64 {
-	if x != nil {
-		return x.GroupID
-	}
-	return 0
-}
-
-func (x *Enrollment) GetHasTeacherScopes() bool {
-	if x != nil {
-		return x.HasTeacherScopes
-	}
-	return false
-}
-
-func (x *Enrollment) GetUser() *User {
+func (x *NotificationOptOut) GetCategory() NotificationOptOut_Category {
 	if x != nil {
-		return x.User
+		return x.Category
 	}
-	return nil
+	return NotificationOptOut_BUILD
 }
 
-func (x *Enrollment) GetCourse() *Course {
-	if x != nil {
-		return x.Course
-	}
-	return nil
-}
+type NotificationSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Enrollment) GetGroup() *Group {
-	if x != nil {
-		return x.Group
-	}
-	return nil
+	OptOuts []NotificationOptOut_Category `protobuf:"varint,1,rep,packed,name=optOuts,proto3,enum=ag.NotificationOptOut_Category" json:"optOuts,omitempty"` // categories the user does not want notifications for
 }
 
-func (x *Enrollment) GetStatus() Enrollment_UserStatus {
-	if x != nil {
-		return x.Status
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Enrollment_NONE
 }
 
-func (x *Enrollment) GetState() Enrollment_DisplayState {
-	if x != nil {
-		return x.State
-	}
-	return Enrollment_UNSET
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Enrollment) GetSlipDaysRemaining() uint32 {
-	if x != nil {
-		return x.SlipDaysRemaining
-	}
-	return 0
-}
+func (*NotificationSettings) ProtoMessage() {}
 
-func (x *Enrollment) GetLastActivityDate() string {
-	if x != nil {
-		return x.LastActivityDate
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Enrollment) GetTotalApproved() uint64 {
-	if x != nil {
-		return x.TotalApproved
-	}
-	return 0
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Enrollment) GetUsedSlipDays() []*UsedSlipDays {
+func (x *NotificationSettings) GetOptOuts() []NotificationOptOut_Category {
 	if x != nil {
-		return x.UsedSlipDays
+		return x.OptOuts
 	}
 	return nil
 }
 
-type UsedSlipDays struct {
+// ChatIntegration posts course events to a chat channel, and answers slash commands from the chat platform.
+type ChatIntegration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	EnrollmentID uint64 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	UsedSlipDays uint32 `protobuf:"varint,4,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	ID                 uint64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID           uint64                   `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Platform           ChatIntegration_Platform `protobuf:"varint,3,opt,name=platform,proto3,enum=ag.ChatIntegration_Platform" json:"platform,omitempty"`
+	WebhookURL         string                   `protobuf:"bytes,4,opt,name=webhookURL,proto3" json:"webhookURL,omitempty"`        // incoming webhook of the channel that events are posted to
+	SigningSecret      string                   `protobuf:"bytes,5,opt,name=signingSecret,proto3" json:"signingSecret,omitempty"`  // Slack signing secret, Mattermost command token, or Discord application public key
+	BuildFailures      bool                     `protobuf:"varint,6,opt,name=buildFailures,proto3" json:"buildFailures,omitempty"` // post submissions with a score below the assignment's score limit
+	DeadlineReminders  bool                     `protobuf:"varint,7,opt,name=deadlineReminders,proto3" json:"deadlineReminders,omitempty"`
+	Approvals          bool                     `protobuf:"varint,8,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	EnrollmentRequests bool                     `protobuf:"varint,9,opt,name=enrollmentRequests,proto3" json:"enrollmentRequests,omitempty"`
+	ReminderHours      uint32                   `protobuf:"varint,10,opt,name=reminderHours,proto3" json:"reminderHours,omitempty"` // hours before a deadline that reminders are posted
 }
 
-func (x *UsedSlipDays) Reset() {
-	*x = UsedSlipDays{}
+func (x *ChatIntegration) Reset() {
+	*x = ChatIntegration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[9]
+		mi := &file_ag_ag_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UsedSlipDays) String() string {
+func (x *ChatIntegration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedSlipDays) ProtoMessage() {}
+func (*ChatIntegration) ProtoMessage() {}
 
-func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[9]
+func (x *ChatIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,97 +1526,91 @@ func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedSlipDays.ProtoReflect.Descriptor instead.
-func (*UsedSlipDays) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ChatIntegration.ProtoReflect.Descriptor instead.
+func (*ChatIntegration) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UsedSlipDays) GetID() uint64 {
+func (x *ChatIntegration) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetEnrollmentID() uint64 {
+func (x *ChatIntegration) GetCourseID() uint64 {
 	if x != nil {
-		return x.EnrollmentID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetAssignmentID() uint64 {
+func (x *ChatIntegration) GetPlatform() ChatIntegration_Platform {
 	if x != nil {
-		return x.AssignmentID
+		return x.Platform
 	}
-	return 0
+	return ChatIntegration_DISCORD
 }
 
-func (x *UsedSlipDays) GetUsedSlipDays() uint32 {
+func (x *ChatIntegration) GetWebhookURL() string {
 	if x != nil {
-		return x.UsedSlipDays
+		return x.WebhookURL
 	}
-	return 0
+	return ""
 }
 
-type Enrollments struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+func (x *ChatIntegration) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
 }
 
-func (x *Enrollments) Reset() {
-	*x = Enrollments{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChatIntegration) GetBuildFailures() bool {
+	if x != nil {
+		return x.BuildFailures
 	}
+	return false
 }
 
-func (x *Enrollments) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChatIntegration) GetDeadlineReminders() bool {
+	if x != nil {
+		return x.DeadlineReminders
+	}
+	return false
 }
 
-func (*Enrollments) ProtoMessage() {}
-
-func (x *Enrollments) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChatIntegration) GetApprovals() bool {
+	if x != nil {
+		return x.Approvals
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
-func (*Enrollments) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{10}
+func (x *ChatIntegration) GetEnrollmentRequests() bool {
+	if x != nil {
+		return x.EnrollmentRequests
+	}
+	return false
 }
 
-func (x *Enrollments) GetEnrollments() []*Enrollment {
+func (x *ChatIntegration) GetReminderHours() uint32 {
 	if x != nil {
-		return x.Enrollments
+		return x.ReminderHours
 	}
-	return nil
+	return 0
 }
 
-type SubmissionLink struct {
+type ChatIntegrations struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignment *Assignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
-	Submission *Submission `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
+	Integrations []*ChatIntegration `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
 }
 
-func (x *SubmissionLink) Reset() {
-	*x = SubmissionLink{}
+func (x *ChatIntegrations) Reset() {
+	*x = ChatIntegrations{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1339,13 +1618,13 @@ func (x *SubmissionLink) Reset() {
 	}
 }
 
-func (x *SubmissionLink) String() string {
+func (x *ChatIntegrations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionLink) ProtoMessage() {}
+func (*ChatIntegrations) ProtoMessage() {}
 
-func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
+func (x *ChatIntegrations) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1357,36 +1636,38 @@ func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionLink.ProtoReflect.Descriptor instead.
-func (*SubmissionLink) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatIntegrations.ProtoReflect.Descriptor instead.
+func (*ChatIntegrations) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SubmissionLink) GetAssignment() *Assignment {
-	if x != nil {
-		return x.Assignment
-	}
-	return nil
-}
-
-func (x *SubmissionLink) GetSubmission() *Submission {
+func (x *ChatIntegrations) GetIntegrations() []*ChatIntegration {
 	if x != nil {
-		return x.Submission
+		return x.Integrations
 	}
 	return nil
 }
 
-type EnrollmentLink struct {
+// Webhook delivers course events to an external system as signed JSON payloads.
+type Webhook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enrollment  *Enrollment       `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // must have course and user/group preloaded
-	Submissions []*SubmissionLink `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	ID                uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	URL               string `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Secret            string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`                        // key of the payload's HMAC-SHA256 signature in the X-QuickFeed-Signature-256 header
+	SubmissionCreated bool   `protobuf:"varint,5,opt,name=submissionCreated,proto3" json:"submissionCreated,omitempty"` // submission.created events
+	SubmissionStatus  bool   `protobuf:"varint,6,opt,name=submissionStatus,proto3" json:"submissionStatus,omitempty"`   // submission.status_changed events
+	EnrollmentChanged bool   `protobuf:"varint,7,opt,name=enrollmentChanged,proto3" json:"enrollmentChanged,omitempty"` // enrollment.changed events
+	GroupChanged      bool   `protobuf:"varint,8,opt,name=groupChanged,proto3" json:"groupChanged,omitempty"`           // group.changed events
+	AssignmentUpdated bool   `protobuf:"varint,9,opt,name=assignmentUpdated,proto3" json:"assignmentUpdated,omitempty"` // assignment.updated events
+	Active            bool   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`                      // inactive webhooks receive no events
 }
 
-func (x *EnrollmentLink) Reset() {
-	*x = EnrollmentLink{}
+func (x *Webhook) Reset() {
+	*x = Webhook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1394,13 +1675,13 @@ func (x *EnrollmentLink) Reset() {
 	}
 }
 
-func (x *EnrollmentLink) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentLink) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
+func (x *Webhook) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1412,117 +1693,106 @@ func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentLink.ProtoReflect.Descriptor instead.
-func (*EnrollmentLink) Descriptor() ([]byte, []int) {
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EnrollmentLink) GetEnrollment() *Enrollment {
+func (x *Webhook) GetID() uint64 {
 	if x != nil {
-		return x.Enrollment
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-func (x *EnrollmentLink) GetSubmissions() []*SubmissionLink {
+func (x *Webhook) GetCourseID() uint64 {
 	if x != nil {
-		return x.Submissions
+		return x.CourseID
 	}
-	return nil
+	return 0
 }
 
-type CourseSubmissions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Course *Course           `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // preloaded assignments
-	Links  []*EnrollmentLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+func (x *Webhook) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
 }
 
-func (x *CourseSubmissions) Reset() {
-	*x = CourseSubmissions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
 	}
+	return ""
 }
 
-func (x *CourseSubmissions) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Webhook) GetSubmissionCreated() bool {
+	if x != nil {
+		return x.SubmissionCreated
+	}
+	return false
 }
 
-func (*CourseSubmissions) ProtoMessage() {}
-
-func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Webhook) GetSubmissionStatus() bool {
+	if x != nil {
+		return x.SubmissionStatus
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CourseSubmissions.ProtoReflect.Descriptor instead.
-func (*CourseSubmissions) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{13}
+func (x *Webhook) GetEnrollmentChanged() bool {
+	if x != nil {
+		return x.EnrollmentChanged
+	}
+	return false
 }
 
-func (x *CourseSubmissions) GetCourse() *Course {
+func (x *Webhook) GetGroupChanged() bool {
 	if x != nil {
-		return x.Course
+		return x.GroupChanged
 	}
-	return nil
+	return false
 }
 
-func (x *CourseSubmissions) GetLinks() []*EnrollmentLink {
+func (x *Webhook) GetAssignmentUpdated() bool {
 	if x != nil {
-		return x.Links
+		return x.AssignmentUpdated
 	}
-	return nil
+	return false
 }
 
-type Assignment struct {
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Webhooks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64              `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
-	Name              string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ScriptFile        string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
-	Deadline          string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	AutoApprove       bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
-	Order             uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
-	IsGroupLab        bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit        uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`               // minimal score limit for auto approval
-	Reviewers         uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                // number of reviewers that will review submissions for this assignment
-	Submissions       []*Submission       `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`             // submissions produced for this assignment
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,12,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"` // grading benchmarks for this assignment
-	ContainerTimeout  uint32              `protobuf:"varint,13,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`  // TODO(meling) Do we need this?
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 }
 
-func (x *Assignment) Reset() {
-	*x = Assignment{}
+func (x *Webhooks) Reset() {
+	*x = Webhooks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[14]
+		mi := &file_ag_ag_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Assignment) String() string {
+func (x *Webhooks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment) ProtoMessage() {}
+func (*Webhooks) ProtoMessage() {}
 
-func (x *Assignment) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[14]
+func (x *Webhooks) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,112 +1803,156 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
-func (*Assignment) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Webhooks.ProtoReflect.Descriptor instead.
+func (*Webhooks) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Assignment) GetID() uint64 {
+func (x *Webhooks) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.ID
+		return x.Webhooks
 	}
-	return 0
+	return nil
 }
 
-func (x *Assignment) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
+// WebhookDelivery is an event delivered, or to be delivered, to a webhook.
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	WebhookID   uint64 `protobuf:"varint,2,opt,name=webhookID,proto3" json:"webhookID,omitempty" gorm:"index"`
+	Event       string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Payload     string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts    uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`     // number of delivery attempts so far
+	StatusCode  uint32 `protobuf:"varint,6,opt,name=statusCode,proto3" json:"statusCode,omitempty"` // HTTP status code of the last attempt; zero if no response
+	Error       string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`            // error of the last attempt
+	Delivered   bool   `protobuf:"varint,8,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	CreatedDate string `protobuf:"bytes,9,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	LastAttempt string `protobuf:"bytes,10,opt,name=lastAttempt,proto3" json:"lastAttempt,omitempty"`
+	NextAttempt string `protobuf:"bytes,11,opt,name=nextAttempt,proto3" json:"nextAttempt,omitempty"` // time of the next retry; empty if delivered or no more retries
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Assignment) GetName() string {
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WebhookDelivery) GetID() uint64 {
 	if x != nil {
-		return x.Name
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *Assignment) GetScriptFile() string {
+func (x *WebhookDelivery) GetWebhookID() uint64 {
 	if x != nil {
-		return x.ScriptFile
+		return x.WebhookID
 	}
-	return ""
+	return 0
 }
 
-func (x *Assignment) GetDeadline() string {
+func (x *WebhookDelivery) GetEvent() string {
 	if x != nil {
-		return x.Deadline
+		return x.Event
 	}
 	return ""
 }
 
-func (x *Assignment) GetAutoApprove() bool {
+func (x *WebhookDelivery) GetPayload() string {
 	if x != nil {
-		return x.AutoApprove
+		return x.Payload
 	}
-	return false
+	return ""
 }
 
-func (x *Assignment) GetOrder() uint32 {
+func (x *WebhookDelivery) GetAttempts() uint32 {
 	if x != nil {
-		return x.Order
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *Assignment) GetIsGroupLab() bool {
+func (x *WebhookDelivery) GetStatusCode() uint32 {
 	if x != nil {
-		return x.IsGroupLab
+		return x.StatusCode
 	}
-	return false
+	return 0
 }
 
-func (x *Assignment) GetScoreLimit() uint32 {
+func (x *WebhookDelivery) GetError() string {
 	if x != nil {
-		return x.ScoreLimit
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *Assignment) GetReviewers() uint32 {
+func (x *WebhookDelivery) GetDelivered() bool {
 	if x != nil {
-		return x.Reviewers
+		return x.Delivered
 	}
-	return 0
+	return false
 }
 
-func (x *Assignment) GetSubmissions() []*Submission {
+func (x *WebhookDelivery) GetCreatedDate() string {
 	if x != nil {
-		return x.Submissions
+		return x.CreatedDate
 	}
-	return nil
+	return ""
 }
 
-func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
+func (x *WebhookDelivery) GetLastAttempt() string {
 	if x != nil {
-		return x.GradingBenchmarks
+		return x.LastAttempt
 	}
-	return nil
+	return ""
 }
 
-func (x *Assignment) GetContainerTimeout() uint32 {
+func (x *WebhookDelivery) GetNextAttempt() string {
 	if x != nil {
-		return x.ContainerTimeout
+		return x.NextAttempt
 	}
-	return 0
+	return ""
 }
 
-type Assignments struct {
+type WebhookDeliveries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 }
 
-func (x *Assignments) Reset() {
-	*x = Assignments{}
+func (x *WebhookDeliveries) Reset() {
+	*x = WebhookDeliveries{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1646,13 +1960,13 @@ func (x *Assignments) Reset() {
 	}
 }
 
-func (x *Assignments) String() string {
+func (x *WebhookDeliveries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignments) ProtoMessage() {}
+func (*WebhookDeliveries) ProtoMessage() {}
 
-func (x *Assignments) ProtoReflect() protoreflect.Message {
+func (x *WebhookDeliveries) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,41 +1978,29 @@ func (x *Assignments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
-func (*Assignments) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookDeliveries.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveries) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Assignments) GetAssignments() []*Assignment {
+func (x *WebhookDeliveries) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Assignments
+		return x.Deliveries
 	}
 	return nil
 }
 
-type Submission struct {
+type WebhookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64            `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	UserID       uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID      uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	Score        uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
-	CommitHash   string            `protobuf:"bytes,8,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
-	ScoreObjects string            `protobuf:"bytes,6,opt,name=scoreObjects,proto3" json:"scoreObjects,omitempty"` // deprecated: do not use
-	OldBuildInfo string            `protobuf:"bytes,7,opt,name=oldBuildInfo,proto3" json:"oldBuildInfo,omitempty"` // deprecated: do not use
-	Released     bool              `protobuf:"varint,9,opt,name=released,proto3" json:"released,omitempty"`        // true => feedback is visible to the student or group members
-	Status       Submission_Status `protobuf:"varint,10,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
-	ApprovedDate string            `protobuf:"bytes,11,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
-	Reviews      []*Review         `protobuf:"bytes,12,rep,name=reviews,proto3" json:"reviews,omitempty"`     // reviews produced for this submission
-	BuildInfo    *score.BuildInfo  `protobuf:"bytes,13,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"` // build info for tests
-	Scores       []*score.Score    `protobuf:"bytes,14,rep,name=Scores,proto3" json:"Scores,omitempty"`       // list of scores for different tests
+	CourseID  uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	WebhookID uint64 `protobuf:"varint,2,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1706,13 +2008,13 @@ func (x *Submission) Reset() {
 	}
 }
 
-func (x *Submission) String() string {
+func (x *WebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*WebhookRequest) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1724,134 +2026,198 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Submission) GetID() uint64 {
+func (x *WebhookRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Submission) GetAssignmentID() uint64 {
+func (x *WebhookRequest) GetWebhookID() uint64 {
 	if x != nil {
-		return x.AssignmentID
+		return x.WebhookID
 	}
 	return 0
 }
 
-func (x *Submission) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
+// ChatIdentity links a user to the user's account on a chat platform.
+type ChatIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID     uint64                   `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Platform   ChatIntegration_Platform `protobuf:"varint,3,opt,name=platform,proto3,enum=ag.ChatIntegration_Platform" json:"platform,omitempty" gorm:"uniqueIndex:idx_unique_chat_identity"`
+	ChatUserID string                   `protobuf:"bytes,4,opt,name=chatUserID,proto3" json:"chatUserID,omitempty" gorm:"uniqueIndex:idx_unique_chat_identity"`
+}
+
+func (x *ChatIdentity) Reset() {
+	*x = ChatIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Submission) GetGroupID() uint64 {
-	if x != nil {
-		return x.GroupID
+func (x *ChatIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatIdentity) ProtoMessage() {}
+
+func (x *ChatIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Submission) GetScore() uint32 {
+// Deprecated: Use ChatIdentity.ProtoReflect.Descriptor instead.
+func (*ChatIdentity) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChatIdentity) GetID() uint64 {
 	if x != nil {
-		return x.Score
+		return x.ID
 	}
 	return 0
 }
 
-func (x *Submission) GetCommitHash() string {
+func (x *ChatIdentity) GetUserID() uint64 {
 	if x != nil {
-		return x.CommitHash
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *Submission) GetScoreObjects() string {
+func (x *ChatIdentity) GetPlatform() ChatIntegration_Platform {
 	if x != nil {
-		return x.ScoreObjects
+		return x.Platform
 	}
-	return ""
+	return ChatIntegration_DISCORD
 }
 
-func (x *Submission) GetOldBuildInfo() string {
+func (x *ChatIdentity) GetChatUserID() string {
 	if x != nil {
-		return x.OldBuildInfo
+		return x.ChatUserID
 	}
 	return ""
 }
 
-func (x *Submission) GetReleased() bool {
-	if x != nil {
-		return x.Released
+// ChatReminder records that a deadline reminder has been posted by a chat integration.
+type ChatReminder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IntegrationID uint64 `protobuf:"varint,2,opt,name=integrationID,proto3" json:"integrationID,omitempty" gorm:"uniqueIndex:idx_unique_chat_reminder"`
+	AssignmentID  uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex:idx_unique_chat_reminder"`
+	Deadline      string `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty" gorm:"uniqueIndex:idx_unique_chat_reminder"`
+}
+
+func (x *ChatReminder) Reset() {
+	*x = ChatReminder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Submission) GetStatus() Submission_Status {
-	if x != nil {
-		return x.Status
+func (x *ChatReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatReminder) ProtoMessage() {}
+
+func (x *ChatReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Submission_NONE
+	return mi.MessageOf(x)
 }
 
-func (x *Submission) GetApprovedDate() string {
+// Deprecated: Use ChatReminder.ProtoReflect.Descriptor instead.
+func (*ChatReminder) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChatReminder) GetID() uint64 {
 	if x != nil {
-		return x.ApprovedDate
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *Submission) GetReviews() []*Review {
+func (x *ChatReminder) GetIntegrationID() uint64 {
 	if x != nil {
-		return x.Reviews
+		return x.IntegrationID
 	}
-	return nil
+	return 0
 }
 
-func (x *Submission) GetBuildInfo() *score.BuildInfo {
+func (x *ChatReminder) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.BuildInfo
+		return x.AssignmentID
 	}
-	return nil
+	return 0
 }
 
-func (x *Submission) GetScores() []*score.Score {
+func (x *ChatReminder) GetDeadline() string {
 	if x != nil {
-		return x.Scores
+		return x.Deadline
 	}
-	return nil
+	return ""
 }
 
-type Submissions struct {
+type RemoteIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Provider    string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
+	RemoteID    uint64 `protobuf:"varint,3,opt,name=remoteID,proto3" json:"remoteID,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
+	AccessToken string `protobuf:"bytes,4,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      uint64 `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *Submissions) Reset() {
-	*x = Submissions{}
+func (x *RemoteIdentity) Reset() {
+	*x = RemoteIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[17]
+		mi := &file_ag_ag_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submissions) String() string {
+func (x *RemoteIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submissions) ProtoMessage() {}
+func (*RemoteIdentity) ProtoMessage() {}
 
-func (x *Submissions) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[17]
+func (x *RemoteIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,48 +2228,78 @@ func (x *Submissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
-func (*Submissions) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RemoteIdentity.ProtoReflect.Descriptor instead.
+func (*RemoteIdentity) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Submissions) GetSubmissions() []*Submission {
+func (x *RemoteIdentity) GetID() uint64 {
 	if x != nil {
-		return x.Submissions
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-type GradingBenchmark struct {
+func (x *RemoteIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RemoteIdentity) GetRemoteID() uint64 {
+	if x != nil {
+		return x.RemoteID
+	}
+	return 0
+}
+
+func (x *RemoteIdentity) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RemoteIdentity) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64              `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	ReviewID     uint64              `protobuf:"varint,3,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
-	Heading      string              `protobuf:"bytes,4,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment      string              `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria     []*GradingCriterion `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	ID          uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
+	CourseID    uint64            `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
+	TeamID      uint64            `protobuf:"varint,4,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	Status      Group_GroupStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Group_GroupStatus" json:"status,omitempty"`
+	Users       []*User           `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty" gorm:"many2many:group_users;"`
+	Enrollments []*Enrollment     `protobuf:"bytes,7,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	GroupSet    string            `protobuf:"bytes,8,opt,name=groupSet,proto3" json:"groupSet,omitempty"` // name of the group set the group belongs to; empty for the course's default groups
 }
 
-func (x *GradingBenchmark) Reset() {
-	*x = GradingBenchmark{}
+func (x *Group) Reset() {
+	*x = Group{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[18]
+		mi := &file_ag_ag_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingBenchmark) String() string {
+func (x *Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingBenchmark) ProtoMessage() {}
+func (*Group) ProtoMessage() {}
 
-func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[18]
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1914,78 +2310,92 @@ func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GradingBenchmark) GetID() uint64 {
+func (x *Group) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetAssignmentID() uint64 {
+func (x *Group) GetName() string {
 	if x != nil {
-		return x.AssignmentID
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetReviewID() uint64 {
+func (x *Group) GetTeamID() uint64 {
 	if x != nil {
-		return x.ReviewID
+		return x.TeamID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetHeading() string {
+func (x *Group) GetStatus() Group_GroupStatus {
 	if x != nil {
-		return x.Heading
+		return x.Status
 	}
-	return ""
+	return Group_PENDING
 }
 
-func (x *GradingBenchmark) GetComment() string {
+func (x *Group) GetUsers() []*User {
 	if x != nil {
-		return x.Comment
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+func (x *Group) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.Criteria
+		return x.Enrollments
 	}
 	return nil
 }
 
-type Benchmarks struct {
+func (x *Group) GetGroupSet() string {
+	if x != nil {
+		return x.GroupSet
+	}
+	return ""
+}
+
+type Groups struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *Benchmarks) Reset() {
-	*x = Benchmarks{}
+func (x *Groups) Reset() {
+	*x = Groups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[19]
+		mi := &file_ag_ag_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Benchmarks) String() string {
+func (x *Groups) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Benchmarks) ProtoMessage() {}
+func (*Groups) ProtoMessage() {}
 
-func (x *Benchmarks) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[19]
+func (x *Groups) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,48 +2406,48 @@ func (x *Benchmarks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
-func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Groups.ProtoReflect.Descriptor instead.
+func (*Groups) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+func (x *Groups) GetGroups() []*Group {
 	if x != nil {
-		return x.Benchmarks
+		return x.Groups
 	}
 	return nil
 }
 
-type GradingCriterion struct {
+type GroupMembership struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
-	Points      uint64                 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
-	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	ID         uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GroupID    uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID     uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	User       *User  `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	JoinedDate string `protobuf:"bytes,5,opt,name=joinedDate,proto3" json:"joinedDate,omitempty"` // empty if the user joined before membership history was recorded
+	LeftDate   string `protobuf:"bytes,6,opt,name=leftDate,proto3" json:"leftDate,omitempty"`     // empty if the user is still a member
 }
 
-func (x *GradingCriterion) Reset() {
-	*x = GradingCriterion{}
+func (x *GroupMembership) Reset() {
+	*x = GroupMembership{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[20]
+		mi := &file_ag_ag_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingCriterion) String() string {
+func (x *GroupMembership) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingCriterion) ProtoMessage() {}
+func (*GroupMembership) ProtoMessage() {}
 
-func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[20]
+func (x *GroupMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2048,85 +2458,78 @@ func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
-func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GroupMembership.ProtoReflect.Descriptor instead.
+func (*GroupMembership) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GradingCriterion) GetID() uint64 {
+func (x *GroupMembership) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetBenchmarkID() uint64 {
+func (x *GroupMembership) GetGroupID() uint64 {
 	if x != nil {
-		return x.BenchmarkID
+		return x.GroupID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetPoints() uint64 {
+func (x *GroupMembership) GetUserID() uint64 {
 	if x != nil {
-		return x.Points
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetDescription() string {
+func (x *GroupMembership) GetUser() *User {
 	if x != nil {
-		return x.Description
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+func (x *GroupMembership) GetJoinedDate() string {
 	if x != nil {
-		return x.Grade
+		return x.JoinedDate
 	}
-	return GradingCriterion_NONE
+	return ""
 }
 
-func (x *GradingCriterion) GetComment() string {
+func (x *GroupMembership) GetLeftDate() string {
 	if x != nil {
-		return x.Comment
+		return x.LeftDate
 	}
 	return ""
 }
 
-type Review struct {
+type GroupMemberships struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubmissionID      uint64              `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
-	ReviewerID        uint64              `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
-	Feedback          string              `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	Ready             bool                `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
-	Score             uint32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
-	Edited            string              `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty"`
+	Memberships []*GroupMembership `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
+func (x *GroupMemberships) Reset() {
+	*x = GroupMemberships{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[21]
+		mi := &file_ag_ag_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Review) String() string {
+func (x *GroupMemberships) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*GroupMemberships) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[21]
+func (x *GroupMemberships) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2137,92 +2540,125 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GroupMemberships.ProtoReflect.Descriptor instead.
+func (*GroupMemberships) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Review) GetID() uint64 {
+func (x *GroupMemberships) GetMemberships() []*GroupMembership {
 	if x != nil {
-		return x.ID
+		return x.Memberships
 	}
-	return 0
+	return nil
 }
 
-func (x *Review) GetSubmissionID() uint64 {
-	if x != nil {
-		return x.SubmissionID
+type Contribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login     string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`          // SCM login of the commit author, or the author's name if not linked to an SCM account
+	Member    bool   `protobuf:"varint,2,opt,name=member,proto3" json:"member,omitempty"`       // whether the author is, or has been, a member of the group
+	Commits   uint32 `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`     // number of commits, not counting merge commits
+	Additions uint32 `protobuf:"varint,4,opt,name=additions,proto3" json:"additions,omitempty"` // number of lines added
+	Deletions uint32 `protobuf:"varint,5,opt,name=deletions,proto3" json:"deletions,omitempty"` // number of lines deleted
+}
+
+func (x *Contribution) Reset() {
+	*x = Contribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Review) GetReviewerID() uint64 {
-	if x != nil {
-		return x.ReviewerID
+func (x *Contribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contribution) ProtoMessage() {}
+
+func (x *Contribution) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Review) GetFeedback() string {
+// Deprecated: Use Contribution.ProtoReflect.Descriptor instead.
+func (*Contribution) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Contribution) GetLogin() string {
 	if x != nil {
-		return x.Feedback
+		return x.Login
 	}
 	return ""
 }
 
-func (x *Review) GetReady() bool {
+func (x *Contribution) GetMember() bool {
 	if x != nil {
-		return x.Ready
+		return x.Member
 	}
 	return false
 }
 
-func (x *Review) GetScore() uint32 {
+func (x *Contribution) GetCommits() uint32 {
 	if x != nil {
-		return x.Score
+		return x.Commits
 	}
 	return 0
 }
 
-func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+func (x *Contribution) GetAdditions() uint32 {
 	if x != nil {
-		return x.GradingBenchmarks
+		return x.Additions
 	}
-	return nil
+	return 0
 }
 
-func (x *Review) GetEdited() string {
+func (x *Contribution) GetDeletions() uint32 {
 	if x != nil {
-		return x.Edited
+		return x.Deletions
 	}
-	return ""
+	return 0
 }
 
-type Reviewers struct {
+type SubmissionContributions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	SubmissionID  uint64          `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AssignmentID  uint64          `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	CommitHash    string          `protobuf:"bytes,3,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Contributions []*Contribution `protobuf:"bytes,4,rep,name=contributions,proto3" json:"contributions,omitempty"`
 }
 
-func (x *Reviewers) Reset() {
-	*x = Reviewers{}
+func (x *SubmissionContributions) Reset() {
+	*x = SubmissionContributions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[22]
+		mi := &file_ag_ag_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Reviewers) String() string {
+func (x *SubmissionContributions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reviewers) ProtoMessage() {}
+func (*SubmissionContributions) ProtoMessage() {}
 
-func (x *Reviewers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[22]
+func (x *SubmissionContributions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,98 +2669,64 @@ func (x *Reviewers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
-func (*Reviewers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SubmissionContributions.ProtoReflect.Descriptor instead.
+func (*SubmissionContributions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Reviewers) GetReviewers() []*User {
+func (x *SubmissionContributions) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.Reviewers
-	}
-	return nil
-}
-
-type ReviewRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
-}
-
-func (x *ReviewRequest) Reset() {
-	*x = ReviewRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.SubmissionID
 	}
+	return 0
 }
 
-func (x *ReviewRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReviewRequest) ProtoMessage() {}
-
-func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SubmissionContributions) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{23}
+	return 0
 }
 
-func (x *ReviewRequest) GetCourseID() uint64 {
+func (x *SubmissionContributions) GetCommitHash() string {
 	if x != nil {
-		return x.CourseID
+		return x.CommitHash
 	}
-	return 0
+	return ""
 }
 
-func (x *ReviewRequest) GetReview() *Review {
+func (x *SubmissionContributions) GetContributions() []*Contribution {
 	if x != nil {
-		return x.Review
+		return x.Contributions
 	}
 	return nil
 }
 
-type CourseRequest struct {
+type GroupContributions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Submissions []*SubmissionContributions `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
 }
 
-func (x *CourseRequest) Reset() {
-	*x = CourseRequest{}
+func (x *GroupContributions) Reset() {
+	*x = GroupContributions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[24]
+		mi := &file_ag_ag_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseRequest) String() string {
+func (x *GroupContributions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseRequest) ProtoMessage() {}
+func (*GroupContributions) ProtoMessage() {}
 
-func (x *CourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[24]
+func (x *GroupContributions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,43 +2737,47 @@ func (x *CourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
-func (*CourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GroupContributions.ProtoReflect.Descriptor instead.
+func (*GroupContributions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CourseRequest) GetCourseID() uint64 {
+func (x *GroupContributions) GetSubmissions() []*SubmissionContributions {
 	if x != nil {
-		return x.CourseID
+		return x.Submissions
 	}
-	return 0
+	return nil
 }
 
-type UserRequest struct {
+type GroupFormationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	CourseID  uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupSize uint32 `protobuf:"varint,2,opt,name=groupSize,proto3" json:"groupSize,omitempty"` // size of the groups formed from approved students without a group; ignored if csv is given
+	Csv       string `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`              // one group per line: the group name followed by the logins of its members
+	DryRun    bool   `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`       // only report the groups that would be formed, without creating them
+	GroupSet  string `protobuf:"bytes,5,opt,name=groupSet,proto3" json:"groupSet,omitempty"`    // group set to form the groups in; empty for the course's default groups
 }
 
-func (x *UserRequest) Reset() {
-	*x = UserRequest{}
+func (x *GroupFormationRequest) Reset() {
+	*x = GroupFormationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[25]
+		mi := &file_ag_ag_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserRequest) String() string {
+func (x *GroupFormationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRequest) ProtoMessage() {}
+func (*GroupFormationRequest) ProtoMessage() {}
 
-func (x *UserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[25]
+func (x *GroupFormationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2382,138 +2788,57 @@ func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
-func (*UserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GroupFormationRequest.ProtoReflect.Descriptor instead.
+func (*GroupFormationRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UserRequest) GetUserID() uint64 {
+func (x *GroupFormationRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.CourseID
 	}
 	return 0
 }
 
-type GetGroupRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
-}
-
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetGroupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGroupRequest) ProtoMessage() {}
-
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *GetGroupRequest) GetGroupID() uint64 {
+func (x *GroupFormationRequest) GetGroupSize() uint32 {
 	if x != nil {
-		return x.GroupID
+		return x.GroupSize
 	}
 	return 0
 }
 
-type GroupRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
-}
-
-func (x *GroupRequest) Reset() {
-	*x = GroupRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GroupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GroupRequest) ProtoMessage() {}
-
-func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
-func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GroupRequest) GetUserID() uint64 {
+func (x *GroupFormationRequest) GetCsv() string {
 	if x != nil {
-		return x.UserID
+		return x.Csv
 	}
-	return 0
+	return ""
 }
 
-func (x *GroupRequest) GetGroupID() uint64 {
+func (x *GroupFormationRequest) GetDryRun() bool {
 	if x != nil {
-		return x.GroupID
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *GroupRequest) GetCourseID() uint64 {
+func (x *GroupFormationRequest) GetGroupSet() string {
 	if x != nil {
-		return x.CourseID
+		return x.GroupSet
 	}
-	return 0
+	return ""
 }
 
-type Provider struct {
+type FormedGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // why the group could not be formed; empty if the group was (or would be) formed
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
+func (x *FormedGroup) Reset() {
+	*x = FormedGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2521,13 +2846,13 @@ func (x *Provider) Reset() {
 	}
 }
 
-func (x *Provider) String() string {
+func (x *FormedGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*FormedGroup) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
+func (x *FormedGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2539,28 +2864,35 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
+// Deprecated: Use FormedGroup.ProtoReflect.Descriptor instead.
+func (*FormedGroup) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Provider) GetProvider() string {
+func (x *FormedGroup) GetGroup() *Group {
 	if x != nil {
-		return x.Provider
+		return x.Group
+	}
+	return nil
+}
+
+func (x *FormedGroup) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type OrgRequest struct {
+type GroupFormation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Groups []*FormedGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *OrgRequest) Reset() {
-	*x = OrgRequest{}
+func (x *GroupFormation) Reset() {
+	*x = GroupFormation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2568,13 +2900,13 @@ func (x *OrgRequest) Reset() {
 	}
 }
 
-func (x *OrgRequest) String() string {
+func (x *GroupFormation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgRequest) ProtoMessage() {}
+func (*GroupFormation) ProtoMessage() {}
 
-func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupFormation) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2586,31 +2918,51 @@ func (x *OrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
-func (*OrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupFormation.ProtoReflect.Descriptor instead.
+func (*GroupFormation) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *OrgRequest) GetOrgName() string {
+func (x *GroupFormation) GetGroups() []*FormedGroup {
 	if x != nil {
-		return x.OrgName
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type Organization struct {
+type Course struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
+	ID                uint64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseCreatorID   uint64                   `protobuf:"varint,2,opt,name=courseCreatorID,proto3" json:"courseCreatorID,omitempty"`
+	Name              string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Code              string                   `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Year              uint32                   `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Tag               string                   `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	Provider          string                   `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	OrganizationID    uint64                   `protobuf:"varint,8,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	OrganizationPath  string                   `protobuf:"bytes,9,opt,name=organizationPath,proto3" json:"organizationPath,omitempty"` // The organization's SCM name, e.g. uis-dat520-2020.
+	SlipDays          uint32                   `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
+	Enrolled          Enrollment_UserStatus    `protobuf:"varint,11,opt,name=enrolled,proto3,enum=ag.Enrollment_UserStatus" json:"enrolled,omitempty" sql:"-"`
+	Enrollments       []*Enrollment            `protobuf:"bytes,12,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	Assignments       []*Assignment            `protobuf:"bytes,13,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Groups            []*Group                 `protobuf:"bytes,14,rep,name=groups,proto3" json:"groups,omitempty"`
+	GracePeriod       uint32                   `protobuf:"varint,15,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty" gorm:"default:120"`                           // minutes after the deadline before a slip day is used
+	SlipDayUnit       Course_SlipDayUnit       `protobuf:"varint,16,opt,name=slipDayUnit,proto3,enum=ag.Course_SlipDayUnit" json:"slipDayUnit,omitempty"`                   // unit used for counting slip days
+	SlipDaysCap       uint32                   `protobuf:"varint,17,opt,name=slipDaysCap,proto3" json:"slipDaysCap,omitempty"`                                              // max slip days used for a single assignment; zero means no cap
+	GroupSlipDays     Course_GroupSlipDays     `protobuf:"varint,18,opt,name=groupSlipDays,proto3,enum=ag.Course_GroupSlipDays" json:"groupSlipDays,omitempty"`             // how slip days are used for group assignments
+	SubmissionMode    Course_SubmissionMode    `protobuf:"varint,19,opt,name=submissionMode,proto3,enum=ag.Course_SubmissionMode" json:"submissionMode,omitempty"`          // how students submit their solutions
+	SubmissionTrigger Course_SubmissionTrigger `protobuf:"varint,20,opt,name=submissionTrigger,proto3,enum=ag.Course_SubmissionTrigger" json:"submissionTrigger,omitempty"` // which pushes are submissions, if submitting by pushing
+	MinGroupSize      uint32                   `protobuf:"varint,21,opt,name=minGroupSize,proto3" json:"minGroupSize,omitempty"`                                            // minimum number of group members; zero means no minimum
+	MaxGroupSize      uint32                   `protobuf:"varint,22,opt,name=maxGroupSize,proto3" json:"maxGroupSize,omitempty"`                                            // maximum number of group members; zero means no maximum
+	GroupDeadline     string                   `protobuf:"bytes,23,opt,name=groupDeadline,proto3" json:"groupDeadline,omitempty"`                                           // students cannot create groups after this time; empty means no deadline
+	GroupFreezeDate   string                   `protobuf:"bytes,24,opt,name=groupFreezeDate,proto3" json:"groupFreezeDate,omitempty"`                                       // only teachers can change group membership after this time; empty means never frozen
 }
 
-func (x *Organization) Reset() {
-	*x = Organization{}
+func (x *Course) Reset() {
+	*x = Course{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ag_ag_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2618,13 +2970,13 @@ func (x *Organization) Reset() {
 	}
 }
 
-func (x *Organization) String() string {
+func (x *Course) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization) ProtoMessage() {}
+func (*Course) ProtoMessage() {}
 
-func (x *Organization) ProtoReflect() protoreflect.Message {
+func (x *Course) ProtoReflect() protoreflect.Message {
 	mi := &file_ag_ag_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2636,187 +2988,204 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
-func (*Organization) Descriptor() ([]byte, []int) {
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
 	return file_ag_ag_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Organization) GetID() uint64 {
+func (x *Course) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Organization) GetPath() string {
+func (x *Course) GetCourseCreatorID() uint64 {
 	if x != nil {
-		return x.Path
+		return x.CourseCreatorID
 	}
-	return ""
+	return 0
 }
 
-func (x *Organization) GetAvatar() string {
+func (x *Course) GetName() string {
 	if x != nil {
-		return x.Avatar
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Organization) GetPaymentPlan() string {
+func (x *Course) GetCode() string {
 	if x != nil {
-		return x.PaymentPlan
+		return x.Code
 	}
 	return ""
 }
 
-type Organizations struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Course) GetYear() uint32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
 
-	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+func (x *Course) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
 }
 
-func (x *Organizations) Reset() {
-	*x = Organizations{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Course) GetProvider() string {
+	if x != nil {
+		return x.Provider
 	}
+	return ""
 }
 
-func (x *Organizations) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Course) GetOrganizationID() uint64 {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return 0
 }
 
-func (*Organizations) ProtoMessage() {}
+func (x *Course) GetOrganizationPath() string {
+	if x != nil {
+		return x.OrganizationPath
+	}
+	return ""
+}
 
-func (x *Organizations) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Course) GetSlipDays() uint32 {
+	if x != nil {
+		return x.SlipDays
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
-func (*Organizations) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{31}
+func (x *Course) GetEnrolled() Enrollment_UserStatus {
+	if x != nil {
+		return x.Enrolled
+	}
+	return Enrollment_NONE
 }
 
-func (x *Organizations) GetOrganizations() []*Organization {
+func (x *Course) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.Organizations
+		return x.Enrollments
 	}
 	return nil
 }
 
-// EnrollmentRequest is a request for enrolled users of a given course,
-// whose enrollment status match those provided in the request. To ignore group members
-// that otherwise match the enrollment request, set ignoreGroupMembers to true.
-type EnrollmentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Course) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
 
-	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
-	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
-	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+func (x *Course) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
 }
 
-func (x *EnrollmentRequest) Reset() {
-	*x = EnrollmentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Course) GetGracePeriod() uint32 {
+	if x != nil {
+		return x.GracePeriod
 	}
+	return 0
 }
 
-func (x *EnrollmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Course) GetSlipDayUnit() Course_SlipDayUnit {
+	if x != nil {
+		return x.SlipDayUnit
+	}
+	return Course_DAYS
 }
 
-func (*EnrollmentRequest) ProtoMessage() {}
+func (x *Course) GetSlipDaysCap() uint32 {
+	if x != nil {
+		return x.SlipDaysCap
+	}
+	return 0
+}
 
-func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Course) GetGroupSlipDays() Course_GroupSlipDays {
+	if x != nil {
+		return x.GroupSlipDays
 	}
-	return mi.MessageOf(x)
+	return Course_PER_MEMBER
 }
 
-// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{32}
+func (x *Course) GetSubmissionMode() Course_SubmissionMode {
+	if x != nil {
+		return x.SubmissionMode
+	}
+	return Course_PUSH
 }
 
-func (x *EnrollmentRequest) GetCourseID() uint64 {
+func (x *Course) GetSubmissionTrigger() Course_SubmissionTrigger {
 	if x != nil {
-		return x.CourseID
+		return x.SubmissionTrigger
+	}
+	return Course_CHANGED_FILES
+}
+
+func (x *Course) GetMinGroupSize() uint32 {
+	if x != nil {
+		return x.MinGroupSize
 	}
 	return 0
 }
 
-func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
+func (x *Course) GetMaxGroupSize() uint32 {
 	if x != nil {
-		return x.IgnoreGroupMembers
+		return x.MaxGroupSize
 	}
-	return false
+	return 0
 }
 
-func (x *EnrollmentRequest) GetWithActivity() bool {
+func (x *Course) GetGroupDeadline() string {
 	if x != nil {
-		return x.WithActivity
+		return x.GroupDeadline
 	}
-	return false
+	return ""
 }
 
-func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *Course) GetGroupFreezeDate() string {
 	if x != nil {
-		return x.Statuses
+		return x.GroupFreezeDate
 	}
-	return nil
+	return ""
 }
 
-// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
-type EnrollmentStatusRequest struct {
+type Courses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
 }
 
-func (x *EnrollmentStatusRequest) Reset() {
-	*x = EnrollmentStatusRequest{}
+func (x *Courses) Reset() {
+	*x = Courses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[33]
+		mi := &file_ag_ag_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentStatusRequest) String() string {
+func (x *Courses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentStatusRequest) ProtoMessage() {}
+func (*Courses) ProtoMessage() {}
 
-func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[33]
+func (x *Courses) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,52 +3196,49 @@ func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *EnrollmentStatusRequest) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
+func (*Courses) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *Courses) GetCourses() []*Course {
 	if x != nil {
-		return x.Statuses
+		return x.Courses
 	}
 	return nil
 }
 
-type SubmissionRequest struct {
+type Repository struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ID             uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	OrganizationID uint64          `protobuf:"varint,2,opt,name=organizationID,proto3" json:"organizationID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	RepositoryID   uint64          `protobuf:"varint,3,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"` // ID of a github repository
+	UserID         uint64          `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	GroupID        uint64          `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	HTMLURL        string          `protobuf:"bytes,6,opt,name=HTMLURL,proto3" json:"HTMLURL,omitempty"`
+	RepoType       Repository_Type `protobuf:"varint,7,opt,name=repoType,proto3,enum=ag.Repository_Type" json:"repoType,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
 }
 
-func (x *SubmissionRequest) Reset() {
-	*x = SubmissionRequest{}
+func (x *Repository) Reset() {
+	*x = Repository{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[34]
+		mi := &file_ag_ag_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionRequest) String() string {
+func (x *Repository) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionRequest) ProtoMessage() {}
+func (*Repository) ProtoMessage() {}
 
-func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[34]
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2883,61 +3249,98 @@ func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SubmissionRequest) GetUserID() uint64 {
+func (x *Repository) GetID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *SubmissionRequest) GetGroupID() uint64 {
+func (x *Repository) GetOrganizationID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.OrganizationID
 	}
 	return 0
 }
 
-func (x *SubmissionRequest) GetCourseID() uint64 {
+func (x *Repository) GetRepositoryID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.RepositoryID
 	}
 	return 0
 }
 
-type UpdateSubmissionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
-	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+func (x *Repository) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
 }
 
-func (x *UpdateSubmissionRequest) Reset() {
-	*x = UpdateSubmissionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Repository) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
 	}
+	return 0
 }
 
-func (x *UpdateSubmissionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Repository) GetHTMLURL() string {
+	if x != nil {
+		return x.HTMLURL
+	}
+	return ""
 }
 
-func (*UpdateSubmissionRequest) ProtoMessage() {}
+func (x *Repository) GetRepoType() Repository_Type {
+	if x != nil {
+		return x.RepoType
+	}
+	return Repository_NONE
+}
 
-func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[35]
+type Enrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64                  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
+	UserID            uint64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
+	GroupID           uint64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	HasTeacherScopes  bool                    `protobuf:"varint,5,opt,name=hasTeacherScopes,proto3" json:"hasTeacherScopes,omitempty"`
+	User              *User                   `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Course            *Course                 `protobuf:"bytes,7,opt,name=course,proto3" json:"course,omitempty"`
+	Group             *Group                  `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+	Status            Enrollment_UserStatus   `protobuf:"varint,9,opt,name=status,proto3,enum=ag.Enrollment_UserStatus" json:"status,omitempty"`
+	State             Enrollment_DisplayState `protobuf:"varint,10,opt,name=state,proto3,enum=ag.Enrollment_DisplayState" json:"state,omitempty"`
+	SlipDaysRemaining uint32                  `protobuf:"varint,11,opt,name=slipDaysRemaining,proto3" json:"slipDaysRemaining,omitempty" sql:"-"`
+	LastActivityDate  string                  `protobuf:"bytes,12,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty"`
+	TotalApproved     uint64                  `protobuf:"varint,13,opt,name=totalApproved,proto3" json:"totalApproved,omitempty"`
+	UsedSlipDays      []*UsedSlipDays         `protobuf:"bytes,14,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2948,151 +3351,139 @@ func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
+func (x *Enrollment) GetID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+func (x *Enrollment) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetScore() uint32 {
+func (x *Enrollment) GetUserID() uint64 {
 	if x != nil {
-		return x.Score
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetReleased() bool {
+func (x *Enrollment) GetGroupID() uint64 {
 	if x != nil {
-		return x.Released
+		return x.GroupID
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
+func (x *Enrollment) GetHasTeacherScopes() bool {
 	if x != nil {
-		return x.Status
+		return x.HasTeacherScopes
 	}
-	return Submission_NONE
-}
-
-type UpdateSubmissionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
-	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
-	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
+	return false
 }
 
-func (x *UpdateSubmissionsRequest) Reset() {
-	*x = UpdateSubmissionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Enrollment) GetUser() *User {
+	if x != nil {
+		return x.User
 	}
+	return nil
 }
 
-func (x *UpdateSubmissionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Enrollment) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
 }
 
-func (*UpdateSubmissionsRequest) ProtoMessage() {}
-
-func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Enrollment) GetGroup() *Group {
+	if x != nil {
+		return x.Group
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{36}
+func (x *Enrollment) GetStatus() Enrollment_UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Enrollment_NONE
 }
 
-func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
+func (x *Enrollment) GetState() Enrollment_DisplayState {
 	if x != nil {
-		return x.CourseID
+		return x.State
 	}
-	return 0
+	return Enrollment_UNSET
 }
 
-func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
+func (x *Enrollment) GetSlipDaysRemaining() uint32 {
 	if x != nil {
-		return x.AssignmentID
+		return x.SlipDaysRemaining
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
+func (x *Enrollment) GetLastActivityDate() string {
 	if x != nil {
-		return x.ScoreLimit
+		return x.LastActivityDate
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateSubmissionsRequest) GetRelease() bool {
+func (x *Enrollment) GetTotalApproved() uint64 {
 	if x != nil {
-		return x.Release
+		return x.TotalApproved
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateSubmissionsRequest) GetApprove() bool {
+func (x *Enrollment) GetUsedSlipDays() []*UsedSlipDays {
 	if x != nil {
-		return x.Approve
+		return x.UsedSlipDays
 	}
-	return false
+	return nil
 }
 
-type SubmissionReviewersRequest struct {
+type UsedSlipDays struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ID                uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EnrollmentID      uint64 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
+	AssignmentID      uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UsedSlipDays      uint32 `protobuf:"varint,4,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`           // slip days used for the assignment, including manual adjustments; never negative
+	Adjustment        int32  `protobuf:"varint,5,opt,name=adjustment,proto3" json:"adjustment,omitempty"`               // manual adjustment of slip days by teachers
+	AutomaticSlipDays uint32 `protobuf:"varint,6,opt,name=automaticSlipDays,proto3" json:"automaticSlipDays,omitempty"` // slip days used for the assignment, as computed from its submission
 }
 
-func (x *SubmissionReviewersRequest) Reset() {
-	*x = SubmissionReviewersRequest{}
+func (x *UsedSlipDays) Reset() {
+	*x = UsedSlipDays{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[37]
+		mi := &file_ag_ag_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionReviewersRequest) String() string {
+func (x *UsedSlipDays) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionReviewersRequest) ProtoMessage() {}
+func (*UsedSlipDays) ProtoMessage() {}
 
-func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[37]
+func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,98 +3494,87 @@ func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use UsedSlipDays.ProtoReflect.Descriptor instead.
+func (*UsedSlipDays) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
+func (x *UsedSlipDays) GetID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+func (x *UsedSlipDays) GetEnrollmentID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.EnrollmentID
 	}
 	return 0
 }
 
-type Providers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
-}
-
-func (x *Providers) Reset() {
-	*x = Providers{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UsedSlipDays) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
 	}
+	return 0
 }
 
-func (x *Providers) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Providers) ProtoMessage() {}
-
-func (x *Providers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UsedSlipDays) GetUsedSlipDays() uint32 {
+	if x != nil {
+		return x.UsedSlipDays
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
-func (*Providers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{38}
+func (x *UsedSlipDays) GetAdjustment() int32 {
+	if x != nil {
+		return x.Adjustment
+	}
+	return 0
 }
 
-func (x *Providers) GetProviders() []string {
+func (x *UsedSlipDays) GetAutomaticSlipDays() uint32 {
 	if x != nil {
-		return x.Providers
+		return x.AutomaticSlipDays
 	}
-	return nil
+	return 0
 }
 
-type URLRequest struct {
+// SlipDayLedgerEntry records a change of the slip days used by an enrollment for an assignment.
+type SlipDayLedgerEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
+	ID           uint64                    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EnrollmentID uint64                    `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
+	AssignmentID uint64                    `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Source       SlipDayLedgerEntry_Source `protobuf:"varint,4,opt,name=source,proto3,enum=ag.SlipDayLedgerEntry_Source" json:"source,omitempty"`
+	Change       int32                     `protobuf:"varint,5,opt,name=change,proto3" json:"change,omitempty"`             // change in slip days used; negative if slip days are refunded
+	UsedSlipDays uint32                    `protobuf:"varint,6,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"` // slip days used for the assignment after the change
+	Reason       string                    `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorID      uint64                    `protobuf:"varint,8,opt,name=actorID,proto3" json:"actorID,omitempty"` // ID of the teacher that adjusted the slip days; zero for automatic changes
+	Date         string                    `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *URLRequest) Reset() {
-	*x = URLRequest{}
+func (x *SlipDayLedgerEntry) Reset() {
+	*x = SlipDayLedgerEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[39]
+		mi := &file_ag_ag_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *URLRequest) String() string {
+func (x *SlipDayLedgerEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*URLRequest) ProtoMessage() {}
+func (*SlipDayLedgerEntry) ProtoMessage() {}
 
-func (x *URLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[39]
+func (x *SlipDayLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3205,114 +3585,99 @@ func (x *URLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
-func (*URLRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SlipDayLedgerEntry.ProtoReflect.Descriptor instead.
+func (*SlipDayLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *URLRequest) GetCourseID() uint64 {
+func (x *SlipDayLedgerEntry) GetID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *URLRequest) GetRepoTypes() []Repository_Type {
+func (x *SlipDayLedgerEntry) GetEnrollmentID() uint64 {
 	if x != nil {
-		return x.RepoTypes
+		return x.EnrollmentID
 	}
-	return nil
-}
-
-// used to check whether student/group submission repo is empty
-type RepositoryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	return 0
 }
 
-func (x *RepositoryRequest) Reset() {
-	*x = RepositoryRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SlipDayLedgerEntry) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
 	}
+	return 0
 }
 
-func (x *RepositoryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SlipDayLedgerEntry) GetSource() SlipDayLedgerEntry_Source {
+	if x != nil {
+		return x.Source
+	}
+	return SlipDayLedgerEntry_AUTOMATIC
 }
 
-func (*RepositoryRequest) ProtoMessage() {}
-
-func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SlipDayLedgerEntry) GetChange() int32 {
+	if x != nil {
+		return x.Change
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
-func (*RepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{40}
+func (x *SlipDayLedgerEntry) GetUsedSlipDays() uint32 {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return 0
 }
 
-func (x *RepositoryRequest) GetUserID() uint64 {
+func (x *SlipDayLedgerEntry) GetReason() string {
 	if x != nil {
-		return x.UserID
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *RepositoryRequest) GetGroupID() uint64 {
+func (x *SlipDayLedgerEntry) GetActorID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.ActorID
 	}
 	return 0
 }
 
-func (x *RepositoryRequest) GetCourseID() uint64 {
+func (x *SlipDayLedgerEntry) GetDate() string {
 	if x != nil {
-		return x.CourseID
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-type Repositories struct {
+type SlipDayLedger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Entries []*SlipDayLedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *Repositories) Reset() {
-	*x = Repositories{}
+func (x *SlipDayLedger) Reset() {
+	*x = SlipDayLedger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[41]
+		mi := &file_ag_ag_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Repositories) String() string {
+func (x *SlipDayLedger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repositories) ProtoMessage() {}
+func (*SlipDayLedger) ProtoMessage() {}
 
-func (x *Repositories) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[41]
+func (x *SlipDayLedger) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3323,43 +3688,43 @@ func (x *Repositories) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
-func (*Repositories) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use SlipDayLedger.ProtoReflect.Descriptor instead.
+func (*SlipDayLedger) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Repositories) GetURLs() map[string]string {
+func (x *SlipDayLedger) GetEntries() []*SlipDayLedgerEntry {
 	if x != nil {
-		return x.URLs
+		return x.Entries
 	}
 	return nil
 }
 
-type AuthorizationResponse struct {
+type Enrollments struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 }
 
-func (x *AuthorizationResponse) Reset() {
-	*x = AuthorizationResponse{}
+func (x *Enrollments) Reset() {
+	*x = Enrollments{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[42]
+		mi := &file_ag_ag_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizationResponse) String() string {
+func (x *Enrollments) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationResponse) ProtoMessage() {}
+func (*Enrollments) ProtoMessage() {}
 
-func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[42]
+func (x *Enrollments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3370,44 +3735,53 @@ func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
+func (*Enrollments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AuthorizationResponse) GetIsAuthorized() bool {
+func (x *Enrollments) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.IsAuthorized
+		return x.Enrollments
 	}
-	return false
+	return nil
 }
 
-type Status struct {
+// AuditLogEntry records a privileged action: who called which method on what,
+// and the state of the changed record before and after the action. Entries are never changed.
+type AuditLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"` // zero for actions not tied to a course
+	ActorID  uint64 `protobuf:"varint,3,opt,name=actorID,proto3" json:"actorID,omitempty"`   // ID of the user that performed the action
+	UserID   uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`     // ID of the user affected by the action; zero if none
+	Action   string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`      // name of the called method
+	Target   string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`      // type and ID of the changed record, or the type of the request
+	Before   string `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`      // changed record before the action, JSON encoded; empty if not recorded
+	After    string `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`        // changed record after the action, or the request, JSON encoded
+	Date     string `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[43]
+		mi := &file_ag_ag_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status) String() string {
+func (x *AuditLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*AuditLogEntry) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[43]
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,114 +3792,99 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Status) GetCode() uint64 {
+func (x *AuditLogEntry) GetID() uint64 {
 	if x != nil {
-		return x.Code
+		return x.ID
 	}
 	return 0
 }
 
-func (x *Status) GetError() string {
+func (x *AuditLogEntry) GetCourseID() uint64 {
 	if x != nil {
-		return x.Error
+		return x.CourseID
 	}
-	return ""
-}
-
-type SubmissionsForCourseRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
-	SkipBuildInfo bool                             `protobuf:"varint,3,opt,name=skipBuildInfo,proto3" json:"skipBuildInfo,omitempty"`
+	return 0
 }
 
-func (x *SubmissionsForCourseRequest) Reset() {
-	*x = SubmissionsForCourseRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuditLogEntry) GetActorID() uint64 {
+	if x != nil {
+		return x.ActorID
 	}
+	return 0
 }
 
-func (x *SubmissionsForCourseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditLogEntry) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
 }
 
-func (*SubmissionsForCourseRequest) ProtoMessage() {}
-
-func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AuditLogEntry) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+func (x *AuditLogEntry) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
 }
 
-func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
+func (x *AuditLogEntry) GetBefore() string {
 	if x != nil {
-		return x.CourseID
+		return x.Before
 	}
-	return 0
+	return ""
 }
 
-func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
+func (x *AuditLogEntry) GetAfter() string {
 	if x != nil {
-		return x.Type
+		return x.After
 	}
-	return SubmissionsForCourseRequest_ALL
+	return ""
 }
 
-func (x *SubmissionsForCourseRequest) GetSkipBuildInfo() bool {
+func (x *AuditLogEntry) GetDate() string {
 	if x != nil {
-		return x.SkipBuildInfo
+		return x.Date
 	}
-	return false
+	return ""
 }
 
-type RebuildRequest struct {
+type AuditLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Entries []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *RebuildRequest) Reset() {
-	*x = RebuildRequest{}
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[45]
+		mi := &file_ag_ag_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebuildRequest) String() string {
+func (x *AuditLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebuildRequest) ProtoMessage() {}
+func (*AuditLog) ProtoMessage() {}
 
-func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[45]
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3536,52 +3895,44 @@ func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
-func (*RebuildRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *RebuildRequest) GetSubmissionID() uint64 {
-	if x != nil {
-		return x.SubmissionID
-	}
-	return 0
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RebuildRequest) GetAssignmentID() uint64 {
+func (x *AuditLog) GetEntries() []*AuditLogEntry {
 	if x != nil {
-		return x.AssignmentID
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-type CourseUserRequest struct {
+type SubmissionLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
-	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
-	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+	Assignment *Assignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Submission *Submission `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
 }
 
-func (x *CourseUserRequest) Reset() {
-	*x = CourseUserRequest{}
+func (x *SubmissionLink) Reset() {
+	*x = SubmissionLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[46]
+		mi := &file_ag_ag_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseUserRequest) String() string {
+func (x *SubmissionLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseUserRequest) ProtoMessage() {}
+func (*SubmissionLink) ProtoMessage() {}
 
-func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[46]
+func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3592,58 +3943,51 @@ func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
-func (*CourseUserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use SubmissionLink.ProtoReflect.Descriptor instead.
+func (*SubmissionLink) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CourseUserRequest) GetCourseCode() string {
+func (x *SubmissionLink) GetAssignment() *Assignment {
 	if x != nil {
-		return x.CourseCode
+		return x.Assignment
 	}
-	return ""
+	return nil
 }
 
-func (x *CourseUserRequest) GetCourseYear() uint32 {
+func (x *SubmissionLink) GetSubmission() *Submission {
 	if x != nil {
-		return x.CourseYear
+		return x.Submission
 	}
-	return 0
+	return nil
 }
 
-func (x *CourseUserRequest) GetUserLogin() string {
-	if x != nil {
-		return x.UserLogin
-	}
-	return ""
-}
-
-type AssignmentRequest struct {
+type EnrollmentLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Enrollment  *Enrollment       `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // must have course and user/group preloaded
+	Submissions []*SubmissionLink `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
 }
 
-func (x *AssignmentRequest) Reset() {
-	*x = AssignmentRequest{}
+func (x *EnrollmentLink) Reset() {
+	*x = EnrollmentLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[47]
+		mi := &file_ag_ag_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignmentRequest) String() string {
+func (x *EnrollmentLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignmentRequest) ProtoMessage() {}
+func (*EnrollmentLink) ProtoMessage() {}
 
-func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[47]
+func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3654,51 +3998,51 @@ func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
-func (*AssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use EnrollmentLink.ProtoReflect.Descriptor instead.
+func (*EnrollmentLink) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AssignmentRequest) GetCourseID() uint64 {
+func (x *EnrollmentLink) GetEnrollment() *Enrollment {
 	if x != nil {
-		return x.CourseID
+		return x.Enrollment
 	}
-	return 0
+	return nil
 }
 
-func (x *AssignmentRequest) GetAssignmentID() uint64 {
+func (x *EnrollmentLink) GetSubmissions() []*SubmissionLink {
 	if x != nil {
-		return x.AssignmentID
+		return x.Submissions
 	}
-	return 0
+	return nil
 }
 
-// Void contains no fields. A server response with a Void still contains a gRPC status code,
-// which can be checked for success or failure. Status code 0 indicates that the requested action was successful,
-// whereas any other status code indicates some failure. As such, the status code can be used as a boolean result from the server.
-type Void struct {
+type CourseSubmissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Course *Course           `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // preloaded assignments
+	Links  []*EnrollmentLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
 }
 
-func (x *Void) Reset() {
-	*x = Void{}
+func (x *CourseSubmissions) Reset() {
+	*x = CourseSubmissions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[48]
+		mi := &file_ag_ag_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Void) String() string {
+func (x *CourseSubmissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Void) ProtoMessage() {}
+func (*CourseSubmissions) ProtoMessage() {}
 
-func (x *Void) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[48]
+func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3709,883 +4053,6311 @@ func (x *Void) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Void.ProtoReflect.Descriptor instead.
-func (*Void) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use CourseSubmissions.ProtoReflect.Descriptor instead.
+func (*CourseSubmissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{42}
 }
 
-var File_ag_ag_proto protoreflect.FileDescriptor
+func (x *CourseSubmissions) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
 
-var file_ag_ag_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x61, 0x67, 0x2f, 0x61, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61,
-	0x67, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52,
-	0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x05, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f,
-	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
-	0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
-	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x92, 0x03, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
-	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5,
-	0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
-	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01,
-	0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xea, 0x03,
-	0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
-	0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x45, 0x0a, 0x08,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0xa2,
-	0x01, 0x07, 0x73, 0x71, 0x6c, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a, 0x0a,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64,
-	0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0e,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22,
-	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x49, 0x44, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64,
-	0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48,
-	0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a, 0xca,
-	0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f,
-	0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
-	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x49,
-	0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d,
-	0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10,
-	0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47,
-	0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22, 0x88, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78,
-	0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63,
-	0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x11, 0x73, 0x6c,
-	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0xa2, 0x01, 0x07, 0x73, 0x71,
-	0x6c, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52,
-	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73,
-	0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
-	0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
-	0x22, 0x3d, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54,
-	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x22,
-	0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49,
-	0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c,
-	0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10,
-	0x03, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
-	0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73,
-	0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3f,
-	0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a,
-	0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0x70, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e,
-	0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22,
-	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xc0, 0x03, 0x0a,
-	0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75,
-	0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x12,
-	0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a,
-	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
-	0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30,
-	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x99, 0x04, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12,
-	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22,
-	0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x3c,
-	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x52, 0x45, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x01,
-	0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24,
-	0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x49, 0x44, 0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x42,
-	0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x29, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x22, 0xa3, 0x02, 0x0a, 0x06, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x65, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x49, 0x44, 0x22, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74,
-	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
-	0x22, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a,
-	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a,
-	0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f,
-	0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x22, 0x47, 0x0a, 0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67,
-	0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a,
-	0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e,
-	0x0a, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22,
-	0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x22, 0x29, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a,
-	0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x77, 0x0a,
-	0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a,
-	0x04, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x55, 0x52,
-	0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x1a, 0x37, 0x0a,
-	0x09, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55,
-	0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x22,
-	0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x53, 0x0a, 0x11,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xad, 0x12, 0x0a, 0x11, 0x41, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00,
-	0x12, 0x21, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61,
-	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x0a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3c, 0x0a,
-	0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00,
-	0x12, 0x24, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12,
-	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61,
-	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
-	0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
-	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
-	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15,
-	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
-	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61,
-	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e,
-	0x61, 0x67, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
-	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a,
-	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
-	0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67,
-	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
-	0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a,
-	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
-	0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x29,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61,
-	0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67, 0xba, 0x02,
-	0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CourseSubmissions) GetLinks() []*EnrollmentLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
 }
 
-var (
-	file_ag_ag_proto_rawDescOnce sync.Once
-	file_ag_ag_proto_rawDescData = file_ag_ag_proto_rawDesc
-)
+type Assignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_ag_ag_proto_rawDescGZIP() []byte {
-	file_ag_ag_proto_rawDescOnce.Do(func() {
-		file_ag_ag_proto_rawDescData = protoimpl.X.CompressGZIP(file_ag_ag_proto_rawDescData)
-	})
-	return file_ag_ag_proto_rawDescData
+	ID                uint64                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64                `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
+	Name              string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ScriptFile        string                `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
+	Deadline          string                `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AutoApprove       bool                  `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
+	Order             uint32                `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	IsGroupLab        bool                  `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	ScoreLimit        uint32                `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`                                // minimal score limit for auto approval
+	Reviewers         uint32                `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                                 // number of reviewers that will review submissions for this assignment
+	Submissions       []*Submission         `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`                              // submissions produced for this assignment
+	GradingBenchmarks []*GradingBenchmark   `protobuf:"bytes,12,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`                  // grading benchmarks for this assignment
+	ContainerTimeout  uint32                `protobuf:"varint,13,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`                   // TODO(meling) Do we need this?
+	LatePolicy        Assignment_LatePolicy `protobuf:"varint,14,opt,name=latePolicy,proto3,enum=ag.Assignment_LatePolicy" json:"latePolicy,omitempty"` // policy for submissions delivered after the deadline
+	LatePenalty       uint32                `protobuf:"varint,15,opt,name=latePenalty,proto3" json:"latePenalty,omitempty"`                             // percentage deducted from the score for each day late (PENALTY policy)
+	LateDays          uint32                `protobuf:"varint,16,opt,name=lateDays,proto3" json:"lateDays,omitempty"`                                   // days after the deadline when late submissions get zero score (PENALTY and CUTOFF policies)
+	PeerReviewers     uint32                `protobuf:"varint,17,opt,name=peerReviewers,proto3" json:"peerReviewers,omitempty"`                         // number of anonymous student reviewers for each submission; zero disables peer review
+	RubricTemplateID  uint64                `protobuf:"varint,18,opt,name=rubricTemplateID,proto3" json:"rubricTemplateID,omitempty"`                   // rubric template version that the grading benchmarks were last loaded from
+	BlindReview       bool                  `protobuf:"varint,19,opt,name=blindReview,proto3" json:"blindReview,omitempty"`                             // hide the identity of teacher reviewers from each other
+	GroupSet          string                `protobuf:"bytes,20,opt,name=groupSet,proto3" json:"groupSet,omitempty"`                                    // group set whose groups submit this group assignment; empty for the course's default groups
 }
 
-var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
-var file_ag_ag_proto_goTypes = []interface{}{
-	(Group_GroupStatus)(0),                // 0: ag.Group.GroupStatus
-	(Repository_Type)(0),                  // 1: ag.Repository.Type
-	(Enrollment_UserStatus)(0),            // 2: ag.Enrollment.UserStatus
-	(Enrollment_DisplayState)(0),          // 3: ag.Enrollment.DisplayState
-	(Submission_Status)(0),                // 4: ag.Submission.Status
-	(GradingCriterion_Grade)(0),           // 5: ag.GradingCriterion.Grade
-	(SubmissionsForCourseRequest_Type)(0), // 6: ag.SubmissionsForCourseRequest.Type
-	(*User)(nil),                          // 7: ag.User
-	(*Users)(nil),                         // 8: ag.Users
-	(*RemoteIdentity)(nil),                // 9: ag.RemoteIdentity
-	(*Group)(nil),                         // 10: ag.Group
-	(*Groups)(nil),                        // 11: ag.Groups
-	(*Course)(nil),                        // 12: ag.Course
-	(*Courses)(nil),                       // 13: ag.Courses
-	(*Repository)(nil),                    // 14: ag.Repository
-	(*Enrollment)(nil),                    // 15: ag.Enrollment
-	(*UsedSlipDays)(nil),                  // 16: ag.UsedSlipDays
-	(*Enrollments)(nil),                   // 17: ag.Enrollments
-	(*SubmissionLink)(nil),                // 18: ag.SubmissionLink
-	(*EnrollmentLink)(nil),                // 19: ag.EnrollmentLink
-	(*CourseSubmissions)(nil),             // 20: ag.CourseSubmissions
-	(*Assignment)(nil),                    // 21: ag.Assignment
-	(*Assignments)(nil),                   // 22: ag.Assignments
-	(*Submission)(nil),                    // 23: ag.Submission
-	(*Submissions)(nil),                   // 24: ag.Submissions
-	(*GradingBenchmark)(nil),              // 25: ag.GradingBenchmark
-	(*Benchmarks)(nil),                    // 26: ag.Benchmarks
-	(*GradingCriterion)(nil),              // 27: ag.GradingCriterion
-	(*Review)(nil),                        // 28: ag.Review
-	(*Reviewers)(nil),                     // 29: ag.Reviewers
-	(*ReviewRequest)(nil),                 // 30: ag.ReviewRequest
-	(*CourseRequest)(nil),                 // 31: ag.CourseRequest
-	(*UserRequest)(nil),                   // 32: ag.UserRequest
-	(*GetGroupRequest)(nil),               // 33: ag.GetGroupRequest
-	(*GroupRequest)(nil),                  // 34: ag.GroupRequest
-	(*Provider)(nil),                      // 35: ag.Provider
-	(*OrgRequest)(nil),                    // 36: ag.OrgRequest
-	(*Organization)(nil),                  // 37: ag.Organization
-	(*Organizations)(nil),                 // 38: ag.Organizations
-	(*EnrollmentRequest)(nil),             // 39: ag.EnrollmentRequest
-	(*EnrollmentStatusRequest)(nil),       // 40: ag.EnrollmentStatusRequest
-	(*SubmissionRequest)(nil),             // 41: ag.SubmissionRequest
-	(*UpdateSubmissionRequest)(nil),       // 42: ag.UpdateSubmissionRequest
-	(*UpdateSubmissionsRequest)(nil),      // 43: ag.UpdateSubmissionsRequest
-	(*SubmissionReviewersRequest)(nil),    // 44: ag.SubmissionReviewersRequest
-	(*Providers)(nil),                     // 45: ag.Providers
-	(*URLRequest)(nil),                    // 46: ag.URLRequest
-	(*RepositoryRequest)(nil),             // 47: ag.RepositoryRequest
-	(*Repositories)(nil),                  // 48: ag.Repositories
-	(*AuthorizationResponse)(nil),         // 49: ag.AuthorizationResponse
-	(*Status)(nil),                        // 50: ag.Status
-	(*SubmissionsForCourseRequest)(nil),   // 51: ag.SubmissionsForCourseRequest
-	(*RebuildRequest)(nil),                // 52: ag.RebuildRequest
-	(*CourseUserRequest)(nil),             // 53: ag.CourseUserRequest
-	(*AssignmentRequest)(nil),             // 54: ag.AssignmentRequest
-	(*Void)(nil),                          // 55: ag.Void
-	nil,                                   // 56: ag.Repositories.URLsEntry
-	(*score.BuildInfo)(nil),               // 57: score.BuildInfo
-	(*score.Score)(nil),                   // 58: score.Score
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_ag_ag_proto_depIdxs = []int32{
-	9,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
-	15, // 1: ag.User.enrollments:type_name -> ag.Enrollment
-	7,  // 2: ag.Users.users:type_name -> ag.User
-	0,  // 3: ag.Group.status:type_name -> ag.Group.GroupStatus
-	7,  // 4: ag.Group.users:type_name -> ag.User
-	15, // 5: ag.Group.enrollments:type_name -> ag.Enrollment
-	10, // 6: ag.Groups.groups:type_name -> ag.Group
-	2,  // 7: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
-	15, // 8: ag.Course.enrollments:type_name -> ag.Enrollment
-	21, // 9: ag.Course.assignments:type_name -> ag.Assignment
-	10, // 10: ag.Course.groups:type_name -> ag.Group
-	12, // 11: ag.Courses.courses:type_name -> ag.Course
-	1,  // 12: ag.Repository.repoType:type_name -> ag.Repository.Type
-	7,  // 13: ag.Enrollment.user:type_name -> ag.User
-	12, // 14: ag.Enrollment.course:type_name -> ag.Course
-	10, // 15: ag.Enrollment.group:type_name -> ag.Group
-	2,  // 16: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
-	3,  // 17: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
-	16, // 18: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
-	15, // 19: ag.Enrollments.enrollments:type_name -> ag.Enrollment
-	21, // 20: ag.SubmissionLink.assignment:type_name -> ag.Assignment
-	23, // 21: ag.SubmissionLink.submission:type_name -> ag.Submission
-	15, // 22: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
-	18, // 23: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
-	12, // 24: ag.CourseSubmissions.course:type_name -> ag.Course
-	19, // 25: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
-	23, // 26: ag.Assignment.submissions:type_name -> ag.Submission
-	25, // 27: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	21, // 28: ag.Assignments.assignments:type_name -> ag.Assignment
-	4,  // 29: ag.Submission.status:type_name -> ag.Submission.Status
-	28, // 30: ag.Submission.reviews:type_name -> ag.Review
-	57, // 31: ag.Submission.BuildInfo:type_name -> score.BuildInfo
-	58, // 32: ag.Submission.Scores:type_name -> score.Score
-	23, // 33: ag.Submissions.submissions:type_name -> ag.Submission
-	27, // 34: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
-	25, // 35: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
-	5,  // 36: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
-	25, // 37: ag.Review.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	7,  // 38: ag.Reviewers.reviewers:type_name -> ag.User
-	28, // 39: ag.ReviewRequest.review:type_name -> ag.Review
-	37, // 40: ag.Organizations.organizations:type_name -> ag.Organization
-	2,  // 41: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	2,  // 42: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	4,  // 43: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
-	1,  // 44: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
-	56, // 45: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
-	6,  // 46: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
-	55, // 47: ag.AutograderService.GetUser:input_type -> ag.Void
-	55, // 48: ag.AutograderService.GetUsers:input_type -> ag.Void
-	53, // 49: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
-	7,  // 50: ag.AutograderService.UpdateUser:input_type -> ag.User
-	55, // 51: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
-	33, // 52: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
-	34, // 53: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
-	31, // 54: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
-	10, // 55: ag.AutograderService.CreateGroup:input_type -> ag.Group
-	10, // 56: ag.AutograderService.UpdateGroup:input_type -> ag.Group
-	34, // 57: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
-	31, // 58: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
-	55, // 59: ag.AutograderService.GetCourses:input_type -> ag.Void
-	40, // 60: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
-	12, // 61: ag.AutograderService.CreateCourse:input_type -> ag.Course
-	12, // 62: ag.AutograderService.UpdateCourse:input_type -> ag.Course
-	15, // 63: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
-	31, // 64: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
-	31, // 65: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
-	40, // 66: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
-	39, // 67: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
-	15, // 68: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
-	15, // 69: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
-	31, // 70: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
-	41, // 71: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
-	51, // 72: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
-	42, // 73: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
-	43, // 74: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
-	52, // 75: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
-	54, // 76: ag.AutograderService.RebuildSubmissions:input_type -> ag.AssignmentRequest
-	25, // 77: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 78: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 79: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
-	27, // 80: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
-	27, // 81: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
-	27, // 82: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
-	30, // 83: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
-	30, // 84: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
-	44, // 85: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
-	54, // 86: ag.AutograderService.LoadCriteria:input_type -> ag.AssignmentRequest
-	55, // 87: ag.AutograderService.GetProviders:input_type -> ag.Void
-	36, // 88: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
-	46, // 89: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
-	47, // 90: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
-	7,  // 91: ag.AutograderService.GetUser:output_type -> ag.User
-	8,  // 92: ag.AutograderService.GetUsers:output_type -> ag.Users
-	7,  // 93: ag.AutograderService.GetUserByCourse:output_type -> ag.User
-	55, // 94: ag.AutograderService.UpdateUser:output_type -> ag.Void
-	49, // 95: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
-	10, // 96: ag.AutograderService.GetGroup:output_type -> ag.Group
-	10, // 97: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
-	11, // 98: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
-	10, // 99: ag.AutograderService.CreateGroup:output_type -> ag.Group
-	55, // 100: ag.AutograderService.UpdateGroup:output_type -> ag.Void
-	55, // 101: ag.AutograderService.DeleteGroup:output_type -> ag.Void
-	12, // 102: ag.AutograderService.GetCourse:output_type -> ag.Course
-	13, // 103: ag.AutograderService.GetCourses:output_type -> ag.Courses
-	13, // 104: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
-	12, // 105: ag.AutograderService.CreateCourse:output_type -> ag.Course
-	55, // 106: ag.AutograderService.UpdateCourse:output_type -> ag.Void
-	55, // 107: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
-	22, // 108: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
-	55, // 109: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
-	17, // 110: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
-	17, // 111: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
-	55, // 112: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
-	55, // 113: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
-	55, // 114: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
-	24, // 115: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
-	20, // 116: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
-	55, // 117: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
-	55, // 118: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
-	23, // 119: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
-	55, // 120: ag.AutograderService.RebuildSubmissions:output_type -> ag.Void
-	25, // 121: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
-	55, // 122: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
-	55, // 123: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
-	27, // 124: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
-	55, // 125: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
-	55, // 126: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
-	28, // 127: ag.AutograderService.CreateReview:output_type -> ag.Review
-	28, // 128: ag.AutograderService.UpdateReview:output_type -> ag.Review
-	29, // 129: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
-	26, // 130: ag.AutograderService.LoadCriteria:output_type -> ag.Benchmarks
-	45, // 131: ag.AutograderService.GetProviders:output_type -> ag.Providers
-	37, // 132: ag.AutograderService.GetOrganization:output_type -> ag.Organization
-	48, // 133: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
-	55, // 134: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
-	91, // [91:135] is the sub-list for method output_type
-	47, // [47:91] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_ag_ag_proto_init() }
-func file_ag_ag_proto_init() {
-	if File_ag_ag_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_ag_ag_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Assignment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Assignment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Assignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Assignment) GetScriptFile() string {
+	if x != nil {
+		return x.ScriptFile
+	}
+	return ""
+}
+
+func (x *Assignment) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *Assignment) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+func (x *Assignment) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Assignment) GetIsGroupLab() bool {
+	if x != nil {
+		return x.IsGroupLab
+	}
+	return false
+}
+
+func (x *Assignment) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetReviewers() uint32 {
+	if x != nil {
+		return x.Reviewers
+	}
+	return 0
+}
+
+func (x *Assignment) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Assignment) GetContainerTimeout() uint32 {
+	if x != nil {
+		return x.ContainerTimeout
+	}
+	return 0
+}
+
+func (x *Assignment) GetLatePolicy() Assignment_LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return Assignment_NONE
+}
+
+func (x *Assignment) GetLatePenalty() uint32 {
+	if x != nil {
+		return x.LatePenalty
+	}
+	return 0
+}
+
+func (x *Assignment) GetLateDays() uint32 {
+	if x != nil {
+		return x.LateDays
+	}
+	return 0
+}
+
+func (x *Assignment) GetPeerReviewers() uint32 {
+	if x != nil {
+		return x.PeerReviewers
+	}
+	return 0
+}
+
+func (x *Assignment) GetRubricTemplateID() uint64 {
+	if x != nil {
+		return x.RubricTemplateID
+	}
+	return 0
+}
+
+func (x *Assignment) GetBlindReview() bool {
+	if x != nil {
+		return x.BlindReview
+	}
+	return false
+}
+
+func (x *Assignment) GetGroupSet() string {
+	if x != nil {
+		return x.GroupSet
+	}
+	return ""
+}
+
+type Assignments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *Assignments) Reset() {
+	*x = Assignments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignments) ProtoMessage() {}
+
+func (x *Assignments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
+func (*Assignments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Assignments) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// ReleaseSchedule schedules the release of reviews and scores for an assignment,
+// either at a fixed time or a number of days after the assignment's deadline.
+type ReleaseSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID      uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex"`
+	ReleaseTime       string `protobuf:"bytes,4,opt,name=releaseTime,proto3" json:"releaseTime,omitempty"`              // time of release; ignored if daysAfterDeadline is set
+	DaysAfterDeadline uint32 `protobuf:"varint,5,opt,name=daysAfterDeadline,proto3" json:"daysAfterDeadline,omitempty"` // days after the assignment's deadline to release
+	ScoreLimit        uint32 `protobuf:"varint,6,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`               // only release submissions with score equal or above the limit
+	Approve           bool   `protobuf:"varint,7,opt,name=approve,proto3" json:"approve,omitempty"`                     // also approve the released submissions
+	ReleasedDate      string `protobuf:"bytes,8,opt,name=releasedDate,proto3" json:"releasedDate,omitempty"`            // time when the release was performed; empty if still scheduled
+}
+
+func (x *ReleaseSchedule) Reset() {
+	*x = ReleaseSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSchedule) ProtoMessage() {}
+
+func (x *ReleaseSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSchedule.ProtoReflect.Descriptor instead.
+func (*ReleaseSchedule) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReleaseSchedule) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ReleaseSchedule) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReleaseSchedule) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ReleaseSchedule) GetReleaseTime() string {
+	if x != nil {
+		return x.ReleaseTime
+	}
+	return ""
+}
+
+func (x *ReleaseSchedule) GetDaysAfterDeadline() uint32 {
+	if x != nil {
+		return x.DaysAfterDeadline
+	}
+	return 0
+}
+
+func (x *ReleaseSchedule) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *ReleaseSchedule) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReleaseSchedule) GetReleasedDate() string {
+	if x != nil {
+		return x.ReleasedDate
+	}
+	return ""
+}
+
+type ReleaseSchedules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*ReleaseSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *ReleaseSchedules) Reset() {
+	*x = ReleaseSchedules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseSchedules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSchedules) ProtoMessage() {}
+
+func (x *ReleaseSchedules) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSchedules.ProtoReflect.Descriptor instead.
+func (*ReleaseSchedules) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReleaseSchedules) GetSchedules() []*ReleaseSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64            `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	UserID       uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID      uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Score        uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	CommitHash   string            `protobuf:"bytes,8,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	ScoreObjects string            `protobuf:"bytes,6,opt,name=scoreObjects,proto3" json:"scoreObjects,omitempty"` // deprecated: do not use
+	OldBuildInfo string            `protobuf:"bytes,7,opt,name=oldBuildInfo,proto3" json:"oldBuildInfo,omitempty"` // deprecated: do not use
+	Released     bool              `protobuf:"varint,9,opt,name=released,proto3" json:"released,omitempty"`        // true => feedback is visible to the student or group members
+	Status       Submission_Status `protobuf:"varint,10,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	ApprovedDate string            `protobuf:"bytes,11,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
+	Reviews      []*Review         `protobuf:"bytes,12,rep,name=reviews,proto3" json:"reviews,omitempty"`          // reviews produced for this submission
+	BuildInfo    *score.BuildInfo  `protobuf:"bytes,13,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"`      // build info for tests
+	Scores       []*score.Score    `protobuf:"bytes,14,rep,name=Scores,proto3" json:"Scores,omitempty"`            // list of scores for different tests
+	RawScore     uint32            `protobuf:"varint,15,opt,name=rawScore,proto3" json:"rawScore,omitempty"`       // score before applying the assignment's late policy
+	PeerScore    uint32            `protobuf:"varint,16,opt,name=peerScore,proto3" json:"peerScore,omitempty"`     // average score of the ready peer reviews not excluded by teachers
+	PullRequest  uint64            `protobuf:"varint,17,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"` // number of the pull request the submission was submitted with, if any
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Submission) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Submission) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Submission) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *Submission) GetScoreObjects() string {
+	if x != nil {
+		return x.ScoreObjects
+	}
+	return ""
+}
+
+func (x *Submission) GetOldBuildInfo() string {
+	if x != nil {
+		return x.OldBuildInfo
+	}
+	return ""
+}
+
+func (x *Submission) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *Submission) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+func (x *Submission) GetApprovedDate() string {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return ""
+}
+
+func (x *Submission) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Submission) GetBuildInfo() *score.BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *Submission) GetScores() []*score.Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Submission) GetRawScore() uint32 {
+	if x != nil {
+		return x.RawScore
+	}
+	return 0
+}
+
+func (x *Submission) GetPeerScore() uint32 {
+	if x != nil {
+		return x.PeerScore
+	}
+	return 0
+}
+
+func (x *Submission) GetPullRequest() uint64 {
+	if x != nil {
+		return x.PullRequest
+	}
+	return 0
+}
+
+type Submissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *Submissions) Reset() {
+	*x = Submissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submissions) ProtoMessage() {}
+
+func (x *Submissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Submissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type GradingBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64              `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	ReviewID     uint64              `protobuf:"varint,3,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
+	Heading      string              `protobuf:"bytes,4,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment      string              `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria     []*GradingCriterion `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	TemplateID   uint64              `protobuf:"varint,7,opt,name=TemplateID,proto3" json:"TemplateID,omitempty"` // foreign key
+}
+
+func (x *GradingBenchmark) Reset() {
+	*x = GradingBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingBenchmark) ProtoMessage() {}
+
+func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GradingBenchmark) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *GradingBenchmark) GetTemplateID() uint64 {
+	if x != nil {
+		return x.TemplateID
+	}
+	return 0
+}
+
+type Benchmarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+}
+
+func (x *Benchmarks) Reset() {
+	*x = Benchmarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Benchmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmarks) ProtoMessage() {}
+
+func (x *Benchmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
+func (*Benchmarks) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+// RubricTemplate is a reusable set of grading benchmarks. Templates are never changed;
+// instead, a new version of the template is created.
+type RubricTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID   uint64              `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_rubric_version"`
+	Name       string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_rubric_version"`
+	Version    uint32              `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty" gorm:"uniqueIndex:idx_unique_rubric_version"`
+	Shared     bool                `protobuf:"varint,5,opt,name=shared,proto3" json:"shared,omitempty"` // template can be used by teachers of other courses
+	CreatorID  uint64              `protobuf:"varint,6,opt,name=creatorID,proto3" json:"creatorID,omitempty"`
+	Benchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=benchmarks,proto3" json:"benchmarks,omitempty" gorm:"foreignKey:TemplateID"`
+}
+
+func (x *RubricTemplate) Reset() {
+	*x = RubricTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RubricTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricTemplate) ProtoMessage() {}
+
+func (x *RubricTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricTemplate.ProtoReflect.Descriptor instead.
+func (*RubricTemplate) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RubricTemplate) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RubricTemplate) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RubricTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RubricTemplate) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RubricTemplate) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *RubricTemplate) GetCreatorID() uint64 {
+	if x != nil {
+		return x.CreatorID
+	}
+	return 0
+}
+
+func (x *RubricTemplate) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+type RubricTemplates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*RubricTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *RubricTemplates) Reset() {
+	*x = RubricTemplates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RubricTemplates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricTemplates) ProtoMessage() {}
+
+func (x *RubricTemplates) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricTemplates.ProtoReflect.Descriptor instead.
+func (*RubricTemplates) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RubricTemplates) GetTemplates() []*RubricTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GradingCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
+	Points      uint64                 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
+	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *GradingCriterion) Reset() {
+	*x = GradingCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingCriterion) ProtoMessage() {}
+
+func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
+func (*GradingCriterion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GradingCriterion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetBenchmarkID() uint64 {
+	if x != nil {
+		return x.BenchmarkID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradingCriterion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID      uint64              `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	ReviewerID        uint64              `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
+	Feedback          string              `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Ready             bool                `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Score             uint32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
+	Edited            string              `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty"`
+	PeerReview        bool                `protobuf:"varint,9,opt,name=peerReview,proto3" json:"peerReview,omitempty"` // review made by a student peer reviewer
+	Outlier           bool                `protobuf:"varint,10,opt,name=outlier,proto3" json:"outlier,omitempty"`      // peer review score deviates from the other peer reviews of the submission
+	Excluded          bool                `protobuf:"varint,11,opt,name=excluded,proto3" json:"excluded,omitempty"`    // peer review excluded from the peer score by a teacher
+	CodeComments      []*CodeComment      `protobuf:"bytes,12,rep,name=codeComments,proto3" json:"codeComments,omitempty" gorm:"foreignKey:ReviewID"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Review) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Review) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Review) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *Review) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Review) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Review) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Review) GetEdited() string {
+	if x != nil {
+		return x.Edited
+	}
+	return ""
+}
+
+func (x *Review) GetPeerReview() bool {
+	if x != nil {
+		return x.PeerReview
+	}
+	return false
+}
+
+func (x *Review) GetOutlier() bool {
+	if x != nil {
+		return x.Outlier
+	}
+	return false
+}
+
+func (x *Review) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+func (x *Review) GetCodeComments() []*CodeComment {
+	if x != nil {
+		return x.CodeComments
+	}
+	return nil
+}
+
+// CodeComment is a reviewer's comment on a range of lines in a file of the reviewed commit.
+type CodeComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ReviewID   uint64 `protobuf:"varint,2,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`
+	Path       string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"` // path of the file relative to the repository root
+	CommitHash string `protobuf:"bytes,4,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	StartLine  uint32 `protobuf:"varint,5,opt,name=startLine,proto3" json:"startLine,omitempty"`
+	EndLine    uint32 `protobuf:"varint,6,opt,name=endLine,proto3" json:"endLine,omitempty"`
+	Comment    string `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Published  bool   `protobuf:"varint,8,opt,name=published,proto3" json:"published,omitempty"` // comment published as a commit comment in the student's repository
+}
+
+func (x *CodeComment) Reset() {
+	*x = CodeComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeComment) ProtoMessage() {}
+
+func (x *CodeComment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeComment.ProtoReflect.Descriptor instead.
+func (*CodeComment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CodeComment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CodeComment) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *CodeComment) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CodeComment) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *CodeComment) GetStartLine() uint32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *CodeComment) GetEndLine() uint32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+func (x *CodeComment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CodeComment) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+type CodeComments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*CodeComment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *CodeComments) Reset() {
+	*x = CodeComments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeComments) ProtoMessage() {}
+
+func (x *CodeComments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeComments.ProtoReflect.Descriptor instead.
+func (*CodeComments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CodeComments) GetComments() []*CodeComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// PeerReviewer assigns a student to review a submission anonymously.
+type PeerReviewer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:idx_unique_peer_reviewer"`
+	SubmissionID uint64 `protobuf:"varint,3,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:idx_unique_peer_reviewer"`
+	ReviewerID   uint64 `protobuf:"varint,4,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty" gorm:"uniqueIndex:idx_unique_peer_reviewer"` // UserID of the student reviewer
+}
+
+func (x *PeerReviewer) Reset() {
+	*x = PeerReviewer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReviewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewer) ProtoMessage() {}
+
+func (x *PeerReviewer) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewer.ProtoReflect.Descriptor instead.
+func (*PeerReviewer) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PeerReviewer) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PeerReviewer) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *PeerReviewer) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *PeerReviewer) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+// ReviewTask assigns a teacher or teaching assistant to manually review a submission.
+type ReviewTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,3,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:idx_unique_review_task"`
+	ReviewerID   uint64 `protobuf:"varint,4,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty" gorm:"uniqueIndex:idx_unique_review_task"` // UserID of the teacher reviewer
+}
+
+func (x *ReviewTask) Reset() {
+	*x = ReviewTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTask) ProtoMessage() {}
+
+func (x *ReviewTask) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTask.ProtoReflect.Descriptor instead.
+func (*ReviewTask) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReviewTask) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ReviewTask) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ReviewTask) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *ReviewTask) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+// ReviewerProgress holds the review progress of a single reviewer for an assignment.
+type ReviewerProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewerID uint64 `protobuf:"varint,1,opt,name=reviewerID,proto3" json:"reviewerID,omitempty"`
+	Assigned   uint32 `protobuf:"varint,2,opt,name=assigned,proto3" json:"assigned,omitempty"` // number of submissions assigned to the reviewer
+	Reviewed   uint32 `protobuf:"varint,3,opt,name=reviewed,proto3" json:"reviewed,omitempty"` // number of assigned submissions with a review by the reviewer
+	Ready      uint32 `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`       // number of assigned submissions with a ready review by the reviewer
+}
+
+func (x *ReviewerProgress) Reset() {
+	*x = ReviewerProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewerProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerProgress) ProtoMessage() {}
+
+func (x *ReviewerProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerProgress.ProtoReflect.Descriptor instead.
+func (*ReviewerProgress) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReviewerProgress) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *ReviewerProgress) GetAssigned() uint32 {
+	if x != nil {
+		return x.Assigned
+	}
+	return 0
+}
+
+func (x *ReviewerProgress) GetReviewed() uint32 {
+	if x != nil {
+		return x.Reviewed
+	}
+	return 0
+}
+
+func (x *ReviewerProgress) GetReady() uint32 {
+	if x != nil {
+		return x.Ready
+	}
+	return 0
+}
+
+type ReviewProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewers []*ReviewerProgress `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+}
+
+func (x *ReviewProgress) Reset() {
+	*x = ReviewProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewProgress) ProtoMessage() {}
+
+func (x *ReviewProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewProgress.ProtoReflect.Descriptor instead.
+func (*ReviewProgress) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReviewProgress) GetReviewers() []*ReviewerProgress {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+// GradeOverride is the final grade of a grading criterion for a submission with several reviews,
+// chosen by the lead teacher. The criterion is identified by its benchmark heading and description.
+type GradeOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64                 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:idx_unique_grade_override"`
+	Heading      string                 `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty" gorm:"uniqueIndex:idx_unique_grade_override"`
+	Description  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" gorm:"uniqueIndex:idx_unique_grade_override"`
+	Grade        GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
+	TeacherID    uint64                 `protobuf:"varint,6,opt,name=teacherID,proto3" json:"teacherID,omitempty"`
+}
+
+func (x *GradeOverride) Reset() {
+	*x = GradeOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeOverride) ProtoMessage() {}
+
+func (x *GradeOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeOverride.ProtoReflect.Descriptor instead.
+func (*GradeOverride) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GradeOverride) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradeOverride) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *GradeOverride) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradeOverride) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradeOverride) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradeOverride) GetTeacherID() uint64 {
+	if x != nil {
+		return x.TeacherID
+	}
+	return 0
+}
+
+// CriterionReconciliation compares the grades given to a grading criterion by each ready review of a submission.
+type CriterionReconciliation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Heading      string                   `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	Description  string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Points       uint64                   `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Grades       []GradingCriterion_Grade `protobuf:"varint,4,rep,packed,name=grades,proto3,enum=ag.GradingCriterion_Grade" json:"grades,omitempty"` // grade given by each review, in the order of the reconciled reviews
+	Disagreement bool                     `protobuf:"varint,5,opt,name=disagreement,proto3" json:"disagreement,omitempty"`                           // the reviews gave different grades
+	Overridden   bool                     `protobuf:"varint,6,opt,name=overridden,proto3" json:"overridden,omitempty"`                               // the final grade was chosen by the lead teacher
+	Grade        GradingCriterion_Grade   `protobuf:"varint,7,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`          // final grade; NONE if the disagreement is not resolved
+}
+
+func (x *CriterionReconciliation) Reset() {
+	*x = CriterionReconciliation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriterionReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionReconciliation) ProtoMessage() {}
+
+func (x *CriterionReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionReconciliation.ProtoReflect.Descriptor instead.
+func (*CriterionReconciliation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CriterionReconciliation) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *CriterionReconciliation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CriterionReconciliation) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CriterionReconciliation) GetGrades() []GradingCriterion_Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *CriterionReconciliation) GetDisagreement() bool {
+	if x != nil {
+		return x.Disagreement
+	}
+	return false
+}
+
+func (x *CriterionReconciliation) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *CriterionReconciliation) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+// Reconciliation is the reconciled result of the ready teacher reviews of a submission.
+type Reconciliation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64                     `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	ReviewIDs    []uint64                   `protobuf:"varint,2,rep,packed,name=reviewIDs,proto3" json:"reviewIDs,omitempty"`
+	Criteria     []*CriterionReconciliation `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Resolved     bool                       `protobuf:"varint,4,opt,name=resolved,proto3" json:"resolved,omitempty"` // all criteria have a final grade
+	Score        uint32                     `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`       // score computed from the final grades
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Reconciliation) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetReviewIDs() []uint64 {
+	if x != nil {
+		return x.ReviewIDs
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetCriteria() []*CriterionReconciliation {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *Reconciliation) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type Reviewers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+}
+
+func (x *Reviewers) Reset() {
+	*x = Reviewers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reviewers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reviewers) ProtoMessage() {}
+
+func (x *Reviewers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
+func (*Reviewers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Reviewers) GetReviewers() []*User {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type ReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReviewRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type CourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *CourseRequest) Reset() {
+	*x = CourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRequest) ProtoMessage() {}
+
+func (x *CourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
+func (*CourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CourseRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type UserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UserRequest) Reset() {
+	*x = UserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRequest) ProtoMessage() {}
+
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UserRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type SessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	SessionID uint64 `protobuf:"varint,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"` // zero for all the user's sessions
+}
+
+func (x *SessionRequest) Reset() {
+	*x = SessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRequest) ProtoMessage() {}
+
+func (x *SessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRequest.ProtoReflect.Descriptor instead.
+func (*SessionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SessionRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SessionRequest) GetSessionID() uint64 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetGroupRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+type GroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupSet string `protobuf:"bytes,4,opt,name=groupSet,proto3" json:"groupSet,omitempty"` // group set of the user's group; empty for the course's default groups
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GroupRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetGroupSet() string {
+	if x != nil {
+		return x.GroupSet
+	}
+	return ""
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Provider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type OrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+}
+
+func (x *OrgRequest) Reset() {
+	*x = OrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgRequest) ProtoMessage() {}
+
+func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
+func (*OrgRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *OrgRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type Organization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Organization) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Organization) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Organization) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Organization) GetPaymentPlan() string {
+	if x != nil {
+		return x.PaymentPlan
+	}
+	return ""
+}
+
+type Organizations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+}
+
+func (x *Organizations) Reset() {
+	*x = Organizations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organizations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organizations) ProtoMessage() {}
+
+func (x *Organizations) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
+func (*Organizations) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Organizations) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// EnrollmentRequest is a request for enrolled users of a given course,
+// whose enrollment status match those provided in the request. To ignore group members
+// that otherwise match the enrollment request, set ignoreGroupMembers to true.
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
+	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
+	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentRequest) ProtoMessage() {}
+
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EnrollmentRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
+	if x != nil {
+		return x.IgnoreGroupMembers
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetWithActivity() bool {
+	if x != nil {
+		return x.WithActivity
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
+type EnrollmentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+}
+
+func (x *EnrollmentStatusRequest) Reset() {
+	*x = EnrollmentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentStatusRequest) ProtoMessage() {}
+
+func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EnrollmentStatusRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type SubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *SubmissionRequest) Reset() {
+	*x = SubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionRequest) ProtoMessage() {}
+
+func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SubmissionRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmissionRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *SubmissionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type UpdateSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+}
+
+func (x *UpdateSubmissionRequest) Reset() {
+	*x = UpdateSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubmissionRequest) ProtoMessage() {}
+
+func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+type UpdateSubmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
+	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
+	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *UpdateSubmissionsRequest) Reset() {
+	*x = UpdateSubmissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubmissionsRequest) ProtoMessage() {}
+
+func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+func (x *UpdateSubmissionsRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type SlipDaysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID       uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Change       int32  `protobuf:"varint,4,opt,name=change,proto3" json:"change,omitempty"` // slip days to add; negative to refund slip days
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SlipDaysRequest) Reset() {
+	*x = SlipDaysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlipDaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlipDaysRequest) ProtoMessage() {}
+
+func (x *SlipDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlipDaysRequest.ProtoReflect.Descriptor instead.
+func (*SlipDaysRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SlipDaysRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SlipDaysRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SlipDaysRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *SlipDaysRequest) GetChange() int32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *SlipDaysRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SlipDayLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *SlipDayLedgerRequest) Reset() {
+	*x = SlipDayLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlipDayLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlipDayLedgerRequest) ProtoMessage() {}
+
+func (x *SlipDayLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlipDayLedgerRequest.ProtoReflect.Descriptor instead.
+func (*SlipDayLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SlipDayLedgerRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SlipDayLedgerRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// AuditLogRequest is a request for the audit log entries matching all the given fields.
+// Fields with zero values are ignored; only admins can omit the course ID.
+type AuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"` // matches entries where the user is the actor or the affected user
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *AuditLogRequest) Reset() {
+	*x = AuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogRequest) ProtoMessage() {}
+
+func (x *AuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AuditLogRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type PeerReviewModerationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ReviewID uint64 `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	Excluded bool   `protobuf:"varint,3,opt,name=excluded,proto3" json:"excluded,omitempty"` // exclude the peer review from the submission's peer score
+}
+
+func (x *PeerReviewModerationRequest) Reset() {
+	*x = PeerReviewModerationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReviewModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewModerationRequest) ProtoMessage() {}
+
+func (x *PeerReviewModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewModerationRequest.ProtoReflect.Descriptor instead.
+func (*PeerReviewModerationRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PeerReviewModerationRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PeerReviewModerationRequest) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *PeerReviewModerationRequest) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+type ReviewDistributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID        uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID    uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	ReviewerIDs     []uint64 `protobuf:"varint,3,rep,packed,name=reviewerIDs,proto3" json:"reviewerIDs,omitempty"`  // reviewers to distribute submissions among; all course teachers if empty
+	ExcludeOwnGroup bool     `protobuf:"varint,4,opt,name=excludeOwnGroup,proto3" json:"excludeOwnGroup,omitempty"` // do not assign reviewers to submissions from their own group
+}
+
+func (x *ReviewDistributionRequest) Reset() {
+	*x = ReviewDistributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDistributionRequest) ProtoMessage() {}
+
+func (x *ReviewDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDistributionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ReviewDistributionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReviewDistributionRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ReviewDistributionRequest) GetReviewerIDs() []uint64 {
+	if x != nil {
+		return x.ReviewerIDs
+	}
+	return nil
+}
+
+func (x *ReviewDistributionRequest) GetExcludeOwnGroup() bool {
+	if x != nil {
+		return x.ExcludeOwnGroup
+	}
+	return false
+}
+
+type RubricTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	TemplateID   uint64 `protobuf:"varint,3,opt,name=templateID,proto3" json:"templateID,omitempty"`
+}
+
+func (x *RubricTemplateRequest) Reset() {
+	*x = RubricTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RubricTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricTemplateRequest) ProtoMessage() {}
+
+func (x *RubricTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RubricTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RubricTemplateRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RubricTemplateRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *RubricTemplateRequest) GetTemplateID() uint64 {
+	if x != nil {
+		return x.TemplateID
+	}
+	return 0
+}
+
+type CodeCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64       `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Comment  *CodeComment `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Publish  bool         `protobuf:"varint,3,opt,name=publish,proto3" json:"publish,omitempty"` // publish the comment as a commit comment in the student's repository
+}
+
+func (x *CodeCommentRequest) Reset() {
+	*x = CodeCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeCommentRequest) ProtoMessage() {}
+
+func (x *CodeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeCommentRequest.ProtoReflect.Descriptor instead.
+func (*CodeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CodeCommentRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CodeCommentRequest) GetComment() *CodeComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CodeCommentRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type CodeCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ReviewID uint64 `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+}
+
+func (x *CodeCommentsRequest) Reset() {
+	*x = CodeCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeCommentsRequest) ProtoMessage() {}
+
+func (x *CodeCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeCommentsRequest.ProtoReflect.Descriptor instead.
+func (*CodeCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CodeCommentsRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CodeCommentsRequest) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+type GradeOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64         `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Override *GradeOverride `protobuf:"bytes,2,opt,name=override,proto3" json:"override,omitempty"` // a NONE grade removes the lead teacher's override
+}
+
+func (x *GradeOverrideRequest) Reset() {
+	*x = GradeOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeOverrideRequest) ProtoMessage() {}
+
+func (x *GradeOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeOverrideRequest.ProtoReflect.Descriptor instead.
+func (*GradeOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GradeOverrideRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *GradeOverrideRequest) GetOverride() *GradeOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type SubmissionReviewersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *SubmissionReviewersRequest) Reset() {
+	*x = SubmissionReviewersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionReviewersRequest) ProtoMessage() {}
+
+func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Providers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *Providers) Reset() {
+	*x = Providers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Providers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Providers) ProtoMessage() {}
+
+func (x *Providers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
+func (*Providers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Providers) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type URLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
+}
+
+func (x *URLRequest) Reset() {
+	*x = URLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *URLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URLRequest) ProtoMessage() {}
+
+func (x *URLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
+func (*URLRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *URLRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *URLRequest) GetRepoTypes() []Repository_Type {
+	if x != nil {
+		return x.RepoTypes
+	}
+	return nil
+}
+
+// used to check whether student/group submission repo is empty
+type RepositoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *RepositoryRequest) Reset() {
+	*x = RepositoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryRequest) ProtoMessage() {}
+
+func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RepositoryRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RepositoryRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *RepositoryRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Repositories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Repositories) Reset() {
+	*x = Repositories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repositories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repositories) ProtoMessage() {}
+
+func (x *Repositories) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
+func (*Repositories) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Repositories) GetURLs() map[string]string {
+	if x != nil {
+		return x.URLs
+	}
+	return nil
+}
+
+type AuthorizationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+}
+
+func (x *AuthorizationResponse) Reset() {
+	*x = AuthorizationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationResponse) ProtoMessage() {}
+
+func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AuthorizationResponse) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Status) GetCode() uint64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Status) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubmissionsForCourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
+	SkipBuildInfo bool                             `protobuf:"varint,3,opt,name=skipBuildInfo,proto3" json:"skipBuildInfo,omitempty"`
+}
+
+func (x *SubmissionsForCourseRequest) Reset() {
+	*x = SubmissionsForCourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionsForCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionsForCourseRequest) ProtoMessage() {}
+
+func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SubmissionsForCourseRequest_ALL
+}
+
+func (x *SubmissionsForCourseRequest) GetSkipBuildInfo() bool {
+	if x != nil {
+		return x.SkipBuildInfo
+	}
+	return false
+}
+
+type RebuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+}
+
+func (x *RebuildRequest) Reset() {
+	*x = RebuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildRequest) ProtoMessage() {}
+
+func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
+func (*RebuildRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RebuildRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *RebuildRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+type SubmitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	CommitHash   string `protobuf:"bytes,3,opt,name=commitHash,proto3" json:"commitHash,omitempty"` // the commit to test and submit
+}
+
+func (x *SubmitRequest) Reset() {
+	*x = SubmitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRequest) ProtoMessage() {}
+
+func (x *SubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SubmitRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmitRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *SubmitRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+type CourseUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
+	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
+	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+}
+
+func (x *CourseUserRequest) Reset() {
+	*x = CourseUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseUserRequest) ProtoMessage() {}
+
+func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
+func (*CourseUserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CourseUserRequest) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *CourseUserRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *CourseUserRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type AssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+}
+
+func (x *AssignmentRequest) Reset() {
+	*x = AssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentRequest) ProtoMessage() {}
+
+func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AssignmentRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AssignmentRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+// Void contains no fields. A server response with a Void still contains a gRPC status code,
+// which can be checked for success or failure. Status code 0 indicates that the requested action was successful,
+// whereas any other status code indicates some failure. As such, the status code can be used as a boolean result from the server.
+type Void struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Void) Reset() {
+	*x = Void{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Void) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Void) ProtoMessage() {}
+
+func (x *Void) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Void.ProtoReflect.Descriptor instead.
+func (*Void) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{101}
+}
+
+var File_ag_ag_proto protoreflect.FileDescriptor
+
+var file_ag_ag_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x61, 0x67, 0x2f, 0x61, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61,
+	0x67, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52,
+	0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x08, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x2b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01,
+	0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01,
+	0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x31, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x24, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x61, 0x67, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x22, 0x71, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x33, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0a, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x22, 0xa4, 0x02, 0x0a, 0x12, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x44, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2c, 0xca, 0xb5, 0x03, 0x28, 0xa2, 0x01, 0x25, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x22, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x69, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x2c, 0xca, 0xb5, 0x03, 0x28, 0xa2,
+	0x01, 0x25, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6f,
+	0x70, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x22, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x22, 0x4d, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x09, 0x0a,
+	0x05, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x42, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x03,
+	0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x04,
+	0x22, 0x51, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x4f,
+	0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x4f, 0x75,
+	0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x4f,
+	0x75, 0x74, 0x73, 0x22, 0xb9, 0x03, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a,
+	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65,
+	0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x43, 0x4f,
+	0x52, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4c, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x54, 0x54, 0x45, 0x52, 0x4d, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x22,
+	0x4b, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x02, 0x0a,
+	0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2c,
+	0x0a, 0x11, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0x33, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x08,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5,
+	0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x22, 0x48, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x0e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x61,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x6c, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x42, 0x32, 0xca, 0xb5, 0x03, 0x2e, 0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x22, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x52, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x32, 0xca, 0xb5, 0x03, 0x2e, 0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
+	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0xa0, 0x02, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x58, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0xca, 0xb5, 0x03,
+	0x2e, 0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x52,
+	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x56,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0xca, 0xb5, 0x03, 0x2e, 0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69,
+	0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x4e, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xca, 0xb5, 0x03, 0x2e, 0xa2, 0x01,
+	0x2b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x52, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xca, 0xb5, 0x03,
+	0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2,
+	0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0xae, 0x03, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03,
+	0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d,
+	0x61, 0x6e, 0x79, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b,
+	0x22, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01,
+	0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xad, 0x01,
+	0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a,
+	0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0b, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x01,
+	0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x36, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a, 0x12, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3d, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x97,
+	0x01, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x73, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x22, 0x44, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x6d,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39,
+	0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xae, 0x09, 0x0a, 0x06, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0xa2, 0x01, 0x07, 0x73, 0x71,
+	0x6c, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x19, 0xca, 0xb5, 0x03,
+	0x15, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x3a, 0x31, 0x32, 0x30, 0x22, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x43, 0x61, 0x70, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x43, 0x61, 0x70, 0x12,
+	0x3e, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x41, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x11, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x22, 0x0a, 0x0b, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x59, 0x53, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x0d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x0a, 0x50,
+	0x45, 0x52, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x01, 0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x55, 0x53,
+	0x48, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x01, 0x22, 0x43, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x54, 0x41, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
+	0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x02, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a, 0x0a,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64,
+	0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x44, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64,
+	0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48,
+	0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a, 0xca,
+	0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f,
+	0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x49,
+	0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d,
+	0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10,
+	0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22, 0x90, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78,
+	0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x11, 0x73, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0xa2, 0x01, 0x07, 0x73, 0x71,
+	0x6c, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73,
+	0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x22, 0x45, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x12,
+	0x06, 0x0a, 0x02, 0x54, 0x41, 0x10, 0x04, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46,
+	0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22, 0xd8, 0x01, 0x0a, 0x0c, 0x55, 0x73,
+	0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x12, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x23, 0x0a, 0x06,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41,
+	0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10,
+	0x01, 0x22, 0x41, 0x0a, 0x0d, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x70, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x87, 0x06,
+	0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61,
+	0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x44, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x6c, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x0a, 0x4c, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x55, 0x54, 0x4f, 0x46, 0x46, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f,
+	0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19,
+	0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x61, 0x79,
+	0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x61, 0x79, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x45, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x67, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xf5, 0x04, 0x0a,
+	0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x6c, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61,
+	0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x61,
+	0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50,
+	0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x49, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x56,
+	0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22, 0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x9a, 0x03, 0x0a, 0x0e, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4f, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x33, 0xca, 0xb5, 0x03, 0x2f, 0xa2, 0x01, 0x2c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x47,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xb5,
+	0x03, 0x2f, 0xa2, 0x01, 0x2c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x33, 0xca, 0xb5, 0x03, 0x2f, 0xa2, 0x01,
+	0x2c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x59, 0x0a, 0x0a,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x23, 0xca, 0xb5, 0x03, 0x1f, 0xa2, 0x01, 0x1c, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a,
+	0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53,
+	0x45, 0x44, 0x10, 0x02, 0x22, 0xd1, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5,
+	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52,
+	0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x6c, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x12, 0x56, 0x0a, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x64,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x56, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0xca, 0xb5,
+	0x03, 0x2e, 0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x22,
+	0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x56,
+	0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0xca, 0xb5, 0x03, 0x2e, 0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69,
+	0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0xca, 0xb5, 0x03, 0x2e,
+	0xa2, 0x01, 0x2b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x22, 0x52, 0x0a,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x22, 0xe8, 0x01, 0x0a, 0x0a, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x54, 0x0a,
+	0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
+	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x50, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x49, 0x44, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x44, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0xee,
+	0x02, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x57, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x33, 0xca, 0xb5, 0x03, 0x2f, 0xa2, 0x01, 0x2c, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x4d, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xb5, 0x03, 0x2f,
+	0xa2, 0x01, 0x2c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xca,
+	0xb5, 0x03, 0x2f, 0xa2, 0x01, 0x2c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x22, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x97, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x73, 0x12, 0x37,
+	0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x4f,
+	0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22,
+	0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x78, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x65, 0x74, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0a, 0x4f, 0x72,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x22, 0x47, 0x0a, 0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77,
+	0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12,
+	0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67,
+	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x22, 0x61, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xae, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a,
+	0x14, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0f, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x1b, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x19,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x77, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x77, 0x6e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x77, 0x0a, 0x15, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22, 0x75,
+	0x0a, 0x12, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x13, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x22, 0x61, 0x0a, 0x14, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x08, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x5b, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a,
+	0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x2e, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73,
+	0x1a, 0x37, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56,
+	0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x10, 0x02, 0x22, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x6f, 0x0a, 0x0d,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x71, 0x0a,
+	0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65,
+	0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x22, 0x53, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0x84, 0x24,
+	0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x22, 0x00, 0x12, 0x21, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x22, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18,
+	0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e,
+	0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12,
+	0x29, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0c, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x24, 0x0a,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11,
+	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x61, 0x67,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x2e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67,
+	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x67,
+	0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12,
+	0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e,
+	0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x2f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22,
+	0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x0d, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x18, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x2e, 0x61, 0x67,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e,
+	0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x61, 0x67,
+	0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x2e,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0e,
+	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x71, 0x75, 0x69,
+	0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67, 0xba, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ag_ag_proto_rawDescOnce sync.Once
+	file_ag_ag_proto_rawDescData = file_ag_ag_proto_rawDesc
+)
+
+func file_ag_ag_proto_rawDescGZIP() []byte {
+	file_ag_ag_proto_rawDescOnce.Do(func() {
+		file_ag_ag_proto_rawDescData = protoimpl.X.CompressGZIP(file_ag_ag_proto_rawDescData)
+	})
+	return file_ag_ag_proto_rawDescData
+}
+
+var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_ag_ag_proto_goTypes = []interface{}{
+	(NotificationOptOut_Category)(0),      // 0: ag.NotificationOptOut.Category
+	(ChatIntegration_Platform)(0),         // 1: ag.ChatIntegration.Platform
+	(Group_GroupStatus)(0),                // 2: ag.Group.GroupStatus
+	(Course_SlipDayUnit)(0),               // 3: ag.Course.SlipDayUnit
+	(Course_GroupSlipDays)(0),             // 4: ag.Course.GroupSlipDays
+	(Course_SubmissionMode)(0),            // 5: ag.Course.SubmissionMode
+	(Course_SubmissionTrigger)(0),         // 6: ag.Course.SubmissionTrigger
+	(Repository_Type)(0),                  // 7: ag.Repository.Type
+	(Enrollment_UserStatus)(0),            // 8: ag.Enrollment.UserStatus
+	(Enrollment_DisplayState)(0),          // 9: ag.Enrollment.DisplayState
+	(SlipDayLedgerEntry_Source)(0),        // 10: ag.SlipDayLedgerEntry.Source
+	(Assignment_LatePolicy)(0),            // 11: ag.Assignment.LatePolicy
+	(Submission_Status)(0),                // 12: ag.Submission.Status
+	(GradingCriterion_Grade)(0),           // 13: ag.GradingCriterion.Grade
+	(SubmissionsForCourseRequest_Type)(0), // 14: ag.SubmissionsForCourseRequest.Type
+	(*User)(nil),                          // 15: ag.User
+	(*Users)(nil),                         // 16: ag.Users
+	(*APIToken)(nil),                      // 17: ag.APIToken
+	(*APITokens)(nil),                     // 18: ag.APITokens
+	(*APITokenRequest)(nil),               // 19: ag.APITokenRequest
+	(*Session)(nil),                       // 20: ag.Session
+	(*Sessions)(nil),                      // 21: ag.Sessions
+	(*OAuthState)(nil),                    // 22: ag.OAuthState
+	(*NotificationOptOut)(nil),            // 23: ag.NotificationOptOut
+	(*NotificationSettings)(nil),          // 24: ag.NotificationSettings
+	(*ChatIntegration)(nil),               // 25: ag.ChatIntegration
+	(*ChatIntegrations)(nil),              // 26: ag.ChatIntegrations
+	(*Webhook)(nil),                       // 27: ag.Webhook
+	(*Webhooks)(nil),                      // 28: ag.Webhooks
+	(*WebhookDelivery)(nil),               // 29: ag.WebhookDelivery
+	(*WebhookDeliveries)(nil),             // 30: ag.WebhookDeliveries
+	(*WebhookRequest)(nil),                // 31: ag.WebhookRequest
+	(*ChatIdentity)(nil),                  // 32: ag.ChatIdentity
+	(*ChatReminder)(nil),                  // 33: ag.ChatReminder
+	(*RemoteIdentity)(nil),                // 34: ag.RemoteIdentity
+	(*Group)(nil),                         // 35: ag.Group
+	(*Groups)(nil),                        // 36: ag.Groups
+	(*GroupMembership)(nil),               // 37: ag.GroupMembership
+	(*GroupMemberships)(nil),              // 38: ag.GroupMemberships
+	(*Contribution)(nil),                  // 39: ag.Contribution
+	(*SubmissionContributions)(nil),       // 40: ag.SubmissionContributions
+	(*GroupContributions)(nil),            // 41: ag.GroupContributions
+	(*GroupFormationRequest)(nil),         // 42: ag.GroupFormationRequest
+	(*FormedGroup)(nil),                   // 43: ag.FormedGroup
+	(*GroupFormation)(nil),                // 44: ag.GroupFormation
+	(*Course)(nil),                        // 45: ag.Course
+	(*Courses)(nil),                       // 46: ag.Courses
+	(*Repository)(nil),                    // 47: ag.Repository
+	(*Enrollment)(nil),                    // 48: ag.Enrollment
+	(*UsedSlipDays)(nil),                  // 49: ag.UsedSlipDays
+	(*SlipDayLedgerEntry)(nil),            // 50: ag.SlipDayLedgerEntry
+	(*SlipDayLedger)(nil),                 // 51: ag.SlipDayLedger
+	(*Enrollments)(nil),                   // 52: ag.Enrollments
+	(*AuditLogEntry)(nil),                 // 53: ag.AuditLogEntry
+	(*AuditLog)(nil),                      // 54: ag.AuditLog
+	(*SubmissionLink)(nil),                // 55: ag.SubmissionLink
+	(*EnrollmentLink)(nil),                // 56: ag.EnrollmentLink
+	(*CourseSubmissions)(nil),             // 57: ag.CourseSubmissions
+	(*Assignment)(nil),                    // 58: ag.Assignment
+	(*Assignments)(nil),                   // 59: ag.Assignments
+	(*ReleaseSchedule)(nil),               // 60: ag.ReleaseSchedule
+	(*ReleaseSchedules)(nil),              // 61: ag.ReleaseSchedules
+	(*Submission)(nil),                    // 62: ag.Submission
+	(*Submissions)(nil),                   // 63: ag.Submissions
+	(*GradingBenchmark)(nil),              // 64: ag.GradingBenchmark
+	(*Benchmarks)(nil),                    // 65: ag.Benchmarks
+	(*RubricTemplate)(nil),                // 66: ag.RubricTemplate
+	(*RubricTemplates)(nil),               // 67: ag.RubricTemplates
+	(*GradingCriterion)(nil),              // 68: ag.GradingCriterion
+	(*Review)(nil),                        // 69: ag.Review
+	(*CodeComment)(nil),                   // 70: ag.CodeComment
+	(*CodeComments)(nil),                  // 71: ag.CodeComments
+	(*PeerReviewer)(nil),                  // 72: ag.PeerReviewer
+	(*ReviewTask)(nil),                    // 73: ag.ReviewTask
+	(*ReviewerProgress)(nil),              // 74: ag.ReviewerProgress
+	(*ReviewProgress)(nil),                // 75: ag.ReviewProgress
+	(*GradeOverride)(nil),                 // 76: ag.GradeOverride
+	(*CriterionReconciliation)(nil),       // 77: ag.CriterionReconciliation
+	(*Reconciliation)(nil),                // 78: ag.Reconciliation
+	(*Reviewers)(nil),                     // 79: ag.Reviewers
+	(*ReviewRequest)(nil),                 // 80: ag.ReviewRequest
+	(*CourseRequest)(nil),                 // 81: ag.CourseRequest
+	(*UserRequest)(nil),                   // 82: ag.UserRequest
+	(*SessionRequest)(nil),                // 83: ag.SessionRequest
+	(*GetGroupRequest)(nil),               // 84: ag.GetGroupRequest
+	(*GroupRequest)(nil),                  // 85: ag.GroupRequest
+	(*Provider)(nil),                      // 86: ag.Provider
+	(*OrgRequest)(nil),                    // 87: ag.OrgRequest
+	(*Organization)(nil),                  // 88: ag.Organization
+	(*Organizations)(nil),                 // 89: ag.Organizations
+	(*EnrollmentRequest)(nil),             // 90: ag.EnrollmentRequest
+	(*EnrollmentStatusRequest)(nil),       // 91: ag.EnrollmentStatusRequest
+	(*SubmissionRequest)(nil),             // 92: ag.SubmissionRequest
+	(*UpdateSubmissionRequest)(nil),       // 93: ag.UpdateSubmissionRequest
+	(*UpdateSubmissionsRequest)(nil),      // 94: ag.UpdateSubmissionsRequest
+	(*SlipDaysRequest)(nil),               // 95: ag.SlipDaysRequest
+	(*SlipDayLedgerRequest)(nil),          // 96: ag.SlipDayLedgerRequest
+	(*AuditLogRequest)(nil),               // 97: ag.AuditLogRequest
+	(*PeerReviewModerationRequest)(nil),   // 98: ag.PeerReviewModerationRequest
+	(*ReviewDistributionRequest)(nil),     // 99: ag.ReviewDistributionRequest
+	(*RubricTemplateRequest)(nil),         // 100: ag.RubricTemplateRequest
+	(*CodeCommentRequest)(nil),            // 101: ag.CodeCommentRequest
+	(*CodeCommentsRequest)(nil),           // 102: ag.CodeCommentsRequest
+	(*GradeOverrideRequest)(nil),          // 103: ag.GradeOverrideRequest
+	(*SubmissionReviewersRequest)(nil),    // 104: ag.SubmissionReviewersRequest
+	(*Providers)(nil),                     // 105: ag.Providers
+	(*URLRequest)(nil),                    // 106: ag.URLRequest
+	(*RepositoryRequest)(nil),             // 107: ag.RepositoryRequest
+	(*Repositories)(nil),                  // 108: ag.Repositories
+	(*AuthorizationResponse)(nil),         // 109: ag.AuthorizationResponse
+	(*Status)(nil),                        // 110: ag.Status
+	(*SubmissionsForCourseRequest)(nil),   // 111: ag.SubmissionsForCourseRequest
+	(*RebuildRequest)(nil),                // 112: ag.RebuildRequest
+	(*SubmitRequest)(nil),                 // 113: ag.SubmitRequest
+	(*CourseUserRequest)(nil),             // 114: ag.CourseUserRequest
+	(*AssignmentRequest)(nil),             // 115: ag.AssignmentRequest
+	(*Void)(nil),                          // 116: ag.Void
+	nil,                                   // 117: ag.Repositories.URLsEntry
+	(*score.BuildInfo)(nil),               // 118: score.BuildInfo
+	(*score.Score)(nil),                   // 119: score.Score
+}
+var file_ag_ag_proto_depIdxs = []int32{
+	34,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
+	48,  // 1: ag.User.enrollments:type_name -> ag.Enrollment
+	15,  // 2: ag.Users.users:type_name -> ag.User
+	17,  // 3: ag.APITokens.tokens:type_name -> ag.APIToken
+	20,  // 4: ag.Sessions.sessions:type_name -> ag.Session
+	0,   // 5: ag.NotificationOptOut.category:type_name -> ag.NotificationOptOut.Category
+	0,   // 6: ag.NotificationSettings.optOuts:type_name -> ag.NotificationOptOut.Category
+	1,   // 7: ag.ChatIntegration.platform:type_name -> ag.ChatIntegration.Platform
+	25,  // 8: ag.ChatIntegrations.integrations:type_name -> ag.ChatIntegration
+	27,  // 9: ag.Webhooks.webhooks:type_name -> ag.Webhook
+	29,  // 10: ag.WebhookDeliveries.deliveries:type_name -> ag.WebhookDelivery
+	1,   // 11: ag.ChatIdentity.platform:type_name -> ag.ChatIntegration.Platform
+	2,   // 12: ag.Group.status:type_name -> ag.Group.GroupStatus
+	15,  // 13: ag.Group.users:type_name -> ag.User
+	48,  // 14: ag.Group.enrollments:type_name -> ag.Enrollment
+	35,  // 15: ag.Groups.groups:type_name -> ag.Group
+	15,  // 16: ag.GroupMembership.user:type_name -> ag.User
+	37,  // 17: ag.GroupMemberships.memberships:type_name -> ag.GroupMembership
+	39,  // 18: ag.SubmissionContributions.contributions:type_name -> ag.Contribution
+	40,  // 19: ag.GroupContributions.submissions:type_name -> ag.SubmissionContributions
+	35,  // 20: ag.FormedGroup.group:type_name -> ag.Group
+	43,  // 21: ag.GroupFormation.groups:type_name -> ag.FormedGroup
+	8,   // 22: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
+	48,  // 23: ag.Course.enrollments:type_name -> ag.Enrollment
+	58,  // 24: ag.Course.assignments:type_name -> ag.Assignment
+	35,  // 25: ag.Course.groups:type_name -> ag.Group
+	3,   // 26: ag.Course.slipDayUnit:type_name -> ag.Course.SlipDayUnit
+	4,   // 27: ag.Course.groupSlipDays:type_name -> ag.Course.GroupSlipDays
+	5,   // 28: ag.Course.submissionMode:type_name -> ag.Course.SubmissionMode
+	6,   // 29: ag.Course.submissionTrigger:type_name -> ag.Course.SubmissionTrigger
+	45,  // 30: ag.Courses.courses:type_name -> ag.Course
+	7,   // 31: ag.Repository.repoType:type_name -> ag.Repository.Type
+	15,  // 32: ag.Enrollment.user:type_name -> ag.User
+	45,  // 33: ag.Enrollment.course:type_name -> ag.Course
+	35,  // 34: ag.Enrollment.group:type_name -> ag.Group
+	8,   // 35: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
+	9,   // 36: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
+	49,  // 37: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
+	10,  // 38: ag.SlipDayLedgerEntry.source:type_name -> ag.SlipDayLedgerEntry.Source
+	50,  // 39: ag.SlipDayLedger.entries:type_name -> ag.SlipDayLedgerEntry
+	48,  // 40: ag.Enrollments.enrollments:type_name -> ag.Enrollment
+	53,  // 41: ag.AuditLog.entries:type_name -> ag.AuditLogEntry
+	58,  // 42: ag.SubmissionLink.assignment:type_name -> ag.Assignment
+	62,  // 43: ag.SubmissionLink.submission:type_name -> ag.Submission
+	48,  // 44: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
+	55,  // 45: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
+	45,  // 46: ag.CourseSubmissions.course:type_name -> ag.Course
+	56,  // 47: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
+	62,  // 48: ag.Assignment.submissions:type_name -> ag.Submission
+	64,  // 49: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	11,  // 50: ag.Assignment.latePolicy:type_name -> ag.Assignment.LatePolicy
+	58,  // 51: ag.Assignments.assignments:type_name -> ag.Assignment
+	60,  // 52: ag.ReleaseSchedules.schedules:type_name -> ag.ReleaseSchedule
+	12,  // 53: ag.Submission.status:type_name -> ag.Submission.Status
+	69,  // 54: ag.Submission.reviews:type_name -> ag.Review
+	118, // 55: ag.Submission.BuildInfo:type_name -> score.BuildInfo
+	119, // 56: ag.Submission.Scores:type_name -> score.Score
+	62,  // 57: ag.Submissions.submissions:type_name -> ag.Submission
+	68,  // 58: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
+	64,  // 59: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
+	64,  // 60: ag.RubricTemplate.benchmarks:type_name -> ag.GradingBenchmark
+	66,  // 61: ag.RubricTemplates.templates:type_name -> ag.RubricTemplate
+	13,  // 62: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
+	64,  // 63: ag.Review.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	70,  // 64: ag.Review.codeComments:type_name -> ag.CodeComment
+	70,  // 65: ag.CodeComments.comments:type_name -> ag.CodeComment
+	74,  // 66: ag.ReviewProgress.reviewers:type_name -> ag.ReviewerProgress
+	13,  // 67: ag.GradeOverride.grade:type_name -> ag.GradingCriterion.Grade
+	13,  // 68: ag.CriterionReconciliation.grades:type_name -> ag.GradingCriterion.Grade
+	13,  // 69: ag.CriterionReconciliation.grade:type_name -> ag.GradingCriterion.Grade
+	77,  // 70: ag.Reconciliation.criteria:type_name -> ag.CriterionReconciliation
+	15,  // 71: ag.Reviewers.reviewers:type_name -> ag.User
+	69,  // 72: ag.ReviewRequest.review:type_name -> ag.Review
+	88,  // 73: ag.Organizations.organizations:type_name -> ag.Organization
+	8,   // 74: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	8,   // 75: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	12,  // 76: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
+	70,  // 77: ag.CodeCommentRequest.comment:type_name -> ag.CodeComment
+	76,  // 78: ag.GradeOverrideRequest.override:type_name -> ag.GradeOverride
+	7,   // 79: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
+	117, // 80: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
+	14,  // 81: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
+	116, // 82: ag.AutograderService.GetUser:input_type -> ag.Void
+	116, // 83: ag.AutograderService.GetUsers:input_type -> ag.Void
+	114, // 84: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
+	15,  // 85: ag.AutograderService.UpdateUser:input_type -> ag.User
+	116, // 86: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
+	116, // 87: ag.AutograderService.GetNotificationSettings:input_type -> ag.Void
+	24,  // 88: ag.AutograderService.UpdateNotificationSettings:input_type -> ag.NotificationSettings
+	32,  // 89: ag.AutograderService.UpdateChatIdentity:input_type -> ag.ChatIdentity
+	19,  // 90: ag.AutograderService.CreateAPIToken:input_type -> ag.APITokenRequest
+	116, // 91: ag.AutograderService.GetAPITokens:input_type -> ag.Void
+	17,  // 92: ag.AutograderService.RevokeAPIToken:input_type -> ag.APIToken
+	82,  // 93: ag.AutograderService.GetSessions:input_type -> ag.UserRequest
+	83,  // 94: ag.AutograderService.RevokeSessions:input_type -> ag.SessionRequest
+	84,  // 95: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
+	85,  // 96: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
+	81,  // 97: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
+	35,  // 98: ag.AutograderService.CreateGroup:input_type -> ag.Group
+	35,  // 99: ag.AutograderService.UpdateGroup:input_type -> ag.Group
+	85,  // 100: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
+	42,  // 101: ag.AutograderService.FormGroups:input_type -> ag.GroupFormationRequest
+	85,  // 102: ag.AutograderService.GetGroupMemberships:input_type -> ag.GroupRequest
+	85,  // 103: ag.AutograderService.GetGroupContributions:input_type -> ag.GroupRequest
+	81,  // 104: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
+	116, // 105: ag.AutograderService.GetCourses:input_type -> ag.Void
+	91,  // 106: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
+	45,  // 107: ag.AutograderService.CreateCourse:input_type -> ag.Course
+	45,  // 108: ag.AutograderService.UpdateCourse:input_type -> ag.Course
+	48,  // 109: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
+	25,  // 110: ag.AutograderService.UpdateChatIntegration:input_type -> ag.ChatIntegration
+	81,  // 111: ag.AutograderService.GetChatIntegrations:input_type -> ag.CourseRequest
+	25,  // 112: ag.AutograderService.DeleteChatIntegration:input_type -> ag.ChatIntegration
+	27,  // 113: ag.AutograderService.UpdateWebhook:input_type -> ag.Webhook
+	81,  // 114: ag.AutograderService.GetWebhooks:input_type -> ag.CourseRequest
+	31,  // 115: ag.AutograderService.DeleteWebhook:input_type -> ag.WebhookRequest
+	31,  // 116: ag.AutograderService.GetWebhookDeliveries:input_type -> ag.WebhookRequest
+	81,  // 117: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
+	81,  // 118: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
+	91,  // 119: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
+	90,  // 120: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
+	48,  // 121: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
+	48,  // 122: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
+	81,  // 123: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
+	95,  // 124: ag.AutograderService.UpdateSlipDays:input_type -> ag.SlipDaysRequest
+	96,  // 125: ag.AutograderService.GetSlipDayLedger:input_type -> ag.SlipDayLedgerRequest
+	92,  // 126: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
+	111, // 127: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
+	93,  // 128: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
+	94,  // 129: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
+	60,  // 130: ag.AutograderService.ScheduleRelease:input_type -> ag.ReleaseSchedule
+	81,  // 131: ag.AutograderService.GetReleaseSchedules:input_type -> ag.CourseRequest
+	115, // 132: ag.AutograderService.CancelRelease:input_type -> ag.AssignmentRequest
+	112, // 133: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
+	115, // 134: ag.AutograderService.RebuildSubmissions:input_type -> ag.AssignmentRequest
+	113, // 135: ag.AutograderService.SubmitCommit:input_type -> ag.SubmitRequest
+	64,  // 136: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
+	64,  // 137: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
+	64,  // 138: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
+	68,  // 139: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
+	68,  // 140: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
+	68,  // 141: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
+	80,  // 142: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
+	80,  // 143: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
+	104, // 144: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
+	104, // 145: ag.AutograderService.GetReconciliation:input_type -> ag.SubmissionReviewersRequest
+	103, // 146: ag.AutograderService.OverrideGrade:input_type -> ag.GradeOverrideRequest
+	115, // 147: ag.AutograderService.GetPeerReviewSubmissions:input_type -> ag.AssignmentRequest
+	98,  // 148: ag.AutograderService.ModeratePeerReview:input_type -> ag.PeerReviewModerationRequest
+	99,  // 149: ag.AutograderService.DistributeReviews:input_type -> ag.ReviewDistributionRequest
+	115, // 150: ag.AutograderService.GetReviewProgress:input_type -> ag.AssignmentRequest
+	115, // 151: ag.AutograderService.GetReviewQueue:input_type -> ag.AssignmentRequest
+	101, // 152: ag.AutograderService.CreateCodeComment:input_type -> ag.CodeCommentRequest
+	102, // 153: ag.AutograderService.GetCodeComments:input_type -> ag.CodeCommentsRequest
+	115, // 154: ag.AutograderService.LoadCriteria:input_type -> ag.AssignmentRequest
+	66,  // 155: ag.AutograderService.CreateRubricTemplate:input_type -> ag.RubricTemplate
+	81,  // 156: ag.AutograderService.GetRubricTemplates:input_type -> ag.CourseRequest
+	100, // 157: ag.AutograderService.ApplyRubricTemplate:input_type -> ag.RubricTemplateRequest
+	97,  // 158: ag.AutograderService.GetAuditLog:input_type -> ag.AuditLogRequest
+	116, // 159: ag.AutograderService.GetProviders:input_type -> ag.Void
+	87,  // 160: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
+	106, // 161: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
+	107, // 162: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
+	15,  // 163: ag.AutograderService.GetUser:output_type -> ag.User
+	16,  // 164: ag.AutograderService.GetUsers:output_type -> ag.Users
+	15,  // 165: ag.AutograderService.GetUserByCourse:output_type -> ag.User
+	116, // 166: ag.AutograderService.UpdateUser:output_type -> ag.Void
+	109, // 167: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
+	24,  // 168: ag.AutograderService.GetNotificationSettings:output_type -> ag.NotificationSettings
+	24,  // 169: ag.AutograderService.UpdateNotificationSettings:output_type -> ag.NotificationSettings
+	116, // 170: ag.AutograderService.UpdateChatIdentity:output_type -> ag.Void
+	17,  // 171: ag.AutograderService.CreateAPIToken:output_type -> ag.APIToken
+	18,  // 172: ag.AutograderService.GetAPITokens:output_type -> ag.APITokens
+	116, // 173: ag.AutograderService.RevokeAPIToken:output_type -> ag.Void
+	21,  // 174: ag.AutograderService.GetSessions:output_type -> ag.Sessions
+	116, // 175: ag.AutograderService.RevokeSessions:output_type -> ag.Void
+	35,  // 176: ag.AutograderService.GetGroup:output_type -> ag.Group
+	35,  // 177: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
+	36,  // 178: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
+	35,  // 179: ag.AutograderService.CreateGroup:output_type -> ag.Group
+	116, // 180: ag.AutograderService.UpdateGroup:output_type -> ag.Void
+	116, // 181: ag.AutograderService.DeleteGroup:output_type -> ag.Void
+	44,  // 182: ag.AutograderService.FormGroups:output_type -> ag.GroupFormation
+	38,  // 183: ag.AutograderService.GetGroupMemberships:output_type -> ag.GroupMemberships
+	41,  // 184: ag.AutograderService.GetGroupContributions:output_type -> ag.GroupContributions
+	45,  // 185: ag.AutograderService.GetCourse:output_type -> ag.Course
+	46,  // 186: ag.AutograderService.GetCourses:output_type -> ag.Courses
+	46,  // 187: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
+	45,  // 188: ag.AutograderService.CreateCourse:output_type -> ag.Course
+	116, // 189: ag.AutograderService.UpdateCourse:output_type -> ag.Void
+	116, // 190: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
+	25,  // 191: ag.AutograderService.UpdateChatIntegration:output_type -> ag.ChatIntegration
+	26,  // 192: ag.AutograderService.GetChatIntegrations:output_type -> ag.ChatIntegrations
+	116, // 193: ag.AutograderService.DeleteChatIntegration:output_type -> ag.Void
+	27,  // 194: ag.AutograderService.UpdateWebhook:output_type -> ag.Webhook
+	28,  // 195: ag.AutograderService.GetWebhooks:output_type -> ag.Webhooks
+	116, // 196: ag.AutograderService.DeleteWebhook:output_type -> ag.Void
+	30,  // 197: ag.AutograderService.GetWebhookDeliveries:output_type -> ag.WebhookDeliveries
+	59,  // 198: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
+	116, // 199: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
+	52,  // 200: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
+	52,  // 201: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
+	116, // 202: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
+	116, // 203: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
+	116, // 204: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
+	48,  // 205: ag.AutograderService.UpdateSlipDays:output_type -> ag.Enrollment
+	51,  // 206: ag.AutograderService.GetSlipDayLedger:output_type -> ag.SlipDayLedger
+	63,  // 207: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
+	57,  // 208: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
+	116, // 209: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
+	116, // 210: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
+	60,  // 211: ag.AutograderService.ScheduleRelease:output_type -> ag.ReleaseSchedule
+	61,  // 212: ag.AutograderService.GetReleaseSchedules:output_type -> ag.ReleaseSchedules
+	116, // 213: ag.AutograderService.CancelRelease:output_type -> ag.Void
+	62,  // 214: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
+	116, // 215: ag.AutograderService.RebuildSubmissions:output_type -> ag.Void
+	62,  // 216: ag.AutograderService.SubmitCommit:output_type -> ag.Submission
+	64,  // 217: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
+	116, // 218: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
+	116, // 219: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
+	68,  // 220: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
+	116, // 221: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
+	116, // 222: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
+	69,  // 223: ag.AutograderService.CreateReview:output_type -> ag.Review
+	69,  // 224: ag.AutograderService.UpdateReview:output_type -> ag.Review
+	79,  // 225: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
+	78,  // 226: ag.AutograderService.GetReconciliation:output_type -> ag.Reconciliation
+	78,  // 227: ag.AutograderService.OverrideGrade:output_type -> ag.Reconciliation
+	63,  // 228: ag.AutograderService.GetPeerReviewSubmissions:output_type -> ag.Submissions
+	62,  // 229: ag.AutograderService.ModeratePeerReview:output_type -> ag.Submission
+	75,  // 230: ag.AutograderService.DistributeReviews:output_type -> ag.ReviewProgress
+	75,  // 231: ag.AutograderService.GetReviewProgress:output_type -> ag.ReviewProgress
+	63,  // 232: ag.AutograderService.GetReviewQueue:output_type -> ag.Submissions
+	70,  // 233: ag.AutograderService.CreateCodeComment:output_type -> ag.CodeComment
+	71,  // 234: ag.AutograderService.GetCodeComments:output_type -> ag.CodeComments
+	65,  // 235: ag.AutograderService.LoadCriteria:output_type -> ag.Benchmarks
+	66,  // 236: ag.AutograderService.CreateRubricTemplate:output_type -> ag.RubricTemplate
+	67,  // 237: ag.AutograderService.GetRubricTemplates:output_type -> ag.RubricTemplates
+	65,  // 238: ag.AutograderService.ApplyRubricTemplate:output_type -> ag.Benchmarks
+	54,  // 239: ag.AutograderService.GetAuditLog:output_type -> ag.AuditLog
+	105, // 240: ag.AutograderService.GetProviders:output_type -> ag.Providers
+	88,  // 241: ag.AutograderService.GetOrganization:output_type -> ag.Organization
+	108, // 242: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
+	116, // 243: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
+	163, // [163:244] is the sub-list for method output_type
+	82,  // [82:163] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
+}
+
+func init() { file_ag_ag_proto_init() }
+func file_ag_ag_proto_init() {
+	if File_ag_ag_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ag_ag_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Users); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuthState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationOptOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatIntegration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatIntegrations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhooks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatReminder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Groups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMembership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberships); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionContributions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupContributions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupFormationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormedGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupFormation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Courses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedSlipDays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDayLedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDayLedger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4596,8 +10368,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Users); i {
+		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4608,8 +10380,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteIdentity); i {
+		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4620,8 +10392,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4632,8 +10404,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Groups); i {
+		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4644,8 +10416,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseSubmissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4656,8 +10428,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Courses); i {
+		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4668,8 +10440,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repository); i {
+		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4680,8 +10452,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollment); i {
+		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4692,8 +10464,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsedSlipDays); i {
+		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseSchedules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4704,8 +10476,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollments); i {
+		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4716,8 +10488,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmissionLink); i {
+		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4728,8 +10500,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnrollmentLink); i {
+		file_ag_ag_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingBenchmark); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4740,8 +10512,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseSubmissions); i {
+		file_ag_ag_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Benchmarks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4752,8 +10524,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_ag_ag_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4764,8 +10536,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignments); i {
+		file_ag_ag_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricTemplates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4776,8 +10548,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_ag_ag_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingCriterion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4788,8 +10560,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submissions); i {
+		file_ag_ag_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Review); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4800,8 +10572,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradingBenchmark); i {
+		file_ag_ag_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeComment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4812,8 +10584,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Benchmarks); i {
+		file_ag_ag_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeComments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4824,8 +10596,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradingCriterion); i {
+		file_ag_ag_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReviewer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4836,8 +10608,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Review); i {
+		file_ag_ag_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4848,7 +10620,67 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewerProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionReconciliation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reconciliation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Reviewers); i {
 			case 0:
 				return &v.state
@@ -4860,7 +10692,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReviewRequest); i {
 			case 0:
 				return &v.state
@@ -4872,7 +10704,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseRequest); i {
 			case 0:
 				return &v.state
@@ -4884,7 +10716,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserRequest); i {
 			case 0:
 				return &v.state
@@ -4896,7 +10728,19 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGroupRequest); i {
 			case 0:
 				return &v.state
@@ -4908,7 +10752,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GroupRequest); i {
 			case 0:
 				return &v.state
@@ -4920,7 +10764,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Provider); i {
 			case 0:
 				return &v.state
@@ -4932,7 +10776,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrgRequest); i {
 			case 0:
 				return &v.state
@@ -4944,7 +10788,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Organization); i {
 			case 0:
 				return &v.state
@@ -4956,7 +10800,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Organizations); i {
 			case 0:
 				return &v.state
@@ -4968,7 +10812,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrollmentRequest); i {
 			case 0:
 				return &v.state
@@ -4980,7 +10824,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnrollmentStatusRequest); i {
 			case 0:
 				return &v.state
@@ -4992,7 +10836,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -5004,7 +10848,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -5016,7 +10860,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionsRequest); i {
 			case 0:
 				return &v.state
@@ -5028,7 +10872,115 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDaysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDayLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReviewModerationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewDistributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeOverrideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionReviewersRequest); i {
 			case 0:
 				return &v.state
@@ -5040,7 +10992,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Providers); i {
 			case 0:
 				return &v.state
@@ -5052,7 +11004,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*URLRequest); i {
 			case 0:
 				return &v.state
@@ -5064,7 +11016,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepositoryRequest); i {
 			case 0:
 				return &v.state
@@ -5076,7 +11028,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Repositories); i {
 			case 0:
 				return &v.state
@@ -5088,7 +11040,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthorizationResponse); i {
 			case 0:
 				return &v.state
@@ -5100,7 +11052,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -5112,7 +11064,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionsForCourseRequest); i {
 			case 0:
 				return &v.state
@@ -5124,7 +11076,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebuildRequest); i {
 			case 0:
 				return &v.state
@@ -5136,7 +11088,19 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseUserRequest); i {
 			case 0:
 				return &v.state
@@ -5148,7 +11112,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssignmentRequest); i {
 			case 0:
 				return &v.state
@@ -5160,7 +11124,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Void); i {
 			case 0:
 				return &v.state
@@ -5178,8 +11142,8 @@ func file_ag_ag_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ag_ag_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   50,
+			NumEnums:      15,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
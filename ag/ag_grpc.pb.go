@@ -23,18 +23,36 @@ type AutograderServiceClient interface {
 	GetUserByCourse(ctx context.Context, in *CourseUserRequest, opts ...grpc.CallOption) (*User, error)
 	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*Void, error)
 	IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	GetNotificationSettings(ctx context.Context, in *Void, opts ...grpc.CallOption) (*NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*NotificationSettings, error)
+	UpdateChatIdentity(ctx context.Context, in *ChatIdentity, opts ...grpc.CallOption) (*Void, error)
+	CreateAPIToken(ctx context.Context, in *APITokenRequest, opts ...grpc.CallOption) (*APIToken, error)
+	GetAPITokens(ctx context.Context, in *Void, opts ...grpc.CallOption) (*APITokens, error)
+	RevokeAPIToken(ctx context.Context, in *APIToken, opts ...grpc.CallOption) (*Void, error)
+	GetSessions(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Sessions, error)
+	RevokeSessions(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*Void, error)
 	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
 	GetGroupByUserAndCourse(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error)
 	GetGroupsByCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Groups, error)
 	CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Group, error)
 	UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Void, error)
 	DeleteGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Void, error)
+	FormGroups(ctx context.Context, in *GroupFormationRequest, opts ...grpc.CallOption) (*GroupFormation, error)
+	GetGroupMemberships(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupMemberships, error)
+	GetGroupContributions(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupContributions, error)
 	GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error)
 	GetCourses(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Courses, error)
 	GetCoursesByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Courses, error)
 	CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error)
 	UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error)
 	UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
+	UpdateChatIntegration(ctx context.Context, in *ChatIntegration, opts ...grpc.CallOption) (*ChatIntegration, error)
+	GetChatIntegrations(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ChatIntegrations, error)
+	DeleteChatIntegration(ctx context.Context, in *ChatIntegration, opts ...grpc.CallOption) (*Void, error)
+	UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	GetWebhooks(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Webhooks, error)
+	DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*Void, error)
+	GetWebhookDeliveries(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*WebhookDeliveries, error)
 	GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error)
 	UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
 	GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error)
@@ -42,14 +60,20 @@ type AutograderServiceClient interface {
 	CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
 	UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
 	UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
+	UpdateSlipDays(ctx context.Context, in *SlipDaysRequest, opts ...grpc.CallOption) (*Enrollment, error)
+	GetSlipDayLedger(ctx context.Context, in *SlipDayLedgerRequest, opts ...grpc.CallOption) (*SlipDayLedger, error)
 	// Get latest submissions for all course assignments for a user or a group.
 	GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error)
 	// Get lab submissions for every course user or every course group
 	GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error)
 	UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error)
 	UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error)
+	ScheduleRelease(ctx context.Context, in *ReleaseSchedule, opts ...grpc.CallOption) (*ReleaseSchedule, error)
+	GetReleaseSchedules(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ReleaseSchedules, error)
+	CancelRelease(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error)
 	RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error)
 	RebuildSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error)
+	SubmitCommit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*Submission, error)
 	// manual grading //
 	CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error)
 	UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
@@ -60,7 +84,20 @@ type AutograderServiceClient interface {
 	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error)
+	GetReconciliation(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	OverrideGrade(ctx context.Context, in *GradeOverrideRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+	GetPeerReviewSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Submissions, error)
+	ModeratePeerReview(ctx context.Context, in *PeerReviewModerationRequest, opts ...grpc.CallOption) (*Submission, error)
+	DistributeReviews(ctx context.Context, in *ReviewDistributionRequest, opts ...grpc.CallOption) (*ReviewProgress, error)
+	GetReviewProgress(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*ReviewProgress, error)
+	GetReviewQueue(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Submissions, error)
+	CreateCodeComment(ctx context.Context, in *CodeCommentRequest, opts ...grpc.CallOption) (*CodeComment, error)
+	GetCodeComments(ctx context.Context, in *CodeCommentsRequest, opts ...grpc.CallOption) (*CodeComments, error)
 	LoadCriteria(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Benchmarks, error)
+	CreateRubricTemplate(ctx context.Context, in *RubricTemplate, opts ...grpc.CallOption) (*RubricTemplate, error)
+	GetRubricTemplates(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*RubricTemplates, error)
+	ApplyRubricTemplate(ctx context.Context, in *RubricTemplateRequest, opts ...grpc.CallOption) (*Benchmarks, error)
+	GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error)
 	GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error)
 	GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error)
 	GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error)
@@ -120,6 +157,78 @@ func (c *autograderServiceClient) IsAuthorizedTeacher(ctx context.Context, in *V
 	return out, nil
 }
 
+func (c *autograderServiceClient) GetNotificationSettings(ctx context.Context, in *Void, opts ...grpc.CallOption) (*NotificationSettings, error) {
+	out := new(NotificationSettings)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetNotificationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*NotificationSettings, error) {
+	out := new(NotificationSettings)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateNotificationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateChatIdentity(ctx context.Context, in *ChatIdentity, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateChatIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateAPIToken(ctx context.Context, in *APITokenRequest, opts ...grpc.CallOption) (*APIToken, error) {
+	out := new(APIToken)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAPITokens(ctx context.Context, in *Void, opts ...grpc.CallOption) (*APITokens, error) {
+	out := new(APITokens)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAPITokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RevokeAPIToken(ctx context.Context, in *APIToken, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RevokeAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSessions(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Sessions, error) {
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RevokeSessions(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
 	out := new(Group)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetGroup", in, out, opts...)
@@ -174,6 +283,33 @@ func (c *autograderServiceClient) DeleteGroup(ctx context.Context, in *GroupRequ
 	return out, nil
 }
 
+func (c *autograderServiceClient) FormGroups(ctx context.Context, in *GroupFormationRequest, opts ...grpc.CallOption) (*GroupFormation, error) {
+	out := new(GroupFormation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/FormGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetGroupMemberships(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupMemberships, error) {
+	out := new(GroupMemberships)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetGroupMemberships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetGroupContributions(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupContributions, error) {
+	out := new(GroupContributions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetGroupContributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error) {
 	out := new(Course)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourse", in, out, opts...)
@@ -228,6 +364,69 @@ func (c *autograderServiceClient) UpdateCourseVisibility(ctx context.Context, in
 	return out, nil
 }
 
+func (c *autograderServiceClient) UpdateChatIntegration(ctx context.Context, in *ChatIntegration, opts ...grpc.CallOption) (*ChatIntegration, error) {
+	out := new(ChatIntegration)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateChatIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetChatIntegrations(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ChatIntegrations, error) {
+	out := new(ChatIntegrations)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetChatIntegrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteChatIntegration(ctx context.Context, in *ChatIntegration, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteChatIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetWebhooks(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Webhooks, error) {
+	out := new(Webhooks)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetWebhookDeliveries(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*WebhookDeliveries, error) {
+	out := new(WebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error) {
 	out := new(Assignments)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAssignments", in, out, opts...)
@@ -291,6 +490,24 @@ func (c *autograderServiceClient) UpdateEnrollments(ctx context.Context, in *Cou
 	return out, nil
 }
 
+func (c *autograderServiceClient) UpdateSlipDays(ctx context.Context, in *SlipDaysRequest, opts ...grpc.CallOption) (*Enrollment, error) {
+	out := new(Enrollment)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateSlipDays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSlipDayLedger(ctx context.Context, in *SlipDayLedgerRequest, opts ...grpc.CallOption) (*SlipDayLedger, error) {
+	out := new(SlipDayLedger)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSlipDayLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error) {
 	out := new(Submissions)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissions", in, out, opts...)
@@ -327,6 +544,33 @@ func (c *autograderServiceClient) UpdateSubmissions(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *autograderServiceClient) ScheduleRelease(ctx context.Context, in *ReleaseSchedule, opts ...grpc.CallOption) (*ReleaseSchedule, error) {
+	out := new(ReleaseSchedule)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ScheduleRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetReleaseSchedules(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ReleaseSchedules, error) {
+	out := new(ReleaseSchedules)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetReleaseSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CancelRelease(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CancelRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error) {
 	out := new(Submission)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/RebuildSubmission", in, out, opts...)
@@ -345,6 +589,15 @@ func (c *autograderServiceClient) RebuildSubmissions(ctx context.Context, in *As
 	return out, nil
 }
 
+func (c *autograderServiceClient) SubmitCommit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/SubmitCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
 	out := new(GradingBenchmark)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateBenchmark", in, out, opts...)
@@ -426,6 +679,87 @@ func (c *autograderServiceClient) GetReviewers(ctx context.Context, in *Submissi
 	return out, nil
 }
 
+func (c *autograderServiceClient) GetReconciliation(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) OverrideGrade(ctx context.Context, in *GradeOverrideRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/OverrideGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetPeerReviewSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Submissions, error) {
+	out := new(Submissions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetPeerReviewSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ModeratePeerReview(ctx context.Context, in *PeerReviewModerationRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ModeratePeerReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DistributeReviews(ctx context.Context, in *ReviewDistributionRequest, opts ...grpc.CallOption) (*ReviewProgress, error) {
+	out := new(ReviewProgress)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DistributeReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetReviewProgress(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*ReviewProgress, error) {
+	out := new(ReviewProgress)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetReviewProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetReviewQueue(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Submissions, error) {
+	out := new(Submissions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetReviewQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateCodeComment(ctx context.Context, in *CodeCommentRequest, opts ...grpc.CallOption) (*CodeComment, error) {
+	out := new(CodeComment)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateCodeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCodeComments(ctx context.Context, in *CodeCommentsRequest, opts ...grpc.CallOption) (*CodeComments, error) {
+	out := new(CodeComments)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCodeComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) LoadCriteria(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Benchmarks, error) {
 	out := new(Benchmarks)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/LoadCriteria", in, out, opts...)
@@ -435,6 +769,42 @@ func (c *autograderServiceClient) LoadCriteria(ctx context.Context, in *Assignme
 	return out, nil
 }
 
+func (c *autograderServiceClient) CreateRubricTemplate(ctx context.Context, in *RubricTemplate, opts ...grpc.CallOption) (*RubricTemplate, error) {
+	out := new(RubricTemplate)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateRubricTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetRubricTemplates(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*RubricTemplates, error) {
+	out := new(RubricTemplates)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetRubricTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ApplyRubricTemplate(ctx context.Context, in *RubricTemplateRequest, opts ...grpc.CallOption) (*Benchmarks, error) {
+	out := new(Benchmarks)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ApplyRubricTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error) {
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error) {
 	out := new(Providers)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetProviders", in, out, opts...)
@@ -480,18 +850,36 @@ type AutograderServiceServer interface {
 	GetUserByCourse(context.Context, *CourseUserRequest) (*User, error)
 	UpdateUser(context.Context, *User) (*Void, error)
 	IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error)
+	GetNotificationSettings(context.Context, *Void) (*NotificationSettings, error)
+	UpdateNotificationSettings(context.Context, *NotificationSettings) (*NotificationSettings, error)
+	UpdateChatIdentity(context.Context, *ChatIdentity) (*Void, error)
+	CreateAPIToken(context.Context, *APITokenRequest) (*APIToken, error)
+	GetAPITokens(context.Context, *Void) (*APITokens, error)
+	RevokeAPIToken(context.Context, *APIToken) (*Void, error)
+	GetSessions(context.Context, *UserRequest) (*Sessions, error)
+	RevokeSessions(context.Context, *SessionRequest) (*Void, error)
 	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
 	GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error)
 	GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error)
 	CreateGroup(context.Context, *Group) (*Group, error)
 	UpdateGroup(context.Context, *Group) (*Void, error)
 	DeleteGroup(context.Context, *GroupRequest) (*Void, error)
+	FormGroups(context.Context, *GroupFormationRequest) (*GroupFormation, error)
+	GetGroupMemberships(context.Context, *GroupRequest) (*GroupMemberships, error)
+	GetGroupContributions(context.Context, *GroupRequest) (*GroupContributions, error)
 	GetCourse(context.Context, *CourseRequest) (*Course, error)
 	GetCourses(context.Context, *Void) (*Courses, error)
 	GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error)
 	CreateCourse(context.Context, *Course) (*Course, error)
 	UpdateCourse(context.Context, *Course) (*Void, error)
 	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
+	UpdateChatIntegration(context.Context, *ChatIntegration) (*ChatIntegration, error)
+	GetChatIntegrations(context.Context, *CourseRequest) (*ChatIntegrations, error)
+	DeleteChatIntegration(context.Context, *ChatIntegration) (*Void, error)
+	UpdateWebhook(context.Context, *Webhook) (*Webhook, error)
+	GetWebhooks(context.Context, *CourseRequest) (*Webhooks, error)
+	DeleteWebhook(context.Context, *WebhookRequest) (*Void, error)
+	GetWebhookDeliveries(context.Context, *WebhookRequest) (*WebhookDeliveries, error)
 	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
 	UpdateAssignments(context.Context, *CourseRequest) (*Void, error)
 	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
@@ -499,14 +887,20 @@ type AutograderServiceServer interface {
 	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
 	UpdateEnrollment(context.Context, *Enrollment) (*Void, error)
 	UpdateEnrollments(context.Context, *CourseRequest) (*Void, error)
+	UpdateSlipDays(context.Context, *SlipDaysRequest) (*Enrollment, error)
+	GetSlipDayLedger(context.Context, *SlipDayLedgerRequest) (*SlipDayLedger, error)
 	// Get latest submissions for all course assignments for a user or a group.
 	GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error)
 	// Get lab submissions for every course user or every course group
 	GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error)
 	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error)
 	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
+	ScheduleRelease(context.Context, *ReleaseSchedule) (*ReleaseSchedule, error)
+	GetReleaseSchedules(context.Context, *CourseRequest) (*ReleaseSchedules, error)
+	CancelRelease(context.Context, *AssignmentRequest) (*Void, error)
 	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
 	RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error)
+	SubmitCommit(context.Context, *SubmitRequest) (*Submission, error)
 	// manual grading //
 	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
 	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
@@ -517,7 +911,20 @@ type AutograderServiceServer interface {
 	CreateReview(context.Context, *ReviewRequest) (*Review, error)
 	UpdateReview(context.Context, *ReviewRequest) (*Review, error)
 	GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error)
+	GetReconciliation(context.Context, *SubmissionReviewersRequest) (*Reconciliation, error)
+	OverrideGrade(context.Context, *GradeOverrideRequest) (*Reconciliation, error)
+	GetPeerReviewSubmissions(context.Context, *AssignmentRequest) (*Submissions, error)
+	ModeratePeerReview(context.Context, *PeerReviewModerationRequest) (*Submission, error)
+	DistributeReviews(context.Context, *ReviewDistributionRequest) (*ReviewProgress, error)
+	GetReviewProgress(context.Context, *AssignmentRequest) (*ReviewProgress, error)
+	GetReviewQueue(context.Context, *AssignmentRequest) (*Submissions, error)
+	CreateCodeComment(context.Context, *CodeCommentRequest) (*CodeComment, error)
+	GetCodeComments(context.Context, *CodeCommentsRequest) (*CodeComments, error)
 	LoadCriteria(context.Context, *AssignmentRequest) (*Benchmarks, error)
+	CreateRubricTemplate(context.Context, *RubricTemplate) (*RubricTemplate, error)
+	GetRubricTemplates(context.Context, *CourseRequest) (*RubricTemplates, error)
+	ApplyRubricTemplate(context.Context, *RubricTemplateRequest) (*Benchmarks, error)
+	GetAuditLog(context.Context, *AuditLogRequest) (*AuditLog, error)
 	GetProviders(context.Context, *Void) (*Providers, error)
 	GetOrganization(context.Context, *OrgRequest) (*Organization, error)
 	GetRepositories(context.Context, *URLRequest) (*Repositories, error)
@@ -544,6 +951,30 @@ func (UnimplementedAutograderServiceServer) UpdateUser(context.Context, *User) (
 func (UnimplementedAutograderServiceServer) IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorizedTeacher not implemented")
 }
+func (UnimplementedAutograderServiceServer) GetNotificationSettings(context.Context, *Void) (*NotificationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateNotificationSettings(context.Context, *NotificationSettings) (*NotificationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationSettings not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateChatIdentity(context.Context, *ChatIdentity) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatIdentity not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateAPIToken(context.Context, *APITokenRequest) (*APIToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAPITokens(context.Context, *Void) (*APITokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPITokens not implemented")
+}
+func (UnimplementedAutograderServiceServer) RevokeAPIToken(context.Context, *APIToken) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSessions(context.Context, *UserRequest) (*Sessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAutograderServiceServer) RevokeSessions(context.Context, *SessionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetGroup(context.Context, *GetGroupRequest) (*Group, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
 }
@@ -562,6 +993,15 @@ func (UnimplementedAutograderServiceServer) UpdateGroup(context.Context, *Group)
 func (UnimplementedAutograderServiceServer) DeleteGroup(context.Context, *GroupRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
+func (UnimplementedAutograderServiceServer) FormGroups(context.Context, *GroupFormationRequest) (*GroupFormation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormGroups not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGroupMemberships(context.Context, *GroupRequest) (*GroupMemberships, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberships not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGroupContributions(context.Context, *GroupRequest) (*GroupContributions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupContributions not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetCourse(context.Context, *CourseRequest) (*Course, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
 }
@@ -580,6 +1020,27 @@ func (UnimplementedAutograderServiceServer) UpdateCourse(context.Context, *Cours
 func (UnimplementedAutograderServiceServer) UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseVisibility not implemented")
 }
+func (UnimplementedAutograderServiceServer) UpdateChatIntegration(context.Context, *ChatIntegration) (*ChatIntegration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatIntegration not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetChatIntegrations(context.Context, *CourseRequest) (*ChatIntegrations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatIntegrations not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteChatIntegration(context.Context, *ChatIntegration) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChatIntegration not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetWebhooks(context.Context, *CourseRequest) (*Webhooks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteWebhook(context.Context, *WebhookRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetWebhookDeliveries(context.Context, *WebhookRequest) (*WebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetAssignments(context.Context, *CourseRequest) (*Assignments, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
 }
@@ -601,6 +1062,12 @@ func (UnimplementedAutograderServiceServer) UpdateEnrollment(context.Context, *E
 func (UnimplementedAutograderServiceServer) UpdateEnrollments(context.Context, *CourseRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollments not implemented")
 }
+func (UnimplementedAutograderServiceServer) UpdateSlipDays(context.Context, *SlipDaysRequest) (*Enrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlipDays not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSlipDayLedger(context.Context, *SlipDayLedgerRequest) (*SlipDayLedger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlipDayLedger not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissions not implemented")
 }
@@ -613,12 +1080,24 @@ func (UnimplementedAutograderServiceServer) UpdateSubmission(context.Context, *U
 func (UnimplementedAutograderServiceServer) UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmissions not implemented")
 }
+func (UnimplementedAutograderServiceServer) ScheduleRelease(context.Context, *ReleaseSchedule) (*ReleaseSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRelease not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetReleaseSchedules(context.Context, *CourseRequest) (*ReleaseSchedules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseSchedules not implemented")
+}
+func (UnimplementedAutograderServiceServer) CancelRelease(context.Context, *AssignmentRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRelease not implemented")
+}
 func (UnimplementedAutograderServiceServer) RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmission not implemented")
 }
 func (UnimplementedAutograderServiceServer) RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmissions not implemented")
 }
+func (UnimplementedAutograderServiceServer) SubmitCommit(context.Context, *SubmitRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCommit not implemented")
+}
 func (UnimplementedAutograderServiceServer) CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
 }
@@ -646,9 +1125,48 @@ func (UnimplementedAutograderServiceServer) UpdateReview(context.Context, *Revie
 func (UnimplementedAutograderServiceServer) GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReviewers not implemented")
 }
+func (UnimplementedAutograderServiceServer) GetReconciliation(context.Context, *SubmissionReviewersRequest) (*Reconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliation not implemented")
+}
+func (UnimplementedAutograderServiceServer) OverrideGrade(context.Context, *GradeOverrideRequest) (*Reconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideGrade not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetPeerReviewSubmissions(context.Context, *AssignmentRequest) (*Submissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerReviewSubmissions not implemented")
+}
+func (UnimplementedAutograderServiceServer) ModeratePeerReview(context.Context, *PeerReviewModerationRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePeerReview not implemented")
+}
+func (UnimplementedAutograderServiceServer) DistributeReviews(context.Context, *ReviewDistributionRequest) (*ReviewProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeReviews not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetReviewProgress(context.Context, *AssignmentRequest) (*ReviewProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewProgress not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetReviewQueue(context.Context, *AssignmentRequest) (*Submissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewQueue not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateCodeComment(context.Context, *CodeCommentRequest) (*CodeComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCodeComment not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCodeComments(context.Context, *CodeCommentsRequest) (*CodeComments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCodeComments not implemented")
+}
 func (UnimplementedAutograderServiceServer) LoadCriteria(context.Context, *AssignmentRequest) (*Benchmarks, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadCriteria not implemented")
 }
+func (UnimplementedAutograderServiceServer) CreateRubricTemplate(context.Context, *RubricTemplate) (*RubricTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRubricTemplate not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetRubricTemplates(context.Context, *CourseRequest) (*RubricTemplates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRubricTemplates not implemented")
+}
+func (UnimplementedAutograderServiceServer) ApplyRubricTemplate(context.Context, *RubricTemplateRequest) (*Benchmarks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRubricTemplate not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAuditLog(context.Context, *AuditLogRequest) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
 func (UnimplementedAutograderServiceServer) GetProviders(context.Context, *Void) (*Providers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProviders not implemented")
 }
@@ -764,6 +1282,150 @@ func _AutograderService_IsAuthorizedTeacher_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetNotificationSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetNotificationSettings(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateNotificationSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateNotificationSettings(ctx, req.(*NotificationSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateChatIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateChatIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateChatIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateChatIdentity(ctx, req.(*ChatIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateAPIToken(ctx, req.(*APITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetAPITokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetAPITokens(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RevokeAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RevokeAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RevokeAPIToken(ctx, req.(*APIToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSessions(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RevokeSessions(ctx, req.(*SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGroupRequest)
 	if err := dec(in); err != nil {
@@ -872,6 +1534,60 @@ func _AutograderService_DeleteGroup_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutograderService_FormGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupFormationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).FormGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/FormGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).FormGroups(ctx, req.(*GroupFormationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroupMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGroupMemberships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupMemberships(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroupContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGroupContributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupContributions(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AutograderService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CourseRequest)
 	if err := dec(in); err != nil {
@@ -950,446 +1666,914 @@ func _AutograderService_UpdateCourse_Handler(srv interface{}, ctx context.Contex
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCourse(ctx, in)
+		return srv.(AutograderServiceServer).UpdateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourse(ctx, req.(*Course))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateCourseVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateChatIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateChatIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateChatIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateChatIntegration(ctx, req.(*ChatIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetChatIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetChatIntegrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetChatIntegrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetChatIntegrations(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteChatIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteChatIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteChatIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteChatIntegration(ctx, req.(*ChatIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetWebhooks(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetWebhookDeliveries(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetEnrollmentsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, req.(*EnrollmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetEnrollmentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetEnrollmentsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, req.(*EnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateEnrollment(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateEnrollments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateSlipDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlipDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSlipDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateSlipDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSlipDays(ctx, req.(*SlipDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSlipDayLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlipDayLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSlipDayLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSlipDayLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSlipDayLedger(ctx, req.(*SlipDayLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissions(ctx, req.(*SubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissionsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionsForCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, req.(*SubmissionsForCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, req.(*UpdateSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ScheduleRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ScheduleRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ScheduleRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ScheduleRelease(ctx, req.(*ReleaseSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetReleaseSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetReleaseSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetReleaseSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetReleaseSchedules(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CancelRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CancelRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CancelRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CancelRelease(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RebuildSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RebuildSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RebuildSubmission(ctx, req.(*RebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RebuildSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateCourse",
+		FullMethod: "/ag.AutograderService/RebuildSubmissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCourse(ctx, req.(*Course))
+		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
+func _AutograderService_SubmitCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, in)
+		return srv.(AutograderServiceServer).SubmitCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateCourseVisibility",
+		FullMethod: "/ag.AutograderService/SubmitCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, req.(*Enrollment))
+		return srv.(AutograderServiceServer).SubmitCommit(ctx, req.(*SubmitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetAssignments(ctx, in)
+		return srv.(AutograderServiceServer).CreateBenchmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetAssignments",
+		FullMethod: "/ag.AutograderService/CreateBenchmark",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetAssignments(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).CreateBenchmark(ctx, req.(*GradingBenchmark))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_UpdateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateAssignments(ctx, in)
+		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateAssignments",
+		FullMethod: "/ag.AutograderService/UpdateBenchmark",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateAssignments(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, req.(*GradingBenchmark))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentStatusRequest)
+func _AutograderService_DeleteBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, in)
+		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetEnrollmentsByUser",
+		FullMethod: "/ag.AutograderService/DeleteBenchmark",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, req.(*EnrollmentStatusRequest))
+		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, req.(*GradingBenchmark))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetEnrollmentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentRequest)
+func _AutograderService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, in)
+		return srv.(AutograderServiceServer).CreateCriterion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetEnrollmentsByCourse",
+		FullMethod: "/ag.AutograderService/CreateCriterion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, req.(*EnrollmentRequest))
+		return srv.(AutograderServiceServer).CreateCriterion(ctx, req.(*GradingCriterion))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
+func _AutograderService_UpdateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateEnrollment(ctx, in)
+		return srv.(AutograderServiceServer).UpdateCriterion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateEnrollment",
+		FullMethod: "/ag.AutograderService/UpdateCriterion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateEnrollment(ctx, req.(*Enrollment))
+		return srv.(AutograderServiceServer).UpdateCriterion(ctx, req.(*GradingCriterion))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
+func _AutograderService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, in)
+		return srv.(AutograderServiceServer).DeleteCriterion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateEnrollment",
+		FullMethod: "/ag.AutograderService/DeleteCriterion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, req.(*Enrollment))
+		return srv.(AutograderServiceServer).DeleteCriterion(ctx, req.(*GradingCriterion))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, in)
+		return srv.(AutograderServiceServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateEnrollments",
+		FullMethod: "/ag.AutograderService/CreateReview",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).CreateReview(ctx, req.(*ReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionRequest)
+func _AutograderService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetSubmissions(ctx, in)
+		return srv.(AutograderServiceServer).UpdateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetSubmissions",
+		FullMethod: "/ag.AutograderService/UpdateReview",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetSubmissions(ctx, req.(*SubmissionRequest))
+		return srv.(AutograderServiceServer).UpdateReview(ctx, req.(*ReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetSubmissionsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionsForCourseRequest)
+func _AutograderService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionReviewersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, in)
+		return srv.(AutograderServiceServer).GetReviewers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetSubmissionsByCourse",
+		FullMethod: "/ag.AutograderService/GetReviewers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, req.(*SubmissionsForCourseRequest))
+		return srv.(AutograderServiceServer).GetReviewers(ctx, req.(*SubmissionReviewersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubmissionRequest)
+func _AutograderService_GetReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionReviewersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateSubmission(ctx, in)
+		return srv.(AutograderServiceServer).GetReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateSubmission",
+		FullMethod: "/ag.AutograderService/GetReconciliation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
+		return srv.(AutograderServiceServer).GetReconciliation(ctx, req.(*SubmissionReviewersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubmissionsRequest)
+func _AutograderService_OverrideGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeOverrideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, in)
+		return srv.(AutograderServiceServer).OverrideGrade(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateSubmissions",
+		FullMethod: "/ag.AutograderService/OverrideGrade",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, req.(*UpdateSubmissionsRequest))
+		return srv.(AutograderServiceServer).OverrideGrade(ctx, req.(*GradeOverrideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RebuildRequest)
+func _AutograderService_GetPeerReviewSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).RebuildSubmission(ctx, in)
+		return srv.(AutograderServiceServer).GetPeerReviewSubmissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/RebuildSubmission",
+		FullMethod: "/ag.AutograderService/GetPeerReviewSubmissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).RebuildSubmission(ctx, req.(*RebuildRequest))
+		return srv.(AutograderServiceServer).GetPeerReviewSubmissions(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_RebuildSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
+func _AutograderService_ModeratePeerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReviewModerationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, in)
+		return srv.(AutograderServiceServer).ModeratePeerReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/RebuildSubmissions",
+		FullMethod: "/ag.AutograderService/ModeratePeerReview",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, req.(*AssignmentRequest))
+		return srv.(AutograderServiceServer).ModeratePeerReview(ctx, req.(*PeerReviewModerationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
+func _AutograderService_DistributeReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDistributionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateBenchmark(ctx, in)
+		return srv.(AutograderServiceServer).DistributeReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateBenchmark",
+		FullMethod: "/ag.AutograderService/DistributeReviews",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateBenchmark(ctx, req.(*GradingBenchmark))
+		return srv.(AutograderServiceServer).DistributeReviews(ctx, req.(*ReviewDistributionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
+func _AutograderService_GetReviewProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, in)
+		return srv.(AutograderServiceServer).GetReviewProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateBenchmark",
+		FullMethod: "/ag.AutograderService/GetReviewProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, req.(*GradingBenchmark))
+		return srv.(AutograderServiceServer).GetReviewProgress(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_DeleteBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
+func _AutograderService_GetReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, in)
+		return srv.(AutograderServiceServer).GetReviewQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/DeleteBenchmark",
+		FullMethod: "/ag.AutograderService/GetReviewQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, req.(*GradingBenchmark))
+		return srv.(AutograderServiceServer).GetReviewQueue(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
+func _AutograderService_CreateCodeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodeCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateCriterion(ctx, in)
+		return srv.(AutograderServiceServer).CreateCodeComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateCriterion",
+		FullMethod: "/ag.AutograderService/CreateCodeComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateCriterion(ctx, req.(*GradingCriterion))
+		return srv.(AutograderServiceServer).CreateCodeComment(ctx, req.(*CodeCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
+func _AutograderService_GetCodeComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodeCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCriterion(ctx, in)
+		return srv.(AutograderServiceServer).GetCodeComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateCriterion",
+		FullMethod: "/ag.AutograderService/GetCodeComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCriterion(ctx, req.(*GradingCriterion))
+		return srv.(AutograderServiceServer).GetCodeComments(ctx, req.(*CodeCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
+func _AutograderService_LoadCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteCriterion(ctx, in)
+		return srv.(AutograderServiceServer).LoadCriteria(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/DeleteCriterion",
+		FullMethod: "/ag.AutograderService/LoadCriteria",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteCriterion(ctx, req.(*GradingCriterion))
+		return srv.(AutograderServiceServer).LoadCriteria(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
+func _AutograderService_CreateRubricTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RubricTemplate)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateReview(ctx, in)
+		return srv.(AutograderServiceServer).CreateRubricTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateReview",
+		FullMethod: "/ag.AutograderService/CreateRubricTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateReview(ctx, req.(*ReviewRequest))
+		return srv.(AutograderServiceServer).CreateRubricTemplate(ctx, req.(*RubricTemplate))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
+func _AutograderService_GetRubricTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateReview(ctx, in)
+		return srv.(AutograderServiceServer).GetRubricTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateReview",
+		FullMethod: "/ag.AutograderService/GetRubricTemplates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateReview(ctx, req.(*ReviewRequest))
+		return srv.(AutograderServiceServer).GetRubricTemplates(ctx, req.(*CourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionReviewersRequest)
+func _AutograderService_ApplyRubricTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RubricTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetReviewers(ctx, in)
+		return srv.(AutograderServiceServer).ApplyRubricTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetReviewers",
+		FullMethod: "/ag.AutograderService/ApplyRubricTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetReviewers(ctx, req.(*SubmissionReviewersRequest))
+		return srv.(AutograderServiceServer).ApplyRubricTemplate(ctx, req.(*RubricTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_LoadCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
+func _AutograderService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).LoadCriteria(ctx, in)
+		return srv.(AutograderServiceServer).GetAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/LoadCriteria",
+		FullMethod: "/ag.AutograderService/GetAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).LoadCriteria(ctx, req.(*AssignmentRequest))
+		return srv.(AutograderServiceServer).GetAuditLog(ctx, req.(*AuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1493,6 +2677,38 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsAuthorizedTeacher",
 			Handler:    _AutograderService_IsAuthorizedTeacher_Handler,
 		},
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _AutograderService_GetNotificationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationSettings",
+			Handler:    _AutograderService_UpdateNotificationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateChatIdentity",
+			Handler:    _AutograderService_UpdateChatIdentity_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _AutograderService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "GetAPITokens",
+			Handler:    _AutograderService_GetAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _AutograderService_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _AutograderService_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _AutograderService_RevokeSessions_Handler,
+		},
 		{
 			MethodName: "GetGroup",
 			Handler:    _AutograderService_GetGroup_Handler,
@@ -1517,6 +2733,18 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGroup",
 			Handler:    _AutograderService_DeleteGroup_Handler,
 		},
+		{
+			MethodName: "FormGroups",
+			Handler:    _AutograderService_FormGroups_Handler,
+		},
+		{
+			MethodName: "GetGroupMemberships",
+			Handler:    _AutograderService_GetGroupMemberships_Handler,
+		},
+		{
+			MethodName: "GetGroupContributions",
+			Handler:    _AutograderService_GetGroupContributions_Handler,
+		},
 		{
 			MethodName: "GetCourse",
 			Handler:    _AutograderService_GetCourse_Handler,
@@ -1541,6 +2769,34 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCourseVisibility",
 			Handler:    _AutograderService_UpdateCourseVisibility_Handler,
 		},
+		{
+			MethodName: "UpdateChatIntegration",
+			Handler:    _AutograderService_UpdateChatIntegration_Handler,
+		},
+		{
+			MethodName: "GetChatIntegrations",
+			Handler:    _AutograderService_GetChatIntegrations_Handler,
+		},
+		{
+			MethodName: "DeleteChatIntegration",
+			Handler:    _AutograderService_DeleteChatIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _AutograderService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _AutograderService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _AutograderService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _AutograderService_GetWebhookDeliveries_Handler,
+		},
 		{
 			MethodName: "GetAssignments",
 			Handler:    _AutograderService_GetAssignments_Handler,
@@ -1569,6 +2825,14 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateEnrollments",
 			Handler:    _AutograderService_UpdateEnrollments_Handler,
 		},
+		{
+			MethodName: "UpdateSlipDays",
+			Handler:    _AutograderService_UpdateSlipDays_Handler,
+		},
+		{
+			MethodName: "GetSlipDayLedger",
+			Handler:    _AutograderService_GetSlipDayLedger_Handler,
+		},
 		{
 			MethodName: "GetSubmissions",
 			Handler:    _AutograderService_GetSubmissions_Handler,
@@ -1585,6 +2849,18 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSubmissions",
 			Handler:    _AutograderService_UpdateSubmissions_Handler,
 		},
+		{
+			MethodName: "ScheduleRelease",
+			Handler:    _AutograderService_ScheduleRelease_Handler,
+		},
+		{
+			MethodName: "GetReleaseSchedules",
+			Handler:    _AutograderService_GetReleaseSchedules_Handler,
+		},
+		{
+			MethodName: "CancelRelease",
+			Handler:    _AutograderService_CancelRelease_Handler,
+		},
 		{
 			MethodName: "RebuildSubmission",
 			Handler:    _AutograderService_RebuildSubmission_Handler,
@@ -1593,6 +2869,10 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildSubmissions",
 			Handler:    _AutograderService_RebuildSubmissions_Handler,
 		},
+		{
+			MethodName: "SubmitCommit",
+			Handler:    _AutograderService_SubmitCommit_Handler,
+		},
 		{
 			MethodName: "CreateBenchmark",
 			Handler:    _AutograderService_CreateBenchmark_Handler,
@@ -1629,10 +2909,62 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReviewers",
 			Handler:    _AutograderService_GetReviewers_Handler,
 		},
+		{
+			MethodName: "GetReconciliation",
+			Handler:    _AutograderService_GetReconciliation_Handler,
+		},
+		{
+			MethodName: "OverrideGrade",
+			Handler:    _AutograderService_OverrideGrade_Handler,
+		},
+		{
+			MethodName: "GetPeerReviewSubmissions",
+			Handler:    _AutograderService_GetPeerReviewSubmissions_Handler,
+		},
+		{
+			MethodName: "ModeratePeerReview",
+			Handler:    _AutograderService_ModeratePeerReview_Handler,
+		},
+		{
+			MethodName: "DistributeReviews",
+			Handler:    _AutograderService_DistributeReviews_Handler,
+		},
+		{
+			MethodName: "GetReviewProgress",
+			Handler:    _AutograderService_GetReviewProgress_Handler,
+		},
+		{
+			MethodName: "GetReviewQueue",
+			Handler:    _AutograderService_GetReviewQueue_Handler,
+		},
+		{
+			MethodName: "CreateCodeComment",
+			Handler:    _AutograderService_CreateCodeComment_Handler,
+		},
+		{
+			MethodName: "GetCodeComments",
+			Handler:    _AutograderService_GetCodeComments_Handler,
+		},
 		{
 			MethodName: "LoadCriteria",
 			Handler:    _AutograderService_LoadCriteria_Handler,
 		},
+		{
+			MethodName: "CreateRubricTemplate",
+			Handler:    _AutograderService_CreateRubricTemplate_Handler,
+		},
+		{
+			MethodName: "GetRubricTemplates",
+			Handler:    _AutograderService_GetRubricTemplates_Handler,
+		},
+		{
+			MethodName: "ApplyRubricTemplate",
+			Handler:    _AutograderService_ApplyRubricTemplate_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _AutograderService_GetAuditLog_Handler,
+		},
 		{
 			MethodName: "GetProviders",
 			Handler:    _AutograderService_GetProviders_Handler,
@@ -0,0 +1,30 @@
+package ag
+
+import "time"
+
+// MaxAPITokenDays is the maximum number of days an API token can be valid.
+const MaxAPITokenDays = 365
+
+// IsExpired returns true if the token has expired at the given time.
+// Tokens with a malformed expiry date are considered expired.
+func (t *APIToken) IsExpired(now time.Time) bool {
+	expiry, err := time.ParseInLocation(TimeLayout, t.GetExpiryDate(), now.Location())
+	if err != nil {
+		return true
+	}
+	return !now.Before(expiry)
+}
+
+// RemoveHash removes the hash of the token before transmitting to client.
+func (t *APIToken) RemoveHash() {
+	if t != nil {
+		t.Hash = ""
+	}
+}
+
+// RemoveHash removes the hashes of all the tokens before transmitting to client.
+func (t *APITokens) RemoveHash() {
+	for _, token := range t.GetTokens() {
+		token.RemoveHash()
+	}
+}
@@ -21,6 +21,41 @@ func (a *Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
 	return now.Sub(deadline), nil
 }
 
+// PenalizedScore returns the score after applying the assignment's late policy
+// to a submission with the given score delivered at the given time.
+// Days late are counted using the course's grace period.
+func (a *Assignment) PenalizedScore(course *Course, delivered time.Time, score uint32) (uint32, error) {
+	if a.GetLatePolicy() == Assignment_NONE {
+		return score, nil
+	}
+	sinceDeadline, err := a.SinceDeadline(delivered)
+	if err != nil {
+		return score, err
+	}
+	late := course.DaysLate(sinceDeadline)
+	if late == 0 {
+		return score, nil
+	}
+	switch a.GetLatePolicy() {
+	case Assignment_NO_LATE:
+		return 0, nil
+	case Assignment_CUTOFF:
+		if late > a.GetLateDays() {
+			return 0, nil
+		}
+	case Assignment_PENALTY:
+		if a.GetLateDays() > 0 && late > a.GetLateDays() {
+			return 0, nil
+		}
+		deduction := late * a.GetLatePenalty()
+		if deduction >= 100 {
+			return 0, nil
+		}
+		return score * (100 - deduction) / 100, nil
+	}
+	return score, nil
+}
+
 // IsApproved returns an approved submission status if this assignment is already approved
 // for the latest submission, or if the score of the latest submission is sufficient
 // to autoapprove the assignment.
@@ -47,6 +82,13 @@ func (a *Assignment) CloneWithoutSubmissions() *Assignment {
 		ScoreLimit:        a.ScoreLimit,
 		Reviewers:         a.Reviewers,
 		GradingBenchmarks: a.GradingBenchmarks,
+		LatePolicy:        a.LatePolicy,
+		LatePenalty:       a.LatePenalty,
+		LateDays:          a.LateDays,
+		PeerReviewers:     a.PeerReviewers,
+		BlindReview:       a.BlindReview,
+		RubricTemplateID:  a.RubricTemplateID,
+		GroupSet:          a.GroupSet,
 	}
 }
 
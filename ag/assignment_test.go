@@ -2,6 +2,7 @@ package ag_test
 
 import (
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 )
@@ -117,3 +118,52 @@ func TestIsApproved(t *testing.T) {
 		})
 	}
 }
+
+func TestPenalizedScore(t *testing.T) {
+	const deadline = "2021-03-20T23:59:00"
+	dl, err := time.Parse(pb.TimeLayout, deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{GracePeriod: 120}
+	none := &pb.Assignment{Deadline: deadline}
+	penalty := &pb.Assignment{Deadline: deadline, LatePolicy: pb.Assignment_PENALTY, LatePenalty: 20}
+	penaltyCutoff := &pb.Assignment{Deadline: deadline, LatePolicy: pb.Assignment_PENALTY, LatePenalty: 10, LateDays: 2}
+	cutoff := &pb.Assignment{Deadline: deadline, LatePolicy: pb.Assignment_CUTOFF, LateDays: 2}
+	noLate := &pb.Assignment{Deadline: deadline, LatePolicy: pb.Assignment_NO_LATE}
+
+	penalizedScoreTests := []struct {
+		name       string
+		assignment *pb.Assignment
+		delivered  time.Time
+		want       uint32
+	}{
+		{"None/OnTime", none, dl.Add(-time.Hour), 90},
+		{"None/Late", none, dl.Add(72 * time.Hour), 90},
+		{"Penalty/OnTime", penalty, dl.Add(-time.Hour), 90},
+		{"Penalty/WithinGracePeriod", penalty, dl.Add(time.Hour), 90},
+		{"Penalty/OneDay", penalty, dl.Add(3 * time.Hour), 72},
+		{"Penalty/TwoDays", penalty, dl.Add(27 * time.Hour), 54},
+		{"Penalty/FiveDays", penalty, dl.Add(100 * time.Hour), 0},
+		{"Penalty/TenDays", penalty, dl.Add(240 * time.Hour), 0},
+		{"PenaltyCutoff/TwoDays", penaltyCutoff, dl.Add(27 * time.Hour), 72},
+		{"PenaltyCutoff/ThreeDays", penaltyCutoff, dl.Add(51 * time.Hour), 0},
+		{"Cutoff/TwoDays", cutoff, dl.Add(48 * time.Hour), 90},
+		{"Cutoff/ThreeDays", cutoff, dl.Add(51 * time.Hour), 0},
+		{"NoLate/OnTime", noLate, dl, 90},
+		{"NoLate/WithinGracePeriod", noLate, dl.Add(time.Hour), 90},
+		{"NoLate/OneDay", noLate, dl.Add(3 * time.Hour), 0},
+	}
+
+	for _, test := range penalizedScoreTests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.assignment.PenalizedScore(course, test.delivered, 90)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("PenalizedScore(%v, %d) = %d, expected %d", test.delivered, 90, got, test.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package ag
 
+import "time"
+
 // cache of access tokens for courses; they are cached here when fetching from database
 var accessTokens = make(map[uint64]string)
 
@@ -23,3 +25,32 @@ func (course *Course) SetSlipDays() {
 		g.SetSlipDays(course)
 	}
 }
+
+// GroupSizeAllowed returns true if the course allows groups with the given number of members.
+func (course *Course) GroupSizeAllowed(size int) bool {
+	min, max := int(course.GetMinGroupSize()), int(course.GetMaxGroupSize())
+	return size >= min && (max == 0 || size <= max)
+}
+
+// GroupFormationClosed returns true if the course's group formation deadline has passed.
+func (course *Course) GroupFormationClosed(now time.Time) bool {
+	return hasPassed(course.GetGroupDeadline(), now)
+}
+
+// GroupsFrozen returns true if the course's group freeze date has passed.
+func (course *Course) GroupsFrozen(now time.Time) bool {
+	return hasPassed(course.GetGroupFreezeDate(), now)
+}
+
+// hasPassed returns true if the given time has passed; empty or malformed times never pass.
+func hasPassed(date string, now time.Time) bool {
+	if date == "" {
+		return false
+	}
+	t, err := time.ParseInLocation(TimeLayout, date, now.Location())
+	if err != nil {
+		// this should not happen since course dates are validated
+		return false
+	}
+	return now.After(t)
+}
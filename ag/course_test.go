@@ -0,0 +1,59 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestGroupSizeAllowed(t *testing.T) {
+	tests := []struct {
+		min, max uint32
+		size     int
+		want     bool
+	}{
+		{0, 0, 1, true},
+		{0, 0, 10, true},
+		{2, 0, 1, false},
+		{2, 0, 10, true},
+		{0, 3, 3, true},
+		{0, 3, 4, false},
+		{2, 3, 1, false},
+		{2, 3, 2, true},
+		{2, 3, 4, false},
+	}
+	for _, test := range tests {
+		course := &pb.Course{MinGroupSize: test.min, MaxGroupSize: test.max}
+		if got := course.GroupSizeAllowed(test.size); got != test.want {
+			t.Errorf("GroupSizeAllowed(%d) with min %d and max %d = %t, want %t", test.size, test.min, test.max, got, test.want)
+		}
+	}
+}
+
+func TestGroupDates(t *testing.T) {
+	course := &pb.Course{GroupDeadline: "2021-09-01T12:00:00", GroupFreezeDate: "2021-10-01T12:00:00"}
+	tests := []struct {
+		now            string
+		closed, frozen bool
+	}{
+		{"2021-08-31T12:00:00", false, false},
+		{"2021-09-01T12:00:01", true, false},
+		{"2021-10-01T12:00:01", true, true},
+	}
+	for _, test := range tests {
+		now, err := time.Parse(pb.TimeLayout, test.now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := course.GroupFormationClosed(now); got != test.closed {
+			t.Errorf("GroupFormationClosed(%s) = %t, want %t", test.now, got, test.closed)
+		}
+		if got := course.GroupsFrozen(now); got != test.frozen {
+			t.Errorf("GroupsFrozen(%s) = %t, want %t", test.now, got, test.frozen)
+		}
+	}
+	if (&pb.Course{}).GroupFormationClosed(time.Now()) || (&pb.Course{}).GroupsFrozen(time.Now()) {
+		t.Error("course without group deadline or freeze date is closed for group formation")
+	}
+}
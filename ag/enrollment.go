@@ -5,49 +5,59 @@ import (
 	"time"
 )
 
-// gracePeriod is the grace period for submissions after the deadline.
-// The grace period should be hours in the range 0-23.
-// Note grace period applies to all enrollments (courses).
-const gracePeriod time.Duration = time.Duration(2 * time.Hour)
-
 // UpdateSlipDays updates the number of slipdays for the given assignment/submission.
+// The slip days are counted according to the rules of the enrollment's course.
 func (m *Enrollment) UpdateSlipDays(start time.Time, assignment *Assignment, submission *Submission) error {
+	slipDays, late, err := m.slipDaysLate(start, assignment, submission)
+	if err != nil {
+		return err
+	}
+	if late {
+		m.updateSlipDays(assignment.GetID(), slipDays)
+	}
+	return nil
+}
+
+// slipDaysLate returns the number of slip days used for the given assignment/submission,
+// and whether the submission should use slip days.
+func (m *Enrollment) slipDaysLate(start time.Time, assignment *Assignment, submission *Submission) (uint32, bool, error) {
+	if m.GetCourse() == nil {
+		return 0, false, fmt.Errorf("missing course for enrollment %d", m.GetID())
+	}
 	if m.GetCourseID() != assignment.GetCourseID() {
-		return fmt.Errorf("invariant violation (enrollment.CourseID != assignment.CourseID) (%d != %d)", m.CourseID, assignment.CourseID)
+		return 0, false, fmt.Errorf("invariant violation (enrollment.CourseID != assignment.CourseID) (%d != %d)", m.CourseID, assignment.CourseID)
 	}
 	if assignment.GetID() != submission.GetAssignmentID() {
-		return fmt.Errorf("invariant violation (assignment.ID != submission.AssignmentID) (%d != %d)", assignment.ID, submission.AssignmentID)
+		return 0, false, fmt.Errorf("invariant violation (assignment.ID != submission.AssignmentID) (%d != %d)", assignment.ID, submission.AssignmentID)
 	}
 	sinceDeadline, err := assignment.SinceDeadline(start)
 	if err != nil {
-		return err
+		return 0, false, err
 	}
 	// if score is less than limit and it's not yet approved, update slip days if deadline has passed
 	if submission.Score < assignment.ScoreLimit && submission.Status != Submission_APPROVED && sinceDeadline > 0 {
 		// deadline exceeded; calculate used slipdays for this assignment
-		slpDays, slpHours := uint32(sinceDeadline/days), sinceDeadline%days
-		// slpHours is hours after deadline, excluding subsequent full-day slipdays after deadline
-		if slpHours > gracePeriod {
-			slpDays++
-		}
-		m.updateSlipDays(assignment.GetID(), slpDays)
+		return m.GetCourse().SlipDaysLate(sinceDeadline), true, nil
 	}
-	return nil
+	return 0, false, nil
 }
 
-// updateSlipDays updates the number of slipdays for the given assignment.
+// updateSlipDays updates the number of slipdays for the given assignment,
+// keeping any manual adjustments made by teachers.
 func (m *Enrollment) updateSlipDays(assignmentID uint64, slipDays uint32) {
 	for _, val := range m.GetUsedSlipDays() {
 		if val.AssignmentID == assignmentID {
-			val.UsedSlipDays = slipDays
+			val.AutomaticSlipDays = slipDays
+			val.UsedSlipDays = val.Adjusted(slipDays)
 			return
 		}
 	}
 	// not found; add new entry to the slice
 	m.UsedSlipDays = append(m.UsedSlipDays, &UsedSlipDays{
-		AssignmentID: assignmentID,
-		EnrollmentID: m.ID,
-		UsedSlipDays: slipDays,
+		AssignmentID:      assignmentID,
+		EnrollmentID:      m.ID,
+		UsedSlipDays:      slipDays,
+		AutomaticSlipDays: slipDays,
 	})
 }
 
@@ -86,3 +96,18 @@ func (m *Enrollment) IsTeacher() bool {
 func (m *Enrollment) IsStudent() bool {
 	return m.GetStatus() == Enrollment_STUDENT
 }
+
+// IsTA returns true if the enrollment is of a teaching assistant.
+func (m *Enrollment) IsTA() bool {
+	return m.GetStatus() == Enrollment_TA
+}
+
+// Adjusted returns the given number of slip days with the manual adjustment
+// for this assignment applied. The result is never negative.
+func (u *UsedSlipDays) Adjusted(slipDays uint32) uint32 {
+	total := int64(slipDays) + int64(u.GetAdjustment())
+	if total < 0 {
+		return 0
+	}
+	return uint32(total)
+}
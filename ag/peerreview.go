@@ -0,0 +1,122 @@
+package ag
+
+import "sort"
+
+// peerReviewOutlierLimit is the largest difference between the score of a peer review
+// and the median score of the submission's peer reviews, before the peer review is
+// flagged as an outlier.
+const peerReviewOutlierLimit = 25
+
+// AssignPeerReviewers returns up to n peer reviewers for each submission to the given assignment.
+// The authors map holds the user IDs of the students that authored each submission, keyed by
+// submission ID. Students are only assigned to review submissions authored by others, and
+// the reviews are distributed evenly among all the authors. The assigned peer reviewers count
+// towards each submission's n reviewers and the reviewers' load, and are not returned, such
+// that only submissions with fewer than n reviewers are assigned new peer reviewers.
+func AssignPeerReviewers(assignmentID uint64, n uint32, authors map[uint64][]uint64, assigned []*PeerReviewer) []*PeerReviewer {
+	submissionIDs := make([]uint64, 0, len(authors))
+	reviewerSet := make(map[uint64]bool)
+	for submissionID, userIDs := range authors {
+		submissionIDs = append(submissionIDs, submissionID)
+		for _, userID := range userIDs {
+			reviewerSet[userID] = true
+		}
+	}
+	reviewerIDs := make([]uint64, 0, len(reviewerSet))
+	for userID := range reviewerSet {
+		reviewerIDs = append(reviewerIDs, userID)
+	}
+	assignedReviewers := make(map[uint64][]uint64)
+	for _, pr := range assigned {
+		assignedReviewers[pr.GetSubmissionID()] = append(assignedReviewers[pr.GetSubmissionID()], pr.GetReviewerID())
+	}
+	peerReviewers := make([]*PeerReviewer, 0)
+	for _, pair := range distributeReviews(submissionIDs, reviewerIDs, n, assignedReviewers, authors) {
+		if containsID(assignedReviewers[pair.submissionID], pair.reviewerID) {
+			continue
+		}
+		peerReviewers = append(peerReviewers, &PeerReviewer{
+			AssignmentID: assignmentID,
+			SubmissionID: pair.submissionID,
+			ReviewerID:   pair.reviewerID,
+		})
+	}
+	return peerReviewers
+}
+
+// UpdatePeerScore flags the submission's ready peer reviews that are outliers, and sets
+// the submission's peer score to the average score of the ready peer reviews that are
+// not excluded by a teacher.
+func (s *Submission) UpdatePeerScore() {
+	ready := make([]*Review, 0)
+	for _, review := range s.GetReviews() {
+		if review.GetPeerReview() {
+			review.Outlier = false
+			if review.GetReady() {
+				ready = append(ready, review)
+			}
+		}
+	}
+	median := medianScore(ready)
+	var total, count uint32
+	for _, review := range ready {
+		diff := int64(review.GetScore()) - int64(median)
+		review.Outlier = diff > peerReviewOutlierLimit || diff < -peerReviewOutlierLimit
+		if !review.GetExcluded() {
+			total += review.GetScore()
+			count++
+		}
+	}
+	s.PeerScore = 0
+	if count > 0 {
+		s.PeerScore = total / count
+	}
+}
+
+// medianScore returns the median score of the given reviews.
+func medianScore(reviews []*Review) uint32 {
+	if len(reviews) == 0 {
+		return 0
+	}
+	scores := make([]uint32, len(reviews))
+	for i, review := range reviews {
+		scores[i] = review.GetScore()
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i] < scores[j] })
+	mid := len(scores) / 2
+	if len(scores)%2 == 0 {
+		return (scores[mid-1] + scores[mid]) / 2
+	}
+	return scores[mid]
+}
+
+// PeerReviews returns the peer reviews of the submission.
+func (s *Submission) PeerReviews() []*Review {
+	reviews := make([]*Review, 0)
+	for _, review := range s.GetReviews() {
+		if review.GetPeerReview() {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews
+}
+
+// TeacherReviews returns the reviews of the submission made by teachers.
+func (s *Submission) TeacherReviews() []*Review {
+	reviews := make([]*Review, 0)
+	for _, review := range s.GetReviews() {
+		if !review.GetPeerReview() {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews
+}
+
+// HidePeerReviewers removes the identity of peer reviewers from the submission's reviews.
+func (s *Submission) HidePeerReviewers() {
+	for _, review := range s.GetReviews() {
+		if review.GetPeerReview() {
+			review.ReviewerID = 0
+		}
+	}
+}
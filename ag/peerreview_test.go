@@ -0,0 +1,145 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestAssignPeerReviewers(t *testing.T) {
+	assignPeerReviewerTests := []struct {
+		name    string
+		n       uint32
+		authors map[uint64][]uint64 // submission ID -> author user IDs
+		want    int                 // number of reviews per submission
+	}{
+		{"OneReviewer", 1, map[uint64][]uint64{1: {1}, 2: {2}, 3: {3}}, 1},
+		{"TwoReviewers", 2, map[uint64][]uint64{1: {1}, 2: {2}, 3: {3}, 4: {4}, 5: {5}}, 2},
+		{"AllOtherStudents", 3, map[uint64][]uint64{1: {1}, 2: {2}, 3: {3}, 4: {4}}, 3},
+		{"TooFewStudents", 3, map[uint64][]uint64{1: {1}, 2: {2}}, 1},
+		{"Groups", 2, map[uint64][]uint64{1: {1, 2}, 2: {3, 4}, 3: {5, 6}}, 2},
+		{"GroupsAndStudents", 2, map[uint64][]uint64{1: {1, 2}, 2: {3}, 3: {4}, 4: {5, 6, 7}}, 2},
+	}
+	for _, test := range assignPeerReviewerTests {
+		t.Run(test.name, func(t *testing.T) {
+			peerReviewers := pb.AssignPeerReviewers(1, test.n, test.authors, nil)
+			reviews := make(map[uint64]map[uint64]bool) // submission ID -> reviewer IDs
+			load := make(map[uint64]int)                // reviewer ID -> number of reviews
+			for _, pr := range peerReviewers {
+				if pr.GetAssignmentID() != 1 {
+					t.Errorf("AssignmentID = %d, want %d", pr.GetAssignmentID(), 1)
+				}
+				for _, author := range test.authors[pr.GetSubmissionID()] {
+					if author == pr.GetReviewerID() {
+						t.Errorf("user %d assigned to review own submission %d", author, pr.GetSubmissionID())
+					}
+				}
+				if reviews[pr.GetSubmissionID()] == nil {
+					reviews[pr.GetSubmissionID()] = make(map[uint64]bool)
+				}
+				if reviews[pr.GetSubmissionID()][pr.GetReviewerID()] {
+					t.Errorf("user %d assigned to review submission %d more than once", pr.GetReviewerID(), pr.GetSubmissionID())
+				}
+				reviews[pr.GetSubmissionID()][pr.GetReviewerID()] = true
+				load[pr.GetReviewerID()]++
+			}
+			for submissionID := range test.authors {
+				if got := len(reviews[submissionID]); got != test.want {
+					t.Errorf("submission %d has %d reviewers, want %d", submissionID, got, test.want)
+				}
+			}
+			// the reviews must be distributed evenly among the students
+			min, max := len(peerReviewers), 0
+			for _, userIDs := range test.authors {
+				for _, userID := range userIDs {
+					if load[userID] < min {
+						min = load[userID]
+					}
+					if load[userID] > max {
+						max = load[userID]
+					}
+				}
+			}
+			if max-min > 1 {
+				t.Errorf("uneven review load: min %d, max %d: %v", min, max, load)
+			}
+		})
+	}
+}
+
+func TestUpdatePeerScore(t *testing.T) {
+	peerScoreTests := []struct {
+		name         string
+		reviews      []*pb.Review
+		wantScore    uint32
+		wantOutliers []bool
+	}{
+		{
+			name:         "NoReviews",
+			reviews:      []*pb.Review{},
+			wantScore:    0,
+			wantOutliers: []bool{},
+		},
+		{
+			name: "OnlyTeacherReviews",
+			reviews: []*pb.Review{
+				{Score: 50, Ready: true},
+			},
+			wantScore:    0,
+			wantOutliers: []bool{false},
+		},
+		{
+			name: "ReadyPeerReviews",
+			reviews: []*pb.Review{
+				{Score: 60, Ready: true, PeerReview: true},
+				{Score: 80, Ready: true, PeerReview: true},
+				{Score: 10, Ready: false, PeerReview: true},
+				{Score: 10, Ready: true},
+			},
+			wantScore:    70,
+			wantOutliers: []bool{false, false, false, false},
+		},
+		{
+			name: "Outlier",
+			reviews: []*pb.Review{
+				{Score: 70, Ready: true, PeerReview: true},
+				{Score: 80, Ready: true, PeerReview: true},
+				{Score: 10, Ready: true, PeerReview: true},
+			},
+			wantScore:    53,
+			wantOutliers: []bool{false, false, true},
+		},
+		{
+			name: "ExcludedOutlier",
+			reviews: []*pb.Review{
+				{Score: 70, Ready: true, PeerReview: true},
+				{Score: 80, Ready: true, PeerReview: true},
+				{Score: 10, Ready: true, PeerReview: true, Excluded: true},
+			},
+			wantScore:    75,
+			wantOutliers: []bool{false, false, true},
+		},
+		{
+			name: "AllExcluded",
+			reviews: []*pb.Review{
+				{Score: 70, Ready: true, PeerReview: true, Excluded: true},
+			},
+			wantScore:    0,
+			wantOutliers: []bool{false},
+		},
+	}
+	for _, test := range peerScoreTests {
+		t.Run(test.name, func(t *testing.T) {
+			submission := &pb.Submission{Reviews: test.reviews}
+			submission.UpdatePeerScore()
+			if submission.GetPeerScore() != test.wantScore {
+				t.Errorf("PeerScore = %d, want %d", submission.GetPeerScore(), test.wantScore)
+			}
+			for i, review := range submission.GetReviews() {
+				if review.GetOutlier() != test.wantOutliers[i] {
+					t.Errorf("review %d: Outlier = %t, want %t", i, review.GetOutlier(), test.wantOutliers[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package ag
+
+// criterionKey identifies a grading criterion across the reviews of a submission.
+type criterionKey struct {
+	heading     string
+	description string
+}
+
+// Reconcile compares the grades given to each grading criterion by the submission's
+// ready teacher reviews, and applies the lead teacher's grade overrides.
+// Criteria that all the reviews agree on get the agreed grade, unless overridden.
+// Criteria with a disagreement only get a final grade if overridden.
+// The reconciled score is computed from the final grades.
+func (s *Submission) Reconcile(overrides []*GradeOverride) *Reconciliation {
+	var reviews []*Review
+	for _, review := range s.TeacherReviews() {
+		if review.GetReady() {
+			reviews = append(reviews, review)
+		}
+	}
+	reconciliation := &Reconciliation{SubmissionID: s.GetID()}
+	criteria := make(map[criterionKey]*CriterionReconciliation)
+	for i, review := range reviews {
+		reconciliation.ReviewIDs = append(reconciliation.ReviewIDs, review.GetID())
+		for _, bm := range review.GetGradingBenchmarks() {
+			for _, c := range bm.GetCriteria() {
+				key := criterionKey{heading: bm.GetHeading(), description: c.GetDescription()}
+				cr, ok := criteria[key]
+				if !ok {
+					cr = &CriterionReconciliation{
+						Heading:     bm.GetHeading(),
+						Description: c.GetDescription(),
+						Points:      c.GetPoints(),
+						Grades:      make([]GradingCriterion_Grade, len(reviews)),
+					}
+					criteria[key] = cr
+					reconciliation.Criteria = append(reconciliation.Criteria, cr)
+				}
+				cr.Grades[i] = c.GetGrade()
+			}
+		}
+	}
+	overridden := make(map[criterionKey]GradingCriterion_Grade)
+	for _, override := range overrides {
+		overridden[criterionKey{heading: override.GetHeading(), description: override.GetDescription()}] = override.GetGrade()
+	}
+
+	// the final grades are scored as a single review
+	final := &GradingBenchmark{}
+	reconciliation.Resolved = len(reviews) > 0
+	for _, cr := range reconciliation.Criteria {
+		cr.Disagreement = !sameGrades(cr.Grades)
+		key := criterionKey{heading: cr.GetHeading(), description: cr.GetDescription()}
+		if grade := overridden[key]; grade != GradingCriterion_NONE {
+			cr.Grade = grade
+			cr.Overridden = true
+		} else if !cr.Disagreement {
+			cr.Grade = cr.Grades[0]
+		}
+		if cr.Grade == GradingCriterion_NONE {
+			reconciliation.Resolved = false
+		}
+		final.Criteria = append(final.Criteria, &GradingCriterion{Points: cr.GetPoints(), Grade: cr.GetGrade()})
+	}
+	review := &Review{GradingBenchmarks: []*GradingBenchmark{final}}
+	review.ComputeScore()
+	reconciliation.Score = review.GetScore()
+	return reconciliation
+}
+
+// sameGrades returns true if all the given grades are the same.
+func sameGrades(grades []GradingCriterion_Grade) bool {
+	for _, grade := range grades {
+		if grade != grades[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// HideOtherReviewers removes the identity of the teachers that reviewed the submission,
+// except for the reviews made by the given user.
+func (s *Submission) HideOtherReviewers(userID uint64) {
+	for _, review := range s.GetReviews() {
+		if !review.GetPeerReview() && review.GetReviewerID() != userID {
+			review.ReviewerID = 0
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func reconciliationReview(id, reviewerID uint64, grades ...pb.GradingCriterion_Grade) *pb.Review {
+	bm := &pb.GradingBenchmark{Heading: "Code"}
+	for i, grade := range grades {
+		bm.Criteria = append(bm.Criteria, &pb.GradingCriterion{
+			Description: []string{"Compiles", "Tested", "Readable"}[i],
+			Grade:       grade,
+		})
+	}
+	return &pb.Review{ID: id, ReviewerID: reviewerID, Ready: true, GradingBenchmarks: []*pb.GradingBenchmark{bm}}
+}
+
+func TestReconcile(t *testing.T) {
+	passed, failed := pb.GradingCriterion_PASSED, pb.GradingCriterion_FAILED
+	reconcileTests := []struct {
+		name             string
+		reviews          []*pb.Review
+		overrides        []*pb.GradeOverride
+		wantDisagreement []bool
+		wantResolved     bool
+		wantScore        uint32
+	}{
+		{
+			name:         "NoReviews",
+			wantResolved: false,
+		},
+		{
+			name: "Agreement",
+			reviews: []*pb.Review{
+				reconciliationReview(1, 1, passed, passed, failed),
+				reconciliationReview(2, 2, passed, passed, failed),
+			},
+			wantDisagreement: []bool{false, false, false},
+			wantResolved:     true,
+			wantScore:        66,
+		},
+		{
+			name: "Disagreement",
+			reviews: []*pb.Review{
+				reconciliationReview(1, 1, passed, passed, failed),
+				reconciliationReview(2, 2, passed, failed, failed),
+			},
+			wantDisagreement: []bool{false, true, false},
+			wantResolved:     false,
+			wantScore:        33,
+		},
+		{
+			name: "OverriddenDisagreement",
+			reviews: []*pb.Review{
+				reconciliationReview(1, 1, passed, passed, failed),
+				reconciliationReview(2, 2, passed, failed, failed),
+			},
+			overrides:        []*pb.GradeOverride{{Heading: "Code", Description: "Tested", Grade: passed}},
+			wantDisagreement: []bool{false, true, false},
+			wantResolved:     true,
+			wantScore:        66,
+		},
+		{
+			name: "OverriddenAgreement",
+			reviews: []*pb.Review{
+				reconciliationReview(1, 1, passed, passed, failed),
+				reconciliationReview(2, 2, passed, passed, failed),
+			},
+			overrides:        []*pb.GradeOverride{{Heading: "Code", Description: "Readable", Grade: passed}},
+			wantDisagreement: []bool{false, false, false},
+			wantResolved:     true,
+			wantScore:        100,
+		},
+		{
+			name: "IgnoresPeerAndUnreadyReviews",
+			reviews: []*pb.Review{
+				reconciliationReview(1, 1, passed, passed, failed),
+				{ID: 2, ReviewerID: 2, PeerReview: true, Ready: true},
+				{ID: 3, ReviewerID: 3, Ready: false},
+			},
+			wantDisagreement: []bool{false, false, false},
+			wantResolved:     true,
+			wantScore:        66,
+		},
+	}
+	for _, test := range reconcileTests {
+		t.Run(test.name, func(t *testing.T) {
+			submission := &pb.Submission{ID: 1, Reviews: test.reviews}
+			reconciliation := submission.Reconcile(test.overrides)
+			if len(reconciliation.GetCriteria()) != len(test.wantDisagreement) {
+				t.Fatalf("Reconcile() returned %d criteria, want %d", len(reconciliation.GetCriteria()), len(test.wantDisagreement))
+			}
+			for i, cr := range reconciliation.GetCriteria() {
+				if cr.GetDisagreement() != test.wantDisagreement[i] {
+					t.Errorf("criterion %q: Disagreement = %t, want %t", cr.GetDescription(), cr.GetDisagreement(), test.wantDisagreement[i])
+				}
+			}
+			if reconciliation.GetResolved() != test.wantResolved {
+				t.Errorf("Resolved = %t, want %t", reconciliation.GetResolved(), test.wantResolved)
+			}
+			if reconciliation.GetScore() != test.wantScore {
+				t.Errorf("Score = %d, want %d", reconciliation.GetScore(), test.wantScore)
+			}
+		})
+	}
+}
+
+func TestHideOtherReviewers(t *testing.T) {
+	submission := &pb.Submission{Reviews: []*pb.Review{
+		{ReviewerID: 1},
+		{ReviewerID: 2},
+		{ReviewerID: 3, PeerReview: true},
+	}}
+	submission.HideOtherReviewers(1)
+	want := []uint64{1, 0, 3}
+	for i, review := range submission.GetReviews() {
+		if review.GetReviewerID() != want[i] {
+			t.Errorf("review %d: ReviewerID = %d, want %d", i, review.GetReviewerID(), want[i])
+		}
+	}
+}
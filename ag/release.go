@@ -0,0 +1,31 @@
+package ag
+
+import (
+	"time"
+)
+
+// ReleaseAt returns the time when the reviews and scores for the given assignment
+// should be released, expressed in the given location.
+func (r *ReleaseSchedule) ReleaseAt(assignment *Assignment, loc *time.Location) (time.Time, error) {
+	if r.GetDaysAfterDeadline() > 0 {
+		deadline, err := time.ParseInLocation(TimeLayout, assignment.GetDeadline(), loc)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return deadline.Add(time.Duration(r.GetDaysAfterDeadline()) * days), nil
+	}
+	return time.ParseInLocation(TimeLayout, r.GetReleaseTime(), loc)
+}
+
+// IsDue returns true if the scheduled release for the given assignment
+// has not yet been performed and its release time has passed.
+func (r *ReleaseSchedule) IsDue(assignment *Assignment, now time.Time) (bool, error) {
+	if r.GetReleasedDate() != "" {
+		return false, nil
+	}
+	releaseAt, err := r.ReleaseAt(assignment, now.Location())
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(releaseAt), nil
+}
@@ -0,0 +1,44 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestReleaseScheduleIsDue(t *testing.T) {
+	assignment := &pb.Assignment{Deadline: "2021-03-01T12:00:00"}
+	releaseTests := []struct {
+		name     string
+		schedule *pb.ReleaseSchedule
+		now      string
+		want     bool
+	}{
+		{"BeforeReleaseTime", &pb.ReleaseSchedule{ReleaseTime: "2021-03-05T08:00:00"}, "2021-03-05T07:59:59", false},
+		{"AtReleaseTime", &pb.ReleaseSchedule{ReleaseTime: "2021-03-05T08:00:00"}, "2021-03-05T08:00:00", true},
+		{"BeforeDaysAfterDeadline", &pb.ReleaseSchedule{DaysAfterDeadline: 3}, "2021-03-04T11:00:00", false},
+		{"DaysAfterDeadline", &pb.ReleaseSchedule{DaysAfterDeadline: 3}, "2021-03-04T12:00:00", true},
+		{"DaysAfterDeadlineIgnoresReleaseTime", &pb.ReleaseSchedule{DaysAfterDeadline: 3, ReleaseTime: "2021-03-02T12:00:00"}, "2021-03-03T12:00:00", false},
+		{"AlreadyReleased", &pb.ReleaseSchedule{ReleaseTime: "2021-03-05T08:00:00", ReleasedDate: "2021-03-05T08:01:00"}, "2021-03-06T08:00:00", false},
+	}
+	for _, test := range releaseTests {
+		t.Run(test.name, func(t *testing.T) {
+			now, err := time.Parse(pb.TimeLayout, test.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := test.schedule.IsDue(assignment, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("IsDue(%s) = %t, want %t", test.now, got, test.want)
+			}
+		})
+	}
+
+	if _, err := (&pb.ReleaseSchedule{ReleaseTime: "tomorrow"}).IsDue(assignment, time.Now()); err == nil {
+		t.Error("IsDue() with invalid release time: expected error")
+	}
+}
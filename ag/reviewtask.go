@@ -0,0 +1,142 @@
+package ag
+
+import "sort"
+
+// reviewPair is a reviewer assigned to review a submission.
+type reviewPair struct {
+	submissionID uint64
+	reviewerID   uint64
+}
+
+// distributeReviews assigns up to n of the given reviewers to each of the given submissions,
+// such that the reviews are distributed evenly among the reviewers. The assigned map holds
+// the reviewers already assigned to each submission, keyed by submission ID; these count
+// towards the submission's n reviewers and the reviewers' load, and are included in the result.
+// Reviewers are never assigned to a submission for which the excluded map holds their user ID.
+func distributeReviews(submissionIDs, reviewerIDs []uint64, n uint32, assigned, excluded map[uint64][]uint64) []reviewPair {
+	submissionIDs = sortedIDs(submissionIDs)
+	reviewerIDs = sortedIDs(reviewerIDs)
+
+	load := make(map[uint64]int)
+	pairs := make([]reviewPair, 0)
+	for _, submissionID := range submissionIDs {
+		for _, userID := range assigned[submissionID] {
+			load[userID]++
+			pairs = append(pairs, reviewPair{submissionID: submissionID, reviewerID: userID})
+		}
+	}
+	if len(reviewerIDs) == 0 {
+		return pairs
+	}
+	for i, submissionID := range submissionIDs {
+		slots := int(n) - len(assigned[submissionID])
+		if slots <= 0 {
+			continue
+		}
+		// rotate the candidates for each submission to spread reviews among reviewers with equal load
+		candidates := make([]uint64, 0, len(reviewerIDs))
+		for j := range reviewerIDs {
+			userID := reviewerIDs[(i+j+1)%len(reviewerIDs)]
+			if !containsID(excluded[submissionID], userID) && !containsID(assigned[submissionID], userID) {
+				candidates = append(candidates, userID)
+			}
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return load[candidates[a]] < load[candidates[b]]
+		})
+		if len(candidates) > slots {
+			candidates = candidates[:slots]
+		}
+		for _, userID := range candidates {
+			load[userID]++
+			pairs = append(pairs, reviewPair{submissionID: submissionID, reviewerID: userID})
+		}
+	}
+	return pairs
+}
+
+// sortedIDs returns a sorted copy of the given IDs.
+func sortedIDs(ids []uint64) []uint64 {
+	sorted := make([]uint64, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func containsID(ids []uint64, id uint64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// DistributeReviewTasks returns review tasks that distribute the given submissions evenly
+// among the given teacher reviewers, such that each submission gets n reviewers, if possible.
+// Teachers that have already reviewed a submission keep their review task for that submission.
+// If the excluded map is given, reviewers are not assigned to submissions for which the map,
+// keyed by submission ID, holds their user ID.
+func DistributeReviewTasks(assignmentID uint64, n uint32, reviewerIDs []uint64, submissions []*Submission, excluded map[uint64][]uint64) []*ReviewTask {
+	submissionIDs := make([]uint64, 0, len(submissions))
+	assigned := make(map[uint64][]uint64)
+	for _, submission := range submissions {
+		submissionIDs = append(submissionIDs, submission.GetID())
+		for _, review := range submission.TeacherReviews() {
+			if !containsID(assigned[submission.GetID()], review.GetReviewerID()) {
+				assigned[submission.GetID()] = append(assigned[submission.GetID()], review.GetReviewerID())
+			}
+		}
+	}
+	tasks := make([]*ReviewTask, 0)
+	for _, pair := range distributeReviews(submissionIDs, reviewerIDs, n, assigned, excluded) {
+		tasks = append(tasks, &ReviewTask{
+			AssignmentID: assignmentID,
+			SubmissionID: pair.submissionID,
+			ReviewerID:   pair.reviewerID,
+		})
+	}
+	return tasks
+}
+
+// ReviewBy returns the submission's teacher review made by the given reviewer, or nil if there is none.
+func (s *Submission) ReviewBy(reviewerID uint64) *Review {
+	for _, review := range s.TeacherReviews() {
+		if review.GetReviewerID() == reviewerID {
+			return review
+		}
+	}
+	return nil
+}
+
+// NewReviewProgress returns the review progress of each reviewer with review tasks,
+// given the review tasks and the reviewed submissions, ordered by reviewer ID.
+func NewReviewProgress(tasks []*ReviewTask, submissions []*Submission) *ReviewProgress {
+	submissionMap := make(map[uint64]*Submission)
+	for _, submission := range submissions {
+		submissionMap[submission.GetID()] = submission
+	}
+	progressMap := make(map[uint64]*ReviewerProgress)
+	for _, task := range tasks {
+		progress, ok := progressMap[task.GetReviewerID()]
+		if !ok {
+			progress = &ReviewerProgress{ReviewerID: task.GetReviewerID()}
+			progressMap[task.GetReviewerID()] = progress
+		}
+		progress.Assigned++
+		if review := submissionMap[task.GetSubmissionID()].ReviewBy(task.GetReviewerID()); review != nil {
+			progress.Reviewed++
+			if review.GetReady() {
+				progress.Ready++
+			}
+		}
+	}
+	reviewers := make([]*ReviewerProgress, 0, len(progressMap))
+	for _, progress := range progressMap {
+		reviewers = append(reviewers, progress)
+	}
+	sort.Slice(reviewers, func(i, j int) bool {
+		return reviewers[i].GetReviewerID() < reviewers[j].GetReviewerID()
+	})
+	return &ReviewProgress{Reviewers: reviewers}
+}
@@ -0,0 +1,137 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestDistributeReviewTasks(t *testing.T) {
+	distributeTests := []struct {
+		name        string
+		n           uint32
+		reviewerIDs []uint64
+		submissions []*pb.Submission
+		excluded    map[uint64][]uint64
+		wantLoad    map[uint64]int // reviewer ID -> number of review tasks
+	}{
+		{
+			name:        "NoReviewers",
+			n:           1,
+			reviewerIDs: []uint64{},
+			submissions: []*pb.Submission{{ID: 1}, {ID: 2}},
+			wantLoad:    map[uint64]int{},
+		},
+		{
+			name:        "EvenSplit",
+			n:           1,
+			reviewerIDs: []uint64{10, 11},
+			submissions: []*pb.Submission{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}},
+			wantLoad:    map[uint64]int{10: 2, 11: 2},
+		},
+		{
+			name:        "TwoReviewsEach",
+			n:           2,
+			reviewerIDs: []uint64{10, 11, 12},
+			submissions: []*pb.Submission{{ID: 1}, {ID: 2}, {ID: 3}},
+			wantLoad:    map[uint64]int{10: 2, 11: 2, 12: 2},
+		},
+		{
+			name:        "ExistingReview",
+			n:           1,
+			reviewerIDs: []uint64{10, 11},
+			submissions: []*pb.Submission{
+				{ID: 1, Reviews: []*pb.Review{{ReviewerID: 10}}},
+				{ID: 2, Reviews: []*pb.Review{{ReviewerID: 10}}},
+				{ID: 3},
+				{ID: 4},
+			},
+			wantLoad: map[uint64]int{10: 2, 11: 2},
+		},
+		{
+			name:        "PeerReviewsIgnored",
+			n:           1,
+			reviewerIDs: []uint64{10, 11},
+			submissions: []*pb.Submission{
+				{ID: 1, Reviews: []*pb.Review{{ReviewerID: 3, PeerReview: true}}},
+				{ID: 2},
+			},
+			wantLoad: map[uint64]int{10: 1, 11: 1},
+		},
+		{
+			name:        "ExcludeOwnGroup",
+			n:           1,
+			reviewerIDs: []uint64{10, 11},
+			submissions: []*pb.Submission{{ID: 1}, {ID: 2}, {ID: 3}},
+			excluded:    map[uint64][]uint64{1: {10}, 2: {10}, 3: {10}},
+			wantLoad:    map[uint64]int{11: 3},
+		},
+	}
+	for _, test := range distributeTests {
+		t.Run(test.name, func(t *testing.T) {
+			tasks := pb.DistributeReviewTasks(1, test.n, test.reviewerIDs, test.submissions, test.excluded)
+			load := make(map[uint64]int)
+			reviewers := make(map[uint64]int)
+			for _, task := range tasks {
+				if task.GetAssignmentID() != 1 {
+					t.Errorf("AssignmentID = %d, want %d", task.GetAssignmentID(), 1)
+				}
+				for _, excluded := range test.excluded[task.GetSubmissionID()] {
+					if excluded == task.GetReviewerID() {
+						t.Errorf("reviewer %d assigned to excluded submission %d", excluded, task.GetSubmissionID())
+					}
+				}
+				load[task.GetReviewerID()]++
+				reviewers[task.GetSubmissionID()]++
+			}
+			if diff := cmp.Diff(test.wantLoad, load); diff != "" {
+				t.Errorf("DistributeReviewTasks() load mismatch (-want +got):\n%s", diff)
+			}
+			for _, submission := range test.submissions {
+				for _, review := range submission.TeacherReviews() {
+					if !hasTask(tasks, submission.GetID(), review.GetReviewerID()) {
+						t.Errorf("reviewer %d lost review task for submission %d", review.GetReviewerID(), submission.GetID())
+					}
+				}
+				if len(test.reviewerIDs) > 0 && reviewers[submission.GetID()] != int(test.n) {
+					t.Errorf("submission %d has %d reviewers, want %d", submission.GetID(), reviewers[submission.GetID()], test.n)
+				}
+			}
+		})
+	}
+}
+
+func hasTask(tasks []*pb.ReviewTask, submissionID, reviewerID uint64) bool {
+	for _, task := range tasks {
+		if task.GetSubmissionID() == submissionID && task.GetReviewerID() == reviewerID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestNewReviewProgress(t *testing.T) {
+	tasks := []*pb.ReviewTask{
+		{SubmissionID: 1, ReviewerID: 11},
+		{SubmissionID: 2, ReviewerID: 11},
+		{SubmissionID: 3, ReviewerID: 11},
+		{SubmissionID: 1, ReviewerID: 10},
+	}
+	submissions := []*pb.Submission{
+		{ID: 1, Reviews: []*pb.Review{{ReviewerID: 11, Ready: true}, {ReviewerID: 10}}},
+		{ID: 2, Reviews: []*pb.Review{{ReviewerID: 11}, {ReviewerID: 12, Ready: true}}},
+		{ID: 3},
+	}
+	want := &pb.ReviewProgress{
+		Reviewers: []*pb.ReviewerProgress{
+			{ReviewerID: 10, Assigned: 1, Reviewed: 1, Ready: 0},
+			{ReviewerID: 11, Assigned: 3, Reviewed: 2, Ready: 1},
+		},
+	}
+	got := pb.NewReviewProgress(tasks, submissions)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("NewReviewProgress() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,71 @@
+package ag
+
+// MergeBenchmarks returns the benchmarks of the given rubric merged with the existing benchmarks,
+// and the existing benchmarks and criteria that are not part of the rubric.
+// Existing benchmarks are matched with the rubric's benchmarks by heading, and existing criteria
+// with the rubric's criteria by description. Matched benchmarks and criteria keep their ID,
+// comment and grade, and take the points of the rubric's criteria. Benchmarks and criteria that
+// are new in the rubric have zero IDs and no grades.
+func MergeBenchmarks(existing, rubric []*GradingBenchmark) (merged, removed []*GradingBenchmark, removedCriteria []*GradingCriterion) {
+	benchmarks := make(map[string]*GradingBenchmark)
+	for _, bm := range existing {
+		benchmarks[bm.GetHeading()] = bm
+	}
+	kept := make(map[uint64]bool)
+	for _, rubricBM := range rubric {
+		bm := &GradingBenchmark{
+			Heading: rubricBM.GetHeading(),
+			Comment: rubricBM.GetComment(),
+		}
+		criteria := make(map[string]*GradingCriterion)
+		if old, ok := benchmarks[rubricBM.GetHeading()]; ok && !kept[old.GetID()] {
+			kept[old.GetID()] = true
+			bm.ID = old.GetID()
+			bm.AssignmentID = old.GetAssignmentID()
+			bm.ReviewID = old.GetReviewID()
+			bm.Comment = old.GetComment()
+			for _, c := range old.GetCriteria() {
+				criteria[c.GetDescription()] = c
+			}
+		}
+		keptCriteria := make(map[uint64]bool)
+		for _, rubricC := range rubricBM.GetCriteria() {
+			c := &GradingCriterion{
+				BenchmarkID: bm.GetID(),
+				Description: rubricC.GetDescription(),
+				Points:      rubricC.GetPoints(),
+			}
+			if old, ok := criteria[rubricC.GetDescription()]; ok && !keptCriteria[old.GetID()] {
+				keptCriteria[old.GetID()] = true
+				c.ID = old.GetID()
+				c.Grade = old.GetGrade()
+				c.Comment = old.GetComment()
+			}
+			bm.Criteria = append(bm.Criteria, c)
+		}
+		for _, c := range criteria {
+			if !keptCriteria[c.GetID()] {
+				removedCriteria = append(removedCriteria, c)
+			}
+		}
+		merged = append(merged, bm)
+	}
+	for _, bm := range existing {
+		if !kept[bm.GetID()] {
+			removed = append(removed, bm)
+		}
+	}
+	return merged, removed, removedCriteria
+}
+
+// Graded returns true if all the review's grading criteria are either passed or failed.
+func (r *Review) Graded() bool {
+	for _, bm := range r.GetGradingBenchmarks() {
+		for _, c := range bm.GetCriteria() {
+			if c.GetGrade() == GradingCriterion_NONE {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMergeBenchmarks(t *testing.T) {
+	existing := []*pb.GradingBenchmark{
+		{
+			ID:       1,
+			ReviewID: 5,
+			Heading:  "Code",
+			Comment:  "Well structured",
+			Criteria: []*pb.GradingCriterion{
+				{ID: 1, BenchmarkID: 1, Description: "Compiles", Points: 5, Grade: pb.GradingCriterion_PASSED, Comment: "ok"},
+				{ID: 2, BenchmarkID: 1, Description: "Has tests", Points: 5, Grade: pb.GradingCriterion_FAILED},
+			},
+		},
+		{
+			ID:       2,
+			ReviewID: 5,
+			Heading:  "Report",
+			Criteria: []*pb.GradingCriterion{
+				{ID: 3, BenchmarkID: 2, Description: "Has figures", Grade: pb.GradingCriterion_PASSED},
+			},
+		},
+	}
+	rubric := []*pb.GradingBenchmark{
+		{
+			Heading: "Code",
+			Criteria: []*pb.GradingCriterion{
+				{Description: "Compiles", Points: 10},
+				{Description: "Is documented", Points: 5},
+			},
+		},
+		{
+			Heading: "Design",
+			Criteria: []*pb.GradingCriterion{
+				{Description: "Uses interfaces", Points: 5},
+			},
+		},
+	}
+	wantMerged := []*pb.GradingBenchmark{
+		{
+			ID:       1,
+			ReviewID: 5,
+			Heading:  "Code",
+			Comment:  "Well structured",
+			Criteria: []*pb.GradingCriterion{
+				{ID: 1, BenchmarkID: 1, Description: "Compiles", Points: 10, Grade: pb.GradingCriterion_PASSED, Comment: "ok"},
+				{BenchmarkID: 1, Description: "Is documented", Points: 5},
+			},
+		},
+		{
+			Heading: "Design",
+			Criteria: []*pb.GradingCriterion{
+				{Description: "Uses interfaces", Points: 5},
+			},
+		},
+	}
+	merged, removed, removedCriteria := pb.MergeBenchmarks(existing, rubric)
+	if diff := cmp.Diff(wantMerged, merged, protocmp.Transform()); diff != "" {
+		t.Errorf("MergeBenchmarks() merged mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*pb.GradingBenchmark{existing[1]}, removed, protocmp.Transform()); diff != "" {
+		t.Errorf("MergeBenchmarks() removed mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*pb.GradingCriterion{existing[0].Criteria[1]}, removedCriteria, protocmp.Transform()); diff != "" {
+		t.Errorf("MergeBenchmarks() removed criteria mismatch (-want +got):\n%s", diff)
+	}
+
+	review := &pb.Review{GradingBenchmarks: merged}
+	if review.Graded() {
+		t.Error("Graded() = true for review with new criteria, want false")
+	}
+	for _, bm := range merged {
+		for _, c := range bm.Criteria {
+			c.Grade = pb.GradingCriterion_FAILED
+		}
+	}
+	if !review.Graded() {
+		t.Error("Graded() = false for review with all criteria graded, want true")
+	}
+}
@@ -0,0 +1,37 @@
+package ag
+
+import "time"
+
+// IsExpired returns true if the session has expired at the given time.
+// Sessions with a malformed expiry date are considered expired.
+func (s *Session) IsExpired(now time.Time) bool {
+	expiry, err := time.ParseInLocation(TimeLayout, s.GetExpiryDate(), now.Location())
+	if err != nil {
+		return true
+	}
+	return !now.Before(expiry)
+}
+
+// RemoveHash removes the hash of the session cookie before transmitting to client.
+func (s *Session) RemoveHash() {
+	if s != nil {
+		s.Hash = ""
+	}
+}
+
+// RemoveHash removes the hashes of all the session cookies before transmitting to client.
+func (s *Sessions) RemoveHash() {
+	for _, session := range s.GetSessions() {
+		session.RemoveHash()
+	}
+}
+
+// IsExpired returns true if the OAuth state has expired at the given time.
+// States with a malformed expiry date are considered expired.
+func (s *OAuthState) IsExpired(now time.Time) bool {
+	expiry, err := time.ParseInLocation(TimeLayout, s.GetExpiryDate(), now.Location())
+	if err != nil {
+		return true
+	}
+	return !now.Before(expiry)
+}
@@ -0,0 +1,83 @@
+package ag
+
+import (
+	"sort"
+	"time"
+)
+
+// GracePeriodDuration returns the course's grace period for submissions after the deadline.
+func (course *Course) GracePeriodDuration() time.Duration {
+	return time.Duration(course.GetGracePeriod()) * time.Minute
+}
+
+// DaysLate returns the number of started days after the deadline, given the time since the deadline.
+// Hours beyond full days count as an extra day, unless they are within the course's grace period.
+func (course *Course) DaysLate(sinceDeadline time.Duration) uint32 {
+	if sinceDeadline <= zero {
+		return 0
+	}
+	lateDays, lateHours := uint32(sinceDeadline/days), sinceDeadline%days
+	// lateHours is hours after deadline, excluding subsequent full days after deadline
+	if lateHours > course.GracePeriodDuration() {
+		lateDays++
+	}
+	return lateDays
+}
+
+// SlipDaysLate returns the number of slip days used for a single assignment,
+// given the time since the deadline. The slip days are counted in the course's
+// slip day unit, and limited by the course's per-assignment cap, if any.
+func (course *Course) SlipDaysLate(sinceDeadline time.Duration) uint32 {
+	var slipDays uint32
+	switch course.GetSlipDayUnit() {
+	case Course_HOURS:
+		if sinceDeadline > course.GracePeriodDuration() {
+			// count started hours after deadline
+			slipDays = uint32((sinceDeadline + time.Hour - 1) / time.Hour)
+		}
+	default:
+		slipDays = course.DaysLate(sinceDeadline)
+	}
+	if limit := course.GetSlipDaysCap(); limit > 0 && slipDays > limit {
+		slipDays = limit
+	}
+	return slipDays
+}
+
+// sharedSlipDays returns the share of the given slip days used by
+// group member number i, when the slip days are divided between members.
+// Any remaining slip days are used by the first members.
+func sharedSlipDays(slipDays uint32, members, i int) uint32 {
+	share := slipDays / uint32(members)
+	if uint32(i) < slipDays%uint32(members) {
+		share++
+	}
+	return share
+}
+
+// UpdateSlipDays updates the number of slip days for each group member
+// for the given assignment/submission. If the course's group slip days are shared,
+// the slip days are divided between the group members; otherwise, each member
+// uses the full number of slip days.
+func (g *Group) UpdateSlipDays(start time.Time, assignment *Assignment, submission *Submission) error {
+	enrollments := make([]*Enrollment, len(g.GetEnrollments()))
+	copy(enrollments, g.GetEnrollments())
+	// sort by user ID to divide shared slip days between members in a predictable order
+	sort.Slice(enrollments, func(i, j int) bool {
+		return enrollments[i].GetUserID() < enrollments[j].GetUserID()
+	})
+	for i, enrol := range enrollments {
+		slipDays, late, err := enrol.slipDaysLate(start, assignment, submission)
+		if err != nil {
+			return err
+		}
+		if !late {
+			continue
+		}
+		if enrol.GetCourse().GetGroupSlipDays() == Course_SHARED {
+			slipDays = sharedSlipDays(slipDays, len(enrollments), i)
+		}
+		enrol.updateSlipDays(assignment.GetID(), slipDays)
+	}
+	return nil
+}
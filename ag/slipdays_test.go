@@ -18,9 +18,10 @@ var (
 	testNow = time.Now()
 
 	course = &pb.Course{
-		ID:       1,
-		SlipDays: 5,
-		Name:     "opsys",
+		ID:          1,
+		SlipDays:    5,
+		Name:        "opsys",
+		GracePeriod: 120,
 	}
 
 	a = func(daysFromNow int32) *pb.Assignment {
@@ -281,8 +282,9 @@ func TestEnrollmentGetUsedSlipDays(t *testing.T) {
 	}
 	wantUsedSlipDays := []*pb.UsedSlipDays{
 		{
-			AssignmentID: 1,
-			UsedSlipDays: 2,
+			AssignmentID:      1,
+			UsedSlipDays:      2,
+			AutomaticSlipDays: 2,
 		},
 	}
 	if diff := cmp.Diff(wantUsedSlipDays, usedSlipDays, cmpopts.IgnoreUnexported(pb.UsedSlipDays{})); diff != "" {
@@ -361,3 +363,177 @@ func TestSlipDaysWGracePeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestSlipDaysCourseRules(t *testing.T) {
+	lab := a(0)
+	lab.ID = 1
+	timeOfDeadline, err := time.Parse(pb.TimeLayout, lab.Deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab.ID}
+	noGrace := &pb.Course{ID: course.ID, SlipDays: 5}
+	longGrace := &pb.Course{ID: course.ID, SlipDays: 5, GracePeriod: 6 * 60}
+	hours := &pb.Course{ID: course.ID, SlipDays: 48, GracePeriod: 30, SlipDayUnit: pb.Course_HOURS}
+	hoursCap := &pb.Course{ID: course.ID, SlipDays: 48, GracePeriod: 30, SlipDayUnit: pb.Course_HOURS, SlipDaysCap: 24}
+	daysCap := &pb.Course{ID: course.ID, SlipDays: 5, GracePeriod: 120, SlipDaysCap: 2}
+
+	slipDayRuleTests := []struct {
+		name         string
+		course       *pb.Course
+		delivered    time.Time
+		wantSlipDays uint32
+	}{
+		{"Days/NoGrace/OnTime", noGrace, timeOfDeadline, 0},
+		{"Days/NoGrace/1Second", noGrace, timeOfDeadline.Add(time.Second), 1},
+		{"Days/NoGrace/1Day", noGrace, timeOfDeadline.Add(days), 1},
+		{"Days/NoGrace/1Day1Minute", noGrace, timeOfDeadline.Add(days + time.Minute), 2},
+		{"Days/LongGrace/5Hours", longGrace, timeOfDeadline.Add(5 * time.Hour), 0},
+		{"Days/LongGrace/7Hours", longGrace, timeOfDeadline.Add(7 * time.Hour), 1},
+		{"Days/LongGrace/1Day6Hours", longGrace, timeOfDeadline.Add(days + 6*time.Hour), 1},
+		{"Days/LongGrace/1Day7Hours", longGrace, timeOfDeadline.Add(days + 7*time.Hour), 2},
+		{"Hours/WithinGrace", hours, timeOfDeadline.Add(30 * time.Minute), 0},
+		{"Hours/31Minutes", hours, timeOfDeadline.Add(31 * time.Minute), 1},
+		{"Hours/1Hour", hours, timeOfDeadline.Add(time.Hour), 1},
+		{"Hours/1Hour1Minute", hours, timeOfDeadline.Add(time.Hour + time.Minute), 2},
+		{"Hours/1Day3Hours", hours, timeOfDeadline.Add(days + 3*time.Hour), 27},
+		{"HoursCap/5Hours", hoursCap, timeOfDeadline.Add(5 * time.Hour), 5},
+		{"HoursCap/2Days", hoursCap, timeOfDeadline.Add(2 * days), 24},
+		{"DaysCap/1Day3Hours", daysCap, timeOfDeadline.Add(days + 3*time.Hour), 2},
+		{"DaysCap/3Days6Hours", daysCap, timeOfDeadline.Add(3*days + 6*time.Hour), 2},
+	}
+
+	for _, test := range slipDayRuleTests {
+		enrol := &pb.Enrollment{
+			Course:       test.course,
+			CourseID:     test.course.ID,
+			UsedSlipDays: make([]*pb.UsedSlipDays, 0),
+		}
+		t.Run(test.name, func(t *testing.T) {
+			err := enrol.UpdateSlipDays(test.delivered, lab, submission)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var usedSlipDays uint32
+			for _, days := range enrol.GetUsedSlipDays() {
+				usedSlipDays += days.UsedSlipDays
+			}
+			if usedSlipDays != test.wantSlipDays {
+				t.Errorf("UpdateSlipDays('%v', '%v', '%v') = %d, want %d", test.delivered, lab, submission, usedSlipDays, test.wantSlipDays)
+			}
+			wantRemaining := int32(test.course.SlipDays - test.wantSlipDays)
+			if remaining := enrol.RemainingSlipDays(test.course); remaining != wantRemaining {
+				t.Errorf("RemainingSlipDays() = %d, want %d", remaining, wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSlipDaysMissingCourse(t *testing.T) {
+	enrol := &pb.Enrollment{
+		CourseID:     course.ID,
+		UsedSlipDays: make([]*pb.UsedSlipDays, 0),
+	}
+	lab1 := a(-2)
+	lab1.ID = 1
+	submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab1.ID}
+	if err := enrol.UpdateSlipDays(testNow, lab1, submission); err == nil {
+		t.Errorf("expected error since enrollment has no course")
+	}
+}
+
+func TestGroupSlipDays(t *testing.T) {
+	lab := a(0)
+	lab.ID = 1
+	lab.IsGroupLab = true
+	timeOfDeadline, err := time.Parse(pb.TimeLayout, lab.Deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab.ID, GroupID: 1}
+	perMember := &pb.Course{ID: course.ID, SlipDays: 5, GracePeriod: 120, GroupSlipDays: pb.Course_PER_MEMBER}
+	shared := &pb.Course{ID: course.ID, SlipDays: 5, GracePeriod: 120, GroupSlipDays: pb.Course_SHARED}
+	sharedHours := &pb.Course{ID: course.ID, SlipDays: 48, SlipDayUnit: pb.Course_HOURS, GroupSlipDays: pb.Course_SHARED}
+
+	groupSlipDayTests := []struct {
+		name         string
+		course       *pb.Course
+		userIDs      []uint64
+		delivered    time.Time
+		wantSlipDays map[uint64]uint32 // user ID -> used slip days
+	}{
+		{"PerMember/OnTime", perMember, []uint64{1, 2}, timeOfDeadline.Add(time.Hour), map[uint64]uint32{1: 0, 2: 0}},
+		{"PerMember/3Days", perMember, []uint64{1, 2}, timeOfDeadline.Add(2*days + 3*time.Hour), map[uint64]uint32{1: 3, 2: 3}},
+		{"PerMember/3Days/ThreeMembers", perMember, []uint64{3, 1, 2}, timeOfDeadline.Add(2*days + 3*time.Hour), map[uint64]uint32{1: 3, 2: 3, 3: 3}},
+		{"Shared/OnTime", shared, []uint64{1, 2}, timeOfDeadline.Add(time.Hour), map[uint64]uint32{1: 0, 2: 0}},
+		{"Shared/2Days", shared, []uint64{1, 2}, timeOfDeadline.Add(days + 3*time.Hour), map[uint64]uint32{1: 1, 2: 1}},
+		{"Shared/3Days", shared, []uint64{2, 1}, timeOfDeadline.Add(2*days + 3*time.Hour), map[uint64]uint32{1: 2, 2: 1}},
+		{"Shared/1Day/ThreeMembers", shared, []uint64{3, 2, 1}, timeOfDeadline.Add(3 * time.Hour), map[uint64]uint32{1: 1, 2: 0, 3: 0}},
+		{"Shared/5Days/ThreeMembers", shared, []uint64{3, 2, 1}, timeOfDeadline.Add(4*days + 3*time.Hour), map[uint64]uint32{1: 2, 2: 2, 3: 1}},
+		{"SharedHours/10Hours", sharedHours, []uint64{1, 2}, timeOfDeadline.Add(10 * time.Hour), map[uint64]uint32{1: 5, 2: 5}},
+		{"SharedHours/11Hours", sharedHours, []uint64{1, 2}, timeOfDeadline.Add(11 * time.Hour), map[uint64]uint32{1: 6, 2: 5}},
+	}
+
+	for _, test := range groupSlipDayTests {
+		group := &pb.Group{ID: 1, CourseID: test.course.ID}
+		for _, userID := range test.userIDs {
+			group.Enrollments = append(group.Enrollments, &pb.Enrollment{
+				ID:           userID,
+				UserID:       userID,
+				Course:       test.course,
+				CourseID:     test.course.ID,
+				GroupID:      group.ID,
+				UsedSlipDays: make([]*pb.UsedSlipDays, 0),
+			})
+		}
+		t.Run(test.name, func(t *testing.T) {
+			err := group.UpdateSlipDays(test.delivered, lab, submission)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, enrol := range group.Enrollments {
+				var usedSlipDays uint32
+				for _, days := range enrol.GetUsedSlipDays() {
+					usedSlipDays += days.UsedSlipDays
+				}
+				if want := test.wantSlipDays[enrol.UserID]; usedSlipDays != want {
+					t.Errorf("UpdateSlipDays('%v', '%v', '%v'): user %d used %d slip days, want %d", test.delivered, lab, submission, enrol.UserID, usedSlipDays, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSlipDaysWithAdjustment(t *testing.T) {
+	lab1 := a(-3)
+	lab1.ID = 1
+	submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab1.ID}
+	adjustmentTests := []struct {
+		name       string
+		adjustment int32
+		want       uint32
+	}{
+		{"NoAdjustment", 0, 3},
+		{"Refund", -2, 1},
+		{"RefundAll", -3, 0},
+		{"RefundMoreThanUsed", -5, 0},
+		{"Penalty", 2, 5},
+	}
+	for _, test := range adjustmentTests {
+		enrol := &pb.Enrollment{
+			Course:   course,
+			CourseID: course.ID,
+			UsedSlipDays: []*pb.UsedSlipDays{
+				{AssignmentID: lab1.ID, UsedSlipDays: 0, Adjustment: test.adjustment},
+			},
+		}
+		t.Run(test.name, func(t *testing.T) {
+			if err := enrol.UpdateSlipDays(testNow, lab1, submission); err != nil {
+				t.Fatal(err)
+			}
+			if got := enrol.GetUsedSlipDays()[0].GetUsedSlipDays(); got != test.want {
+				t.Errorf("UpdateSlipDays() with adjustment %d: used slip days = %d, want %d", test.adjustment, got, test.want)
+			}
+		})
+	}
+}
@@ -39,6 +39,13 @@ func (g *Groups) RemoveRemoteID() {
 	}
 }
 
+// RemoveRemoteID nullifies remote identities of all users in every formed group
+func (g *GroupFormation) RemoveRemoteID() {
+	for _, formed := range g.GetGroups() {
+		formed.GetGroup().RemoveRemoteID()
+	}
+}
+
 // RemoveRemoteID removes remote identity of the enrolled user
 func (e *Enrollment) RemoveRemoteID() {
 	if e != nil && e.User != nil {
@@ -2,6 +2,7 @@ package ag
 
 import (
 	"context"
+	"net/url"
 	"reflect"
 	"strings"
 	"time"
@@ -83,7 +84,19 @@ func (c *Course) IsValid() bool {
 		(c.GetProvider() == "github" || c.GetProvider() == "gitlab" || c.GetProvider() == "fake") &&
 		c.GetOrganizationID() != 0 &&
 		c.GetYear() != 0 &&
-		c.GetTag() != ""
+		c.GetTag() != "" &&
+		(c.GetMaxGroupSize() == 0 || c.GetMinGroupSize() <= c.GetMaxGroupSize()) &&
+		isValidTime(c.GetGroupDeadline()) &&
+		isValidTime(c.GetGroupFreezeDate())
+}
+
+// isValidTime returns true if the given time is empty or formatted with TimeLayout.
+func isValidTime(t string) bool {
+	if t == "" {
+		return true
+	}
+	_, err := time.Parse(TimeLayout, t)
+	return err == nil
 }
 
 // IsValid checks required fields of a user request
@@ -98,7 +111,7 @@ func (u *UserRequest) IsValid() bool {
 
 // IsValid checks required fields of an enrollment request.
 func (req *Enrollment) IsValid() bool {
-	return req.GetStatus() <= Enrollment_TEACHER &&
+	return req.GetStatus() <= Enrollment_TA &&
 		req.GetUserID() > 0 && req.GetCourseID() > 0
 }
 
@@ -154,11 +167,33 @@ func (req *UpdateSubmissionRequest) IsValid() bool {
 	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
 }
 
+// IsValid ensures that course, user and assignment IDs are set,
+// and that the request has a non-zero change and a reason.
+func (req *SlipDaysRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetUserID() > 0 && req.GetAssignmentID() > 0 &&
+		req.GetChange() != 0 && strings.TrimSpace(req.GetReason()) != ""
+}
+
+// IsValid ensures that both course and user IDs are set
+func (req *SlipDayLedgerRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetUserID() > 0
+}
+
+// IsValid ensures that course and review IDs are set
+func (req *PeerReviewModerationRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetReviewID() > 0
+}
+
 // IsValid ensures that group ID is provided
 func (req *GetGroupRequest) IsValid() bool {
 	return req.GetGroupID() > 0
 }
 
+// IsValid ensures that the course ID is set, and that either the group size or the CSV groupings are given.
+func (req *GroupFormationRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && (req.GetGroupSize() > 0 || strings.TrimSpace(req.GetCsv()) != "")
+}
+
 // IsValid ensures that course ID and group or user IDs are set
 func (req *GroupRequest) IsValid() bool {
 	uid, gid := req.GetUserID(), req.GetGroupID()
@@ -194,6 +229,14 @@ func (req *RebuildRequest) IsValid() bool {
 	return aid > 0 && sid > 0
 }
 
+// IsValid ensures that both course and assignment IDs are set,
+// and that the commit hash is an abbreviated or full hexadecimal commit hash.
+func (req *SubmitRequest) IsValid() bool {
+	hash := req.GetCommitHash()
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 &&
+		len(hash) >= 7 && len(hash) <= 40 && strings.Trim(hash, "0123456789abcdefABCDEF") == ""
+}
+
 // IsValid checks that either ID or path field is set
 func (org *Organization) IsValid() bool {
 	id, path := org.GetID(), org.GetPath()
@@ -242,3 +285,112 @@ func (c *GradingCriterion) IsValid() bool {
 func (r *CourseUserRequest) IsValid() bool {
 	return r.CourseCode != "" && r.UserLogin != "" && r.CourseYear > 2019
 }
+
+// IsValid ensures that both course and assignment IDs are set.
+func (req *ReviewDistributionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that the code comment has a review, a file path, a valid line range and a comment.
+func (c *CodeComment) IsValid() bool {
+	return c.GetReviewID() > 0 && c.GetPath() != "" && c.GetComment() != "" &&
+		c.GetStartLine() > 0 && (c.GetEndLine() == 0 || c.GetEndLine() >= c.GetStartLine())
+}
+
+// IsValid ensures that course ID is provided and the code comment is valid.
+func (req *CodeCommentRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetComment().IsValid()
+}
+
+// IsValid ensures that both course and review IDs are set.
+func (req *CodeCommentsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetReviewID() > 0
+}
+
+// IsValid ensures that the rubric template belongs to a course, has a name,
+// and only has benchmarks with a heading.
+func (t *RubricTemplate) IsValid() bool {
+	if t.GetCourseID() == 0 || t.GetName() == "" || len(t.GetBenchmarks()) == 0 {
+		return false
+	}
+	for _, bm := range t.GetBenchmarks() {
+		if bm.GetHeading() == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValid ensures that course, assignment and template IDs are set.
+func (req *RubricTemplateRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 && req.GetTemplateID() > 0
+}
+
+// IsValid ensures that course ID is provided and the grade override identifies a criterion of a submission.
+func (req *GradeOverrideRequest) IsValid() bool {
+	return req.GetCourseID() > 0 &&
+		req.GetOverride().GetSubmissionID() > 0 &&
+		req.GetOverride().GetHeading() != "" &&
+		req.GetOverride().GetDescription() != ""
+}
+
+// IsValid ensures that course and assignment IDs are set, and that the schedule
+// has either a valid release time or a number of days after the deadline.
+func (r *ReleaseSchedule) IsValid() bool {
+	if r.GetCourseID() == 0 || r.GetAssignmentID() == 0 {
+		return false
+	}
+	if r.GetDaysAfterDeadline() > 0 {
+		return true
+	}
+	_, err := time.Parse(TimeLayout, r.GetReleaseTime())
+	return err == nil
+}
+
+// IsValid ensures that the chat integration belongs to a course, and
+// that the webhook URL, if any, is an absolute HTTP(S) URL on a public host.
+func (c *ChatIntegration) IsValid() bool {
+	if c.GetCourseID() == 0 {
+		return false
+	}
+	if c.GetWebhookURL() == "" {
+		return true
+	}
+	u, err := url.Parse(c.GetWebhookURL())
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Hostname() != "" && isPublicWebhookHost(u.Hostname())
+}
+
+// IsValid ensures that the webhook belongs to a course, that the URL is an absolute
+// HTTP(S) URL on a public host, and that new webhooks have a secret to sign their payloads with.
+func (w *Webhook) IsValid() bool {
+	if w.GetCourseID() == 0 || (w.GetID() == 0 && w.GetSecret() == "") {
+		return false
+	}
+	u, err := url.Parse(w.GetURL())
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Hostname() != "" && isPublicWebhookHost(u.Hostname())
+}
+
+// IsValid ensures that both course and webhook IDs are set.
+func (req *WebhookRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetWebhookID() > 0
+}
+
+// IsValid ensures that the chat account is given.
+func (c *ChatIdentity) IsValid() bool {
+	return c.GetChatUserID() != ""
+}
+
+// IsValid ensures that the token has a name and expires within MaxAPITokenDays days.
+func (req *APITokenRequest) IsValid() bool {
+	return strings.TrimSpace(req.GetName()) != "" && req.GetDays() > 0 && req.GetDays() <= MaxAPITokenDays
+}
+
+// IsValid ensures that the token ID is set.
+func (t *APIToken) IsValid() bool {
+	return t.GetID() > 0
+}
+
+// IsValid ensures that the user ID is set.
+func (r *SessionRequest) IsValid() bool {
+	return r.GetUserID() > 0
+}
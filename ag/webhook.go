@@ -0,0 +1,99 @@
+package ag
+
+import (
+	"net"
+	"strings"
+	"sync"
+)
+
+// nonPublicNetworks holds the address ranges that webhooks may not be delivered to:
+// loopback, private, link-local (including the cloud metadata services at 169.254.169.254
+// and fd00:ec2::254), shared and unspecified addresses, and multicast.
+var nonPublicNetworks = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"224.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+// nonPublicHostNames holds host names that always refer to the local machine or a cloud metadata service.
+var nonPublicHostNames = []string{"localhost", "metadata.google.internal"}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
+
+// IsPublicIP returns true if the IP address is not a loopback, private, link-local,
+// metadata service or other non-public address.
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// webhookHosts holds the non-public hosts that webhooks may be delivered to.
+var webhookHosts = struct {
+	sync.RWMutex
+	hosts map[string]bool
+}{hosts: make(map[string]bool)}
+
+// AllowWebhookHosts adds the given hosts to the non-public hosts that webhooks may be delivered to,
+// e.g., services on the QuickFeed server's private network.
+func AllowWebhookHosts(hosts ...string) {
+	webhookHosts.Lock()
+	defer webhookHosts.Unlock()
+	for _, host := range hosts {
+		if host = strings.TrimSpace(host); host != "" {
+			webhookHosts.hosts[strings.ToLower(host)] = true
+		}
+	}
+}
+
+// IsAllowedWebhookHost returns true if the host has been allowed with AllowWebhookHosts.
+func IsAllowedWebhookHost(host string) bool {
+	webhookHosts.RLock()
+	defer webhookHosts.RUnlock()
+	return webhookHosts.hosts[strings.ToLower(host)]
+}
+
+// isPublicWebhookHost returns true if the host is allowed, or is neither a non-public IP address
+// nor a name of the local machine or a metadata service. Host names that resolve to
+// non-public addresses are rejected when the webhook is delivered.
+func isPublicWebhookHost(host string) bool {
+	if IsAllowedWebhookHost(host) {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicIP(ip)
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, name := range nonPublicHostNames {
+		if host == name || strings.HasSuffix(host, "."+name) {
+			return false
+		}
+	}
+	return true
+}
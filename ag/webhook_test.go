@@ -0,0 +1,75 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestWebhookIsValid(t *testing.T) {
+	webhookTests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://lms.example.com/quickfeed", true},
+		{"http://203.0.113.7:8080/hook", true},
+		{"https://[2001:db8::1]/hook", true},
+		{"ftp://lms.example.com/quickfeed", false},
+		{"/quickfeed", false},
+		{"http://localhost:8080/hook", false},
+		{"http://api.localhost/hook", false},
+		{"http://LOCALHOST./hook", false},
+		{"http://127.0.0.1/hook", false},
+		{"http://127.1.2.3/hook", false},
+		{"http://0.0.0.0/hook", false},
+		{"http://[::1]/hook", false},
+		{"http://[::ffff:127.0.0.1]/hook", false},
+		{"http://10.0.0.5/hook", false},
+		{"http://172.16.3.4/hook", false},
+		{"http://192.168.1.1/hook", false},
+		{"http://[fd12:3456::1]/hook", false},
+		{"http://169.254.169.254/latest/meta-data/", false},
+		{"http://[fe80::1]/hook", false},
+		{"http://[fd00:ec2::254]/latest/meta-data/", false},
+		{"http://metadata.google.internal/computeMetadata/v1/", false},
+		{"http://100.100.100.200/latest/meta-data/", false},
+	}
+	for _, test := range webhookTests {
+		webhook := &pb.Webhook{CourseID: 1, URL: test.url, Secret: "hush"}
+		if got := webhook.IsValid(); got != test.want {
+			t.Errorf("Webhook{URL: %q}.IsValid() = %t, want %t", test.url, got, test.want)
+		}
+	}
+
+	// allowed hosts on the private network
+	pb.AllowWebhookHosts("lms.internal", "10.0.0.5")
+	for _, url := range []string{"http://lms.internal/hook", "http://10.0.0.5/hook"} {
+		if webhook := (&pb.Webhook{CourseID: 1, URL: url, Secret: "hush"}); !webhook.IsValid() {
+			t.Errorf("Webhook{URL: %q}.IsValid() = false for allowed host, want true", url)
+		}
+	}
+	if webhook := (&pb.Webhook{CourseID: 1, URL: "http://10.0.0.6/hook", Secret: "hush"}); webhook.IsValid() {
+		t.Errorf("Webhook{URL: %q}.IsValid() = true, want false", webhook.GetURL())
+	}
+}
+
+func TestChatIntegrationIsValid(t *testing.T) {
+	chatTests := []struct {
+		url  string
+		want bool
+	}{
+		{"", true},
+		{"https://hooks.slack.com/services/T0/B0/x", true},
+		{"ftp://hooks.slack.com/services/T0/B0/x", false},
+		{"http://localhost:8065/hooks/x", false},
+		{"http://127.0.0.1/hooks/x", false},
+		{"http://192.168.1.1/hooks/x", false},
+		{"http://169.254.169.254/latest/meta-data/", false},
+	}
+	for _, test := range chatTests {
+		integration := &pb.ChatIntegration{CourseID: 1, WebhookURL: test.url}
+		if got := integration.IsValid(); got != test.want {
+			t.Errorf("ChatIntegration{WebhookURL: %q}.IsValid() = %t, want %t", test.url, got, test.want)
+		}
+	}
+}
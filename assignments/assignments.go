@@ -9,12 +9,14 @@ import (
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/scm"
 	"go.uber.org/zap"
 )
 
-// UpdateFromTestsRepo updates the database record for the course assignments.
-func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, repo *pb.Repository, course *pb.Course) {
+// UpdateFromTestsRepo updates the database record for the course assignments,
+// and notifies the course's webhooks about the updated assignments.
+func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, notifier *notify.Notifier, repo *pb.Repository, course *pb.Course) {
 	logger.Debugf("Updating %s from '%s' repository", course.GetCode(), pb.TestsRepo)
 	s, err := scm.NewSCMClient(logger, course.GetProvider(), course.GetAccessToken())
 	if err != nil {
@@ -38,6 +40,7 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, repo *
 		return
 	}
 
+	notifier.Dispatch(notify.AssignmentUpdated, course.GetID(), &pb.Assignments{Assignments: assignments})
 	logger.Debugf("Assignments for %s successfully updated from '%s' repo", course.GetCode(), pb.TestsRepo)
 }
 
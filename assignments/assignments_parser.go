@@ -33,6 +33,12 @@ type assignmentData struct {
 	Reviewers        uint   `yaml:"reviewers"`
 	ContainerTimeout uint   `yaml:"containertimeout"`
 	SkipTests        bool   `yaml:"skiptests"`
+	LatePolicy       string `yaml:"latepolicy"`
+	LatePenalty      uint   `yaml:"latepenalty"`
+	LateDays         uint   `yaml:"latedays"`
+	PeerReviewers    uint   `yaml:"peerreviewers"`
+	BlindReview      bool   `yaml:"blindreview"`
+	GroupSet         string `yaml:"groupset"`
 }
 
 // ParseAssignments recursively walks the given directory and parses
@@ -64,6 +70,13 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 				if newAssignment.ScriptFile == "" && !newAssignment.SkipTests {
 					return fmt.Errorf("error unmarshalling assignment: missing field 'scriptfile'")
 				}
+				if newAssignment.GroupSet != "" && !newAssignment.IsGroupLab {
+					return fmt.Errorf("error unmarshalling assignment: field 'groupset' requires 'isgrouplab'")
+				}
+				latePolicy, err := parseLatePolicy(newAssignment.LatePolicy)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling assignment: %w", err)
+				}
 
 				// AssignmentID field from the parsed yaml is used to set Order, not assignment ID,
 				// or it will cause a database constraint violation (IDs must be unique)
@@ -79,6 +92,12 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 					IsGroupLab:       newAssignment.IsGroupLab,
 					Reviewers:        uint32(newAssignment.Reviewers),
 					ContainerTimeout: uint32(newAssignment.ContainerTimeout),
+					LatePolicy:       latePolicy,
+					LatePenalty:      uint32(newAssignment.LatePenalty),
+					LateDays:         uint32(newAssignment.LateDays),
+					PeerReviewers:    uint32(newAssignment.PeerReviewers),
+					BlindReview:      newAssignment.BlindReview,
+					GroupSet:         strings.TrimSpace(newAssignment.GroupSet),
 				}
 
 				assignments = append(assignments, assignment)
@@ -92,6 +111,19 @@ func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, error) {
 	return assignments, nil
 }
 
+// parseLatePolicy returns the late policy with the given name.
+// An empty name gives the default policy, where late submissions only consume slip days.
+func parseLatePolicy(name string) (pb.Assignment_LatePolicy, error) {
+	if name == "" {
+		return pb.Assignment_NONE, nil
+	}
+	policy, ok := pb.Assignment_LatePolicy_value[strings.ToUpper(strings.ReplaceAll(name, "-", "_"))]
+	if !ok {
+		return pb.Assignment_NONE, fmt.Errorf("unknown late policy %q", name)
+	}
+	return pb.Assignment_LatePolicy(policy), nil
+}
+
 func FixDeadline(in string) string {
 	wantLayout := pb.TimeLayout
 	acceptedLayouts := []string{
@@ -43,6 +43,37 @@ deadline: "27-08-2017 12:00"
 grading: "Pass/Fail"
 expected_effort: "10 hours"
 autoapprove: false
+`
+
+	yLatePolicy = `assignmentid: 1
+name: "For loops"
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+latepolicy: "penalty"
+latepenalty: 10
+latedays: 3
+`
+
+	yUnknownLatePolicy = `assignmentid: 1
+name: "For loops"
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+latepolicy: "sometimes"
+`
+
+	yGroupSet = `assignmentid: 3
+name: "Project"
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+isgrouplab: true
+groupset: "project2"
+`
+
+	yGroupSetWithoutGroupLab = `assignmentid: 3
+name: "Project"
+scriptfile: "go.sh"
+deadline: "27-08-2017 12:00"
+groupset: "project2"
 `
 )
 
@@ -155,6 +186,97 @@ func TestParseUnknownFields(t *testing.T) {
 	}
 }
 
+func TestParseLatePolicy(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+
+	if err = os.Mkdir(filepath.Join(testsDir, "lab1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(yLatePolicy), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantAssignment1 := &pb.Assignment{
+		Name:        "lab1",
+		ScriptFile:  "go.sh",
+		Deadline:    "2017-08-27T12:00:00",
+		Order:       1,
+		ScoreLimit:  80,
+		LatePolicy:  pb.Assignment_PENALTY,
+		LatePenalty: 10,
+		LateDays:    3,
+	}
+
+	assignments, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 1)
+	}
+	if diff := cmp.Diff(assignments[0], wantAssignment1, cmpopts.IgnoreUnexported(pb.Assignment{})); diff != "" {
+		t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(testsDir, "lab1", "assignment.yml"), []byte(yUnknownLatePolicy), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded with unknown late policy, want error")
+	}
+}
+
+func TestParseGroupSet(t *testing.T) {
+	testsDir, err := ioutil.TempDir("", pb.TestsRepo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsDir)
+
+	if err = os.Mkdir(filepath.Join(testsDir, "project"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(testsDir, "project", "assignment.yml"), []byte(yGroupSet), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantAssignment := &pb.Assignment{
+		Name:       "project",
+		ScriptFile: "go.sh",
+		Deadline:   "2017-08-27T12:00:00",
+		Order:      3,
+		ScoreLimit: 80,
+		IsGroupLab: true,
+		GroupSet:   "project2",
+	}
+
+	assignments, err := parseAssignments(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 1)
+	}
+	if diff := cmp.Diff(assignments[0], wantAssignment, cmpopts.IgnoreUnexported(pb.Assignment{})); diff != "" {
+		t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(testsDir, "project", "assignment.yml"), []byte(yGroupSetWithoutGroupLab), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = parseAssignments(testsDir, 0); err == nil {
+		t.Error("parseAssignments() succeeded with group set for individual assignment, want error")
+	}
+}
+
 func TestFixDeadline(t *testing.T) {
 	deadlineTests := []struct {
 		in, want string
@@ -0,0 +1,105 @@
+// Package chat posts course events to chat channels on Discord, Slack and Mattermost
+// through incoming webhooks, and verifies and answers slash commands from these platforms.
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Event is a kind of course event that can be posted to a chat channel.
+type Event int
+
+const (
+	// BuildFailure is posted when a submission's score is below the assignment's score limit.
+	BuildFailure Event = iota
+	// DeadlineReminder is posted before an assignment's deadline.
+	DeadlineReminder
+	// Approval is posted when a submission is approved.
+	Approval
+	// EnrollmentRequest is posted when a user requests to enroll in the course.
+	EnrollmentRequest
+)
+
+func (e Event) String() string {
+	switch e {
+	case BuildFailure:
+		return "build failure"
+	case DeadlineReminder:
+		return "deadline reminder"
+	case Approval:
+		return "approval"
+	case EnrollmentRequest:
+		return "enrollment request"
+	}
+	return fmt.Sprintf("Event(%d)", int(e))
+}
+
+// EnabledFor returns true if the given integration posts events of this kind.
+func (e Event) EnabledFor(integration *pb.ChatIntegration) bool {
+	if integration.GetWebhookURL() == "" {
+		return false
+	}
+	switch e {
+	case BuildFailure:
+		return integration.GetBuildFailures()
+	case DeadlineReminder:
+		return integration.GetDeadlineReminders()
+	case Approval:
+		return integration.GetApprovals()
+	case EnrollmentRequest:
+		return integration.GetEnrollmentRequests()
+	}
+	return false
+}
+
+// Client posts messages to chat channel webhooks.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient returns a client that posts messages with the given HTTP client.
+// If httpClient is nil, a client with a 10 second timeout is used.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{http: httpClient}
+}
+
+// Post posts the message to the integration's channel webhook.
+func (c *Client) Post(ctx context.Context, integration *pb.ChatIntegration, message string) error {
+	payload, err := json.Marshal(webhookPayload(integration.GetPlatform(), message))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, integration.GetWebhookURL(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s webhook returned %s", integration.GetPlatform(), resp.Status)
+	}
+	return nil
+}
+
+// webhookPayload returns the webhook message payload of the given platform.
+func webhookPayload(platform pb.ChatIntegration_Platform, message string) interface{} {
+	if platform == pb.ChatIntegration_DISCORD {
+		return map[string]string{"content": message}
+	}
+	// Slack and Mattermost use the same incoming webhook format
+	return map[string]string{"text": message}
+}
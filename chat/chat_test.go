@@ -0,0 +1,73 @@
+package chat_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
+)
+
+func TestPost(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		received = nil
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	postTests := []struct {
+		platform pb.ChatIntegration_Platform
+		field    string
+	}{
+		{pb.ChatIntegration_DISCORD, "content"},
+		{pb.ChatIntegration_SLACK, "text"},
+		{pb.ChatIntegration_MATTERMOST, "text"},
+	}
+	client := chat.NewClient(nil)
+	for _, test := range postTests {
+		integration := &pb.ChatIntegration{Platform: test.platform, WebhookURL: server.URL + "/hook"}
+		if err := client.Post(context.Background(), integration, "lab1 is due tomorrow"); err != nil {
+			t.Fatal(err)
+		}
+		if got := received[test.field]; got != "lab1 is due tomorrow" {
+			t.Errorf("%s webhook received %q = %q, want %q", test.platform, test.field, got, "lab1 is due tomorrow")
+		}
+	}
+
+	broken := &pb.ChatIntegration{Platform: pb.ChatIntegration_SLACK, WebhookURL: server.URL + "/broken"}
+	if err := client.Post(context.Background(), broken, "message"); err == nil {
+		t.Error("Post() to failing webhook: expected error")
+	}
+}
+
+func TestEventEnabledFor(t *testing.T) {
+	integration := &pb.ChatIntegration{WebhookURL: "https://example.com/hook", BuildFailures: true, EnrollmentRequests: true}
+	want := map[chat.Event]bool{
+		chat.BuildFailure:      true,
+		chat.DeadlineReminder:  false,
+		chat.Approval:          false,
+		chat.EnrollmentRequest: true,
+	}
+	for event, enabled := range want {
+		if got := event.EnabledFor(integration); got != enabled {
+			t.Errorf("%s.EnabledFor() = %t, want %t", event, got, enabled)
+		}
+	}
+	if chat.BuildFailure.EnabledFor(&pb.ChatIntegration{BuildFailures: true}) {
+		t.Error("EnabledFor() without webhook URL = true, want false")
+	}
+}
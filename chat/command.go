@@ -0,0 +1,158 @@
+package chat
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// maxCommandAge is the maximum age of a signed slash command request; older requests are rejected to prevent replays.
+const maxCommandAge = 5 * time.Minute
+
+// ErrInvalidSignature is returned for slash command requests that are not signed by the chat platform.
+var ErrInvalidSignature = errors.New("invalid slash command signature")
+
+// Command is a slash command invoked by a chat user.
+type Command struct {
+	// Ping is set for Discord's endpoint verification requests, which must be acknowledged.
+	Ping bool
+	// ChatUserID is the chat platform's ID of the user that invoked the command.
+	ChatUserID string
+	// Text is the text following the command name, e.g., "status".
+	Text string
+}
+
+// discordInteraction holds the fields of a Discord interaction used by QuickFeed.
+type discordInteraction struct {
+	Type int `json:"type"`
+	Data struct {
+		Options []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"options"`
+	} `json:"data"`
+	Member struct {
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	} `json:"member"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+}
+
+// ParseCommand verifies that the slash command request is signed for the given integration,
+// and returns the command. The request header and body must be as received from the platform.
+func ParseCommand(integration *pb.ChatIntegration, header http.Header, body []byte, now time.Time) (*Command, error) {
+	secret := integration.GetSigningSecret()
+	if secret == "" {
+		return nil, ErrInvalidSignature
+	}
+	switch integration.GetPlatform() {
+	case pb.ChatIntegration_SLACK:
+		if err := verifySlack(secret, header, body, now); err != nil {
+			return nil, err
+		}
+		return parseForm(body, "")
+
+	case pb.ChatIntegration_MATTERMOST:
+		return parseForm(body, secret)
+
+	case pb.ChatIntegration_DISCORD:
+		if err := verifyDiscord(secret, header, body); err != nil {
+			return nil, err
+		}
+		var interaction discordInteraction
+		if err := json.Unmarshal(body, &interaction); err != nil {
+			return nil, err
+		}
+		if interaction.Type == 1 {
+			return &Command{Ping: true}, nil
+		}
+		cmd := &Command{ChatUserID: interaction.Member.User.ID}
+		if cmd.ChatUserID == "" {
+			cmd.ChatUserID = interaction.User.ID
+		}
+		for _, option := range interaction.Data.Options {
+			cmd.Text = strings.TrimSpace(cmd.Text + " " + option.Value)
+		}
+		return cmd, nil
+	}
+	return nil, fmt.Errorf("unknown chat platform %s", integration.GetPlatform())
+}
+
+// verifySlack verifies Slack's HMAC-SHA256 request signature.
+func verifySlack(secret string, header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > maxCommandAge || age < -maxCommandAge {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:%s", timestamp, body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature"))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// verifyDiscord verifies Discord's Ed25519 request signature using the application's public key.
+func verifyDiscord(publicKey string, header http.Header, body []byte) error {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid Discord application public key")
+	}
+	signature, err := hex.DecodeString(header.Get("X-Signature-Ed25519"))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	message := append([]byte(header.Get("X-Signature-Timestamp")), body...)
+	if !ed25519.Verify(ed25519.PublicKey(key), message, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// parseForm parses a Slack or Mattermost slash command form. If token is set,
+// the form's token must match; Mattermost authenticates commands by token.
+func parseForm(body []byte, token string) (*Command, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	if token != "" && subtle.ConstantTimeCompare([]byte(form.Get("token")), []byte(token)) != 1 {
+		return nil, ErrInvalidSignature
+	}
+	return &Command{ChatUserID: form.Get("user_id"), Text: strings.TrimSpace(form.Get("text"))}, nil
+}
+
+// Response returns the response body to a slash command for the given platform.
+// The response is only shown to the user that invoked the command.
+func Response(platform pb.ChatIntegration_Platform, cmd *Command, text string) ([]byte, error) {
+	if platform == pb.ChatIntegration_DISCORD {
+		if cmd.Ping {
+			return json.Marshal(map[string]int{"type": 1})
+		}
+		return json.Marshal(map[string]interface{}{
+			"type": 4,
+			"data": map[string]interface{}{"content": text, "flags": 64},
+		})
+	}
+	return json.Marshal(map[string]string{"response_type": "ephemeral", "text": text})
+}
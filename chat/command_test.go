@@ -0,0 +1,133 @@
+package chat_test
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
+)
+
+func slackHeader(secret string, timestamp time.Time, body []byte) http.Header {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:%s", ts, body)
+	header := http.Header{}
+	header.Set("X-Slack-Request-Timestamp", ts)
+	header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return header
+}
+
+func TestParseSlackCommand(t *testing.T) {
+	integration := &pb.ChatIntegration{Platform: pb.ChatIntegration_SLACK, SigningSecret: "slack-secret"}
+	body := []byte(url.Values{"user_id": {"U123"}, "text": {" status "}}.Encode())
+	now := time.Now()
+
+	cmd, err := chat.ParseCommand(integration, slackHeader("slack-secret", now, body), body, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.ChatUserID != "U123" || cmd.Text != "status" {
+		t.Errorf("ParseCommand() = %+v, want user U123 and text status", cmd)
+	}
+
+	invalidTests := []struct {
+		name   string
+		header http.Header
+		body   []byte
+	}{
+		{"WrongSecret", slackHeader("other-secret", now, body), body},
+		{"TamperedBody", slackHeader("slack-secret", now, body), []byte("user_id=U999&text=status")},
+		{"Replayed", slackHeader("slack-secret", now.Add(-10*time.Minute), body), body},
+		{"Unsigned", http.Header{}, body},
+	}
+	for _, test := range invalidTests {
+		if _, err := chat.ParseCommand(integration, test.header, test.body, now); err != chat.ErrInvalidSignature {
+			t.Errorf("ParseCommand(%s): got error %v, want %v", test.name, err, chat.ErrInvalidSignature)
+		}
+	}
+}
+
+func TestParseMattermostCommand(t *testing.T) {
+	integration := &pb.ChatIntegration{Platform: pb.ChatIntegration_MATTERMOST, SigningSecret: "mm-token"}
+	body := []byte(url.Values{"token": {"mm-token"}, "user_id": {"mm-user"}, "text": {"status"}}.Encode())
+	cmd, err := chat.ParseCommand(integration, http.Header{}, body, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.ChatUserID != "mm-user" {
+		t.Errorf("ChatUserID = %q, want %q", cmd.ChatUserID, "mm-user")
+	}
+	forged := []byte(url.Values{"token": {"guess"}, "user_id": {"mm-user"}}.Encode())
+	if _, err := chat.ParseCommand(integration, http.Header{}, forged, time.Now()); err != chat.ErrInvalidSignature {
+		t.Errorf("ParseCommand() with wrong token: got error %v, want %v", err, chat.ErrInvalidSignature)
+	}
+}
+
+func TestParseDiscordCommand(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	integration := &pb.ChatIntegration{Platform: pb.ChatIntegration_DISCORD, SigningSecret: hex.EncodeToString(publicKey)}
+	sign := func(body []byte) http.Header {
+		header := http.Header{}
+		header.Set("X-Signature-Timestamp", "1625000000")
+		header.Set("X-Signature-Ed25519", hex.EncodeToString(ed25519.Sign(privateKey, append([]byte("1625000000"), body...))))
+		return header
+	}
+
+	ping := []byte(`{"type":1}`)
+	cmd, err := chat.ParseCommand(integration, sign(ping), ping, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmd.Ping {
+		t.Error("ParseCommand() of ping: Ping = false, want true")
+	}
+	response, err := chat.Response(pb.ChatIntegration_DISCORD, cmd, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(response) != `{"type":1}` {
+		t.Errorf("Response() to ping = %s, want %s", response, `{"type":1}`)
+	}
+
+	command := []byte(`{"type":2,"data":{"name":"quickfeed","options":[{"name":"query","value":"status"}]},"member":{"user":{"id":"42"}}}`)
+	cmd, err = chat.ParseCommand(integration, sign(command), command, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.ChatUserID != "42" || cmd.Text != "status" {
+		t.Errorf("ParseCommand() = %+v, want user 42 and text status", cmd)
+	}
+	response, err = chat.Response(pb.ChatIntegration_DISCORD, cmd, "lab1: 80% APPROVED")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reply struct {
+		Type int `json:"type"`
+		Data struct {
+			Content string `json:"content"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(response, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Type != 4 || reply.Data.Content != "lab1: 80% APPROVED" {
+		t.Errorf("Response() = %s, want a channel message with the content", response)
+	}
+
+	if _, err := chat.ParseCommand(integration, sign(ping), command, time.Now()); err != chat.ErrInvalidSignature {
+		t.Errorf("ParseCommand() with signature of other body: got error %v, want %v", err, chat.ErrInvalidSignature)
+	}
+}
@@ -0,0 +1,95 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+// statusTimeout is the maximum time spent reporting the results of a commit or pull request to the repository.
+const statusTimeout = 30 * time.Second
+
+// reportStatus reports the state of testing the commit back to the repository,
+// unless no SCM client is provided. Failures are logged.
+func reportStatus(logger *zap.SugaredLogger, rData *RunData, state scm.CommitState, title, details string) {
+	if rData.SCM == nil || rData.CommitID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), statusTimeout)
+	defer cancel()
+	opt := &scm.CommitStatusOptions{
+		Owner:      rData.Course.GetOrganizationPath(),
+		Repository: path.Base(rData.Repo.GetHTMLURL()),
+		CommitHash: rData.CommitID,
+		Name:       "quickfeed/" + rData.Assignment.GetName(),
+		State:      state,
+		Title:      title,
+		Details:    details,
+		TargetURL:  rData.submissionURL(),
+	}
+	if err := rData.SCM.CreateCommitStatus(ctx, opt); err != nil {
+		logger.Errorf("Failed to report %s status for commit %s: %v", state, rData.CommitID, err)
+	}
+}
+
+// commentResults comments the results on the tested pull request, if any. Failures are logged.
+func commentResults(logger *zap.SugaredLogger, rData *RunData, title, details string) {
+	if rData.SCM == nil || rData.PullRequest == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), statusTimeout)
+	defer cancel()
+	body := fmt.Sprintf("**quickfeed/%s**: %s\n\n%s", rData.Assignment.GetName(), title, details)
+	if url := rData.submissionURL(); url != "" {
+		body += fmt.Sprintf("\n[View submission in QuickFeed](%s)\n", url)
+	}
+	opt := &scm.PullRequestCommentOptions{
+		Owner:      rData.Course.GetOrganizationPath(),
+		Repository: path.Base(rData.Repo.GetHTMLURL()),
+		Number:     rData.PullRequest,
+		Body:       body,
+	}
+	if err := rData.SCM.CreatePullRequestComment(ctx, opt); err != nil {
+		logger.Errorf("Failed to comment results on pull request %d: %v", rData.PullRequest, err)
+	}
+}
+
+// submissionURL returns the link to the submission in QuickFeed, or the empty string if the base URL is unknown.
+func (r RunData) submissionURL() string {
+	if r.BaseURL == "" {
+		return ""
+	}
+	lab := "lab"
+	if r.Assignment.GetIsGroupLab() {
+		lab = "grouplab"
+	}
+	return fmt.Sprintf("https://%s/app/student/courses/%d/%s/%d", r.BaseURL, r.Course.GetID(), lab, r.Assignment.GetID())
+}
+
+// resultStatus returns the state, title and details of the commit status for the given results.
+// The tests pass if the submission's score reaches the assignment's score limit.
+func resultStatus(rData *RunData, result *score.Results, submissionScore uint32) (scm.CommitState, string, string) {
+	var passed int
+	var details strings.Builder
+	details.WriteString("| Test | Score | Result |\n|------|-------|--------|\n")
+	for _, s := range result.Scores {
+		outcome := "fail"
+		if s.GetScore() >= s.GetMaxScore() {
+			outcome = "pass"
+			passed++
+		}
+		fmt.Fprintf(&details, "| %s | %d/%d | %s |\n", s.GetTestName(), s.GetScore(), s.GetMaxScore(), outcome)
+	}
+	title := fmt.Sprintf("Score %d%%: %d of %d tests passed", submissionScore, passed, len(result.Scores))
+	state := scm.CommitSuccess
+	if submissionScore < rData.Assignment.GetScoreLimit() {
+		state = scm.CommitFailure
+	}
+	return state, title, details.String()
+}
@@ -18,10 +18,11 @@ type AssignmentInfo struct {
 	CreatorAccessToken string
 	GetURL             string
 	TestURL            string
+	CommitID           string // commit to test; the default branch is tested if empty
 	RandomSecret       string
 }
 
-func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) *AssignmentInfo {
+func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL, commitID string) *AssignmentInfo {
 	script := assignment.GetScriptFile()
 	if strings.Count(script, ".") < 1 {
 		script = script + ".sh"
@@ -33,6 +34,7 @@ func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, t
 		CreatorAccessToken: course.GetAccessToken(),
 		GetURL:             cloneURL,
 		TestURL:            testURL,
+		CommitID:           commitID,
 		RandomSecret:       randomSecret(),
 	}
 }
@@ -3,6 +3,7 @@ package ci
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -70,3 +71,34 @@ func TestParseScript(t *testing.T) {
 		fmt.Println(j.Image)
 	}
 }
+
+func TestParseScriptCommit(t *testing.T) {
+	const commitID = "e7a3f5b0d4c2a1f9e8d7c6b5a4f3e2d1c0b9a8f7"
+	info := &AssignmentInfo{
+		AssignmentName:     "lab2",
+		CreatorAccessToken: "secret",
+		GetURL:             "https://github.com/qf101/user-labs",
+		TestURL:            "https://github.com/qf101/tests",
+		RandomSecret:       "random",
+	}
+	checkout := "checkout --quiet " + commitID
+	for _, script := range []string{"go.sh", "python361.sh", "java8.sh", "dotnet5.0.sh"} {
+		info.Script = script
+		info.CommitID = ""
+		j, err := parseScriptTemplate("scripts", info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(strings.Join(j.Commands, "\n"), "checkout") {
+			t.Errorf("%s: expected default branch to be tested without commit", script)
+		}
+		info.CommitID = commitID
+		j, err = parseScriptTemplate("scripts", info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(strings.Join(j.Commands, "\n"), checkout) {
+			t.Errorf("%s: expected commit %s to be checked out", script, commitID)
+		}
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
 	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/scm"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -21,12 +24,15 @@ const (
 
 // RunData stores CI data
 type RunData struct {
-	Course     *pb.Course
-	Assignment *pb.Assignment
-	Repo       *pb.Repository
-	CommitID   string
-	JobOwner   string
-	Rebuild    bool
+	Course      *pb.Course
+	Assignment  *pb.Assignment
+	Repo        *pb.Repository
+	CommitID    string
+	JobOwner    string
+	Rebuild     bool
+	SCM         scm.SCM // reports the results back to the repository; nil to not report the results
+	BaseURL     string  // base DNS name of QuickFeed, used to link the reported results to the submission
+	PullRequest uint64  // number of the pull request that is tested, if any; the results are commented on it
 }
 
 // String returns a string representation of the run data structure
@@ -35,13 +41,17 @@ func (r RunData) String(secret string) string {
 }
 
 // RunTests runs the assignment specified in the provided RunData structure.
-func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) {
-	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+// The owners of the new submission are notified about the results, unless this is a rebuild.
+// The results are also reported back to the tested commit in the repository.
+func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, notifier *notify.Notifier, rData *RunData) {
+	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL(), rData.CommitID)
 	logger.Debugf("Running tests for %s", rData.JobOwner)
+	reportStatus(logger, rData, scm.CommitPending, "Running tests", "")
 	ed, err := runTests(scriptPath, runner, info, rData)
 	if err != nil {
 		logger.Errorf("Failed to run tests: %v", err)
 		if ed == nil {
+			reportStatus(logger, rData, scm.CommitError, "Failed to run tests", "")
 			return
 		}
 		// we only get here if err was a timeout, so that we can log 'out' to the user
@@ -49,12 +59,30 @@ func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rD
 	result, err := score.ExtractResults(ed.out, info.RandomSecret, ed.execTime)
 	if err != nil {
 		logger.Errorf("Failed to extract results from log: %v", err)
+		reportStatus(logger, rData, scm.CommitError, "Failed to extract test results", "")
 		return
 	}
 	logger.Debug("ci.ExtractResults",
 		zap.Any("results", log.IndentJson(result)),
 	)
-	recordResults(logger, db, rData, result)
+	recordResults(logger, db, notifier, rData, result)
+}
+
+// RecordWithoutTests saves a new submission of the commit given by the run data structure,
+// without running any tests. This is used for manually graded assignments.
+func RecordWithoutTests(db database.Database, rData *RunData) error {
+	return db.CreateSubmission(&pb.Submission{
+		AssignmentID: rData.Assignment.GetID(),
+		BuildInfo: &score.BuildInfo{
+			BuildDate: time.Now().Format(pb.TimeLayout),
+			BuildLog:  "No automated tests for this assignment",
+			ExecTime:  1,
+		},
+		CommitHash:  rData.CommitID,
+		UserID:      rData.Repo.GetUserID(),
+		GroupID:     rData.Repo.GetGroupID(),
+		PullRequest: rData.PullRequest,
+	})
 }
 
 type execData struct {
@@ -90,8 +118,8 @@ func runTests(path string, runner Runner, info *AssignmentInfo, rData *RunData)
 	return &execData{out: out, execTime: time.Since(start)}, err
 }
 
-// recordResults for the assignment given by the run data structure.
-func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *score.Results) {
+// recordResults for the assignment given by the run data structure, and reports the results back to the repository.
+func recordResults(logger *zap.SugaredLogger, db database.Database, notifier *notify.Notifier, rData *RunData, result *score.Results) {
 	assignment := rData.Assignment
 	logger.Debugf("Fetching most recent submission for assignment %d", assignment.GetID())
 	submissionQuery := &pb.Submission{
@@ -110,17 +138,20 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 		result.BuildInfo.BuildDate = newest.BuildInfo.BuildDate
 	}
 
-	score := result.Sum()
+	rawScore := result.Sum()
+	score := penalizedScore(logger, rData.Course, assignment, result.BuildInfo.GetBuildDate(), rawScore)
 	newSubmission := &pb.Submission{
 		ID:           newest.GetID(),
 		AssignmentID: assignment.GetID(),
 		CommitHash:   rData.CommitID,
 		Score:        score,
+		RawScore:     rawScore,
 		BuildInfo:    result.BuildInfo,
 		Scores:       result.Scores,
 		UserID:       rData.Repo.GetUserID(),
 		GroupID:      rData.Repo.GetGroupID(),
 		Status:       assignment.IsApproved(newest, score),
+		PullRequest:  rData.PullRequest,
 	}
 	err = db.CreateSubmission(newSubmission)
 	if err != nil {
@@ -128,9 +159,50 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 		return
 	}
 	logger.Debugf("Created submission for assignment '%s' with score %d, status %s", assignment.GetName(), score, newSubmission.GetStatus())
+	state, title, details := resultStatus(rData, result, score)
+	reportStatus(logger, rData, state, title, details)
+	commentResults(logger, rData, title, details)
+	if newest != nil && newSubmission.GetStatus() != newest.GetStatus() {
+		notifier.Dispatch(notify.SubmissionStatus, rData.Course.GetID(), newSubmission)
+	}
 	if !rData.Rebuild {
-		updateSlipDays(logger, db, rData.Assignment, newSubmission)
+		notifier.Dispatch(notify.SubmissionCreated, rData.Course.GetID(), newSubmission)
+		updateSlipDays(logger, db, rData.Course, rData.Assignment, newSubmission)
+		event := &notify.Event{
+			Course:     rData.Course,
+			Assignment: assignment,
+			Submission: newSubmission,
+		}
+		notifier.NotifyOwners(pb.NotificationOptOut_BUILD, event)
+		if score < assignment.GetScoreLimit() {
+			notifier.Announce(chat.BuildFailure, event)
+		}
+		if newSubmission.GetStatus() == pb.Submission_APPROVED && newest.GetStatus() != pb.Submission_APPROVED {
+			notifier.Announce(chat.Approval, event)
+		}
+	}
+}
+
+// penalizedScore returns the score after applying the assignment's late policy.
+// The raw score is returned if the build date cannot be parsed.
+func penalizedScore(logger *zap.SugaredLogger, course *pb.Course, assignment *pb.Assignment, buildDate string, rawScore uint32) uint32 {
+	if assignment.GetLatePolicy() == pb.Assignment_NONE {
+		return rawScore
+	}
+	buildTime, err := time.Parse(pb.TimeLayout, buildDate)
+	if err != nil {
+		logger.Errorf("Failed to parse time from build date (%s): %v", buildDate, err)
+		return rawScore
+	}
+	score, err := assignment.PenalizedScore(course, buildTime, rawScore)
+	if err != nil {
+		logger.Errorf("Failed to apply late policy for assignment '%s': %v", assignment.GetName(), err)
+		return rawScore
+	}
+	if score != rawScore {
+		logger.Debugf("Late policy %s reduced score for assignment '%s' from %d to %d", assignment.GetLatePolicy(), assignment.GetName(), rawScore, score)
 	}
+	return score
 }
 
 func randomSecret() string {
@@ -141,7 +213,7 @@ func randomSecret() string {
 	return fmt.Sprintf("%x", sha1.Sum(randomness))
 }
 
-func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment *pb.Assignment, submission *pb.Submission) {
+func updateSlipDays(logger *zap.SugaredLogger, db database.Database, course *pb.Course, assignment *pb.Assignment, submission *pb.Submission) {
 	buildDate := submission.GetBuildInfo().GetBuildDate()
 	buildTime, err := time.Parse(pb.TimeLayout, buildDate)
 	if err != nil {
@@ -156,6 +228,13 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 			logger.Errorf("Failed to get group %d: %v", submission.GroupID, err)
 			return
 		}
+		for _, enrol := range group.Enrollments {
+			enrol.Course = course
+		}
+		if err := group.UpdateSlipDays(buildTime, assignment, submission); err != nil {
+			logger.Errorf("Failed to update slip days for submission %d: %v", submission.ID, err)
+			return
+		}
 		enrollments = append(enrollments, group.Enrollments...)
 	} else {
 		enrol, err := db.GetEnrollmentByCourseAndUser(assignment.CourseID, submission.UserID)
@@ -163,14 +242,15 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 			logger.Errorf("Failed to get enrollment for user %d: %v", submission.UserID, err)
 			return
 		}
-		enrollments = append(enrollments, enrol)
-	}
-
-	for _, enrol := range enrollments {
+		enrol.Course = course
 		if err := enrol.UpdateSlipDays(buildTime, assignment, submission); err != nil {
 			logger.Errorf("Failed to update slip days for submission %d: %v", submission.ID, err)
 			return
 		}
+		enrollments = append(enrollments, enrol)
+	}
+
+	for _, enrol := range enrollments {
 		if err := db.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
 			logger.Errorf("Failed to update slip days for enrollment %d: %v", enrol.ID, err)
 			return
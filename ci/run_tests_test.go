@@ -2,6 +2,8 @@ package ci
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -138,7 +140,7 @@ func TestRecordResults(t *testing.T) {
 		JobOwner: "test",
 	}
 
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, nil, runData, results)
 	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID})
 	if err != nil {
 		t.Fatal(err)
@@ -156,7 +158,7 @@ func TestRecordResults(t *testing.T) {
 	// Updating submission after deadline: build info and slip days must be updated
 	newBuildDate := "2022-11-12T13:00:00"
 	results.BuildInfo.BuildDate = newBuildDate
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, nil, runData, results)
 
 	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
 	if err != nil {
@@ -177,7 +179,7 @@ func TestRecordResults(t *testing.T) {
 	runData.Rebuild = true
 	results.BuildInfo.BuildDate = "2022-11-13T13:00:00"
 	slipDaysBeforeUpdate := enrollment.RemainingSlipDays(course)
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, nil, runData, results)
 	updatedEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
 	if err != nil {
 		t.Fatal(err)
@@ -186,3 +188,164 @@ func TestRecordResults(t *testing.T) {
 		t.Errorf("Incorrect number of slip days: expected %d, got %d", slipDaysBeforeUpdate, updatedEnrollment.RemainingSlipDays(course))
 	}
 }
+
+func TestRecordResultsCommitStatus(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  user.ID,
+	}
+	if err := db.CreateCourse(user.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:   course.ID,
+		Name:       "lab1",
+		Deadline:   "2099-11-11T13:00:00",
+		ScoreLimit: 70,
+		Order:      1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeSCM := scm.NewFakeSCMClient()
+	runData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: user.ID, HTMLURL: "https://github.com/qf-org/user-labs"},
+		CommitID:   "abc123",
+		JobOwner:   "test",
+		SCM:        fakeSCM,
+		BaseURL:    "quickfeed.example.com",
+	}
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{BuildDate: "2022-11-10T13:00:00"},
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 15, MaxScore: 15, Weight: 1},
+			{TestName: "TestB", Score: 0, MaxScore: 10, Weight: 1},
+		},
+	}
+	recordResults(zap.NewNop().Sugar(), db, nil, runData, results)
+	if len(fakeSCM.Statuses) != 1 {
+		t.Fatalf("reported %d commit statuses, want %d", len(fakeSCM.Statuses), 1)
+	}
+	got := fakeSCM.Statuses[0]
+	want := &scm.CommitStatusOptions{
+		Owner:      "qf-org",
+		Repository: "user-labs",
+		CommitHash: "abc123",
+		Name:       "quickfeed/lab1",
+		State:      scm.CommitFailure,
+		Title:      "Score 50%: 1 of 2 tests passed",
+		Details:    "| Test | Score | Result |\n|------|-------|--------|\n| TestA | 15/15 | pass |\n| TestB | 0/10 | fail |\n",
+		TargetURL:  fmt.Sprintf("https://quickfeed.example.com/app/student/courses/%d/lab/%d", course.ID, assignment.ID),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("commit status mismatch (-want +got):\n%s", diff)
+	}
+
+	if len(fakeSCM.PullRequestComments) != 0 {
+		t.Errorf("commented on %d pull requests for a pushed commit, want %d", len(fakeSCM.PullRequestComments), 0)
+	}
+
+	// results of pull requests are also commented on the pull request
+	runData.PullRequest = 3
+	results.Scores[1].Score = 10
+	recordResults(zap.NewNop().Sugar(), db, nil, runData, results)
+	if len(fakeSCM.Statuses) != 2 || fakeSCM.Statuses[1].State != scm.CommitSuccess {
+		t.Errorf("commit statuses = %+v, want the passing results reported as %s", fakeSCM.Statuses, scm.CommitSuccess)
+	}
+	if len(fakeSCM.PullRequestComments) != 1 {
+		t.Fatalf("commented on %d pull requests, want %d", len(fakeSCM.PullRequestComments), 1)
+	}
+	comment := fakeSCM.PullRequestComments[0]
+	if comment.Number != 3 || !strings.Contains(comment.Body, "Score 100%: 2 of 2 tests passed") || !strings.Contains(comment.Body, want.TargetURL) {
+		t.Errorf("pull request comment = %+v, want the results and a link to the submission on pull request %d", comment, 3)
+	}
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetPullRequest() != 3 {
+		t.Errorf("submission has pull request %d, want %d", submission.GetPullRequest(), 3)
+	}
+}
+
+func TestRecordResultsLatePenalty(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:            "Test",
+		OrganizationID:  1,
+		CourseCreatorID: admin.ID,
+		SlipDays:        5,
+	}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+
+	assignment := &pb.Assignment{
+		CourseID:    course.ID,
+		Name:        "lab1",
+		ScriptFile:  "go.sh",
+		Deadline:    "2022-11-11T13:00:00",
+		ScoreLimit:  70,
+		Order:       1,
+		LatePolicy:  pb.Assignment_PENALTY,
+		LatePenalty: 25,
+		LateDays:    3,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{
+			BuildDate: "2022-11-10T13:00:00",
+			BuildLog:  "Testing",
+			ExecTime:  33333,
+		},
+		Scores: []*score.Score{
+			{Secret: "secret", TestName: "Test", Score: 15, MaxScore: 15, Weight: 1},
+		},
+	}
+	runData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: admin.ID},
+		JobOwner:   "test",
+	}
+
+	lateTests := []struct {
+		buildDate string
+		want      uint32
+	}{
+		{"2022-11-10T13:00:00", 100}, // before deadline
+		{"2022-11-11T14:00:00", 100}, // within grace period
+		{"2022-11-12T13:00:00", 75},  // one day late
+		{"2022-11-13T16:00:00", 25},  // three days late
+		{"2022-11-14T16:00:00", 0},   // past cutoff
+	}
+	for _, test := range lateTests {
+		results.BuildInfo.BuildDate = test.buildDate
+		recordResults(zap.NewNop().Sugar(), db, nil, runData, results)
+		submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: admin.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if submission.RawScore != 100 {
+			t.Errorf("Incorrect raw score for build date %s: want %d, got %d", test.buildDate, 100, submission.RawScore)
+		}
+		if submission.Score != test.want {
+			t.Errorf("Incorrect penalized score for build date %s: want %d, got %d", test.buildDate, test.want, submission.Score)
+		}
+	}
+}
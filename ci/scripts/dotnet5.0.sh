@@ -13,7 +13,13 @@ TESTDIR=$TESTS/{{ .AssignmentName }}/
 # Fetch student and test repos
 git clone {{ .GetURL }} $ASSIGNMENTS
 git clone {{ .TestURL }} $TESTS
-
+{{ if .CommitID }}
+# Test the submitted commit
+if ! git -C $ASSIGNMENTS checkout --quiet {{ .CommitID }}; then
+  printf "Commit {{ .CommitID }} not found in {{ .GetURL }}"
+  exit
+fi
+{{ end }}
 if [ ! -d "$ASSIGNDIR" ]; then
   printf "Folder $ASSIGNDIR not found in {{ .GetURL }}"
   exit
@@ -8,7 +8,13 @@ ls
 
 git clone  {{ .GetURL }} /home/gradle/user  
 git clone  {{ .TestURL }} /home/gradle/test
-
+{{ if .CommitID }}
+# Test the submitted commit
+if ! git -C /home/gradle/user checkout --quiet {{ .CommitID }}; then
+  printf "Commit {{ .CommitID }} not found in {{ .GetURL }}"
+  exit
+fi
+{{ end }}
 cat <<EOF> /home/gradle/.gradle/gradle.properties
 org.gradle.parallel=true
 org.gradle.daemon=true
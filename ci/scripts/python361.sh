@@ -7,7 +7,13 @@ export PYTHONPATH="/root"
 
 git clone {{ .GetURL }} user
 git clone {{ .TestURL }} test
-
+{{ if .CommitID }}
+# Test the submitted commit
+if ! git -C user checkout --quiet {{ .CommitID }}; then
+  printf "Commit {{ .CommitID }} not found in {{ .GetURL }}"
+  exit
+fi
+{{ end }}
 history -c
 
 
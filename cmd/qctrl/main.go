@@ -39,12 +39,11 @@ func main() {
 	flag.Parse()
 
 	studentMap := loadApproveSheet(srcFile, sheetName)
-	currentUserID := os.Getenv("QUICKFEED_USER")
-	if currentUserID == "" {
-		log.Fatal("Requires a 'QUICKFEED_USER' environmental variable with a valid ID of a registered user")
+	token := strings.TrimSpace(os.Getenv("QUICKFEED_TOKEN"))
+	if token == "" {
+		log.Fatal("Requires a 'QUICKFEED_TOKEN' environmental variable with a valid API token of a registered user")
 	}
-	convertedID := strings.TrimSpace(currentUserID)
-	requestMetadata := metadata.New(map[string]string{"user": convertedID})
+	requestMetadata := metadata.New(map[string]string{"authorization": "Bearer " + token})
 	reqCtx := metadata.NewOutgoingContext(context.Background(), requestMetadata)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -28,6 +28,39 @@ type Database interface {
 	GetUsers(...uint64) ([]*pb.User, error)
 	// UpdateUser updates the user's details, excluding remote identities.
 	UpdateUser(*pb.User) error
+	// UpdateNotificationOptOuts replaces the notification categories that the given user has opted out of.
+	UpdateNotificationOptOuts(userID uint64, categories []pb.NotificationOptOut_Category) error
+	// GetNotificationOptOuts returns the notification categories that the given user has opted out of.
+	GetNotificationOptOuts(userID uint64) ([]pb.NotificationOptOut_Category, error)
+	// UpdateChatIdentity links the identity's user to the given chat account, replacing any previous
+	// account of the user on the same chat platform. Fails if the chat account is linked to another user.
+	UpdateChatIdentity(*pb.ChatIdentity) error
+	// GetChatIdentity returns the identity linked to the given chat account.
+	GetChatIdentity(platform pb.ChatIntegration_Platform, chatUserID string) (*pb.ChatIdentity, error)
+	// CreateAPIToken creates a new API token.
+	CreateAPIToken(*pb.APIToken) error
+	// GetAPIToken returns the API token matching the given query.
+	GetAPIToken(query *pb.APIToken) (*pb.APIToken, error)
+	// GetAPITokens returns the API tokens of the given user.
+	GetAPITokens(userID uint64) ([]*pb.APIToken, error)
+	// DeleteAPIToken deletes the API token with the given ID.
+	DeleteAPIToken(tokenID uint64) error
+	// CreateSession creates a new session.
+	CreateSession(*pb.Session) error
+	// GetSession returns the session matching the given query.
+	GetSession(query *pb.Session) (*pb.Session, error)
+	// GetSessions returns the sessions of the given user.
+	GetSessions(userID uint64) ([]*pb.Session, error)
+	// DeleteSessions deletes the sessions matching the given query.
+	DeleteSessions(query *pb.Session) error
+	// DeleteExpiredSessions deletes the sessions that expired before the given date.
+	DeleteExpiredSessions(date string) error
+	// CreateOAuthState creates a new OAuth state for a login in progress.
+	CreateOAuthState(*pb.OAuthState) error
+	// ConsumeOAuthState returns and deletes the OAuth state with the given hash.
+	ConsumeOAuthState(hash string) (*pb.OAuthState, error)
+	// DeleteExpiredOAuthStates deletes the OAuth states that expired before the given date.
+	DeleteExpiredOAuthStates(date string) error
 
 	// CreateCourse creates a new course if user with given ID is admin, enrolls user as course teacher.
 	CreateCourse(uint64, *pb.Course) error
@@ -39,6 +72,35 @@ type Database interface {
 	// GetCourses returns a list of courses. If one or more course IDs are provided,
 	// the corresponding courses are returned. Otherwise, all courses are returned.
 	GetCourses(...uint64) ([]*pb.Course, error)
+	// UpdateChatIntegration creates or updates the given chat integration.
+	UpdateChatIntegration(*pb.ChatIntegration) error
+	// GetChatIntegration returns the chat integration with the given ID.
+	GetChatIntegration(uint64) (*pb.ChatIntegration, error)
+	// GetChatIntegrations returns the chat integrations matching the query.
+	GetChatIntegrations(query *pb.ChatIntegration) ([]*pb.ChatIntegration, error)
+	// DeleteChatIntegration removes the chat integration with the given ID.
+	DeleteChatIntegration(uint64) error
+	// UpdateWebhook creates or updates the given outbound webhook.
+	UpdateWebhook(*pb.Webhook) error
+	// GetWebhook returns the outbound webhook with the given ID.
+	GetWebhook(uint64) (*pb.Webhook, error)
+	// GetWebhooks returns the outbound webhooks matching the query.
+	GetWebhooks(query *pb.Webhook) ([]*pb.Webhook, error)
+	// DeleteWebhook removes the outbound webhook with the given ID and its delivery log.
+	DeleteWebhook(uint64) error
+	// CreateWebhookDelivery records a new webhook delivery.
+	CreateWebhookDelivery(*pb.WebhookDelivery) error
+	// UpdateWebhookDelivery updates the given webhook delivery after a delivery attempt.
+	UpdateWebhookDelivery(*pb.WebhookDelivery) error
+	// GetWebhookDeliveries returns the most recent deliveries to the given webhook, newest first.
+	GetWebhookDeliveries(webhookID uint64, limit int) ([]*pb.WebhookDelivery, error)
+	// GetPendingWebhookDeliveries returns the undelivered deliveries due to be retried at the given time.
+	GetPendingWebhookDeliveries(now string) ([]*pb.WebhookDelivery, error)
+	// ClaimWebhookDelivery reschedules the given pending delivery to the given time, unless it has changed
+	// since it was read, and returns true if the delivery was claimed.
+	ClaimWebhookDelivery(delivery *pb.WebhookDelivery, nextAttempt string) (bool, error)
+	// CreateChatReminder records the given deadline reminder, and returns false if it was already recorded.
+	CreateChatReminder(*pb.ChatReminder) (bool, error)
 	// GetCoursesByUser returns all courses (with enrollment status)
 	// for the given user id.
 	// If enrollment statuses is provided, the set of courses returned
@@ -72,6 +134,12 @@ type Database interface {
 	GetGroup(uint64) (*pb.Group, error)
 	// GetGroupsByCourse returns the groups for the given course.
 	GetGroupsByCourse(courseID uint64, statuses ...pb.Group_GroupStatus) ([]*pb.Group, error)
+	// GetGroupInSet returns the user's group in the given group set of the course.
+	// An empty group set gives the user's group among the course's default groups.
+	GetGroupInSet(courseID, userID uint64, groupSet string) (*pb.Group, error)
+	// GetGroupMemberships returns the membership history of the given group,
+	// recorded when groups are created, updated, and deleted.
+	GetGroupMemberships(groupID uint64) ([]*pb.GroupMembership, error)
 
 	// CreateAssignment creates a new or updates an existing assignment.
 	CreateAssignment(*pb.Assignment) error
@@ -83,12 +151,27 @@ type Database interface {
 	UpdateAssignments([]*pb.Assignment) error
 	// CreateBenchmark creates a new grading benchmark.
 	CreateBenchmark(*pb.GradingBenchmark) error
+	// GetBenchmark returns the grading benchmark with the given ID.
+	GetBenchmark(benchmarkID uint64) (*pb.GradingBenchmark, error)
 	// UpdateBenchmark updates the given benchmark.
 	UpdateBenchmark(*pb.GradingBenchmark) error
 	// DeleteBenchmark deletes the given benchmark.
 	DeleteBenchmark(*pb.GradingBenchmark) error
+	// CreateRubricTemplate creates a new version of the given rubric template.
+	CreateRubricTemplate(*pb.RubricTemplate) error
+	// GetRubricTemplate returns the rubric template with the given ID.
+	GetRubricTemplate(templateID uint64) (*pb.RubricTemplate, error)
+	// GetRubricTemplates returns the given course's rubric templates and the templates shared by other courses.
+	GetRubricTemplates(courseID uint64) ([]*pb.RubricTemplate, error)
+	// UpdateAssignmentRubric records the rubric template that the given assignment's benchmarks were loaded from.
+	UpdateAssignmentRubric(assignmentID, templateID uint64) error
+	// UpdateRubric runs the given update of an assignment's rubric in a single transaction.
+	// The update is given a database whose operations are part of the transaction.
+	UpdateRubric(update func(tx Database) error) error
 	// CreateCriterion creates a new grading criterion.
 	CreateCriterion(*pb.GradingCriterion) error
+	// GetCriterion returns the grading criterion with the given ID.
+	GetCriterion(criterionID uint64) (*pb.GradingCriterion, error)
 	// UpdateCriterion updates the given criterion.
 	UpdateCriterion(*pb.GradingCriterion) error
 	// DeleteCriterion deletes the given criterion.
@@ -111,12 +194,41 @@ type Database interface {
 	UpdateSubmission(*pb.Submission) error
 	// UpdateSubmissions releases and/or approves all submissions with a certain score
 	UpdateSubmissions(uint64, *pb.Submission) error
+	// UpdateReleaseSchedule creates or replaces the release schedule for the schedule's assignment.
+	UpdateReleaseSchedule(*pb.ReleaseSchedule) error
+	// GetReleaseSchedules returns the release schedules for the given course.
+	GetReleaseSchedules(courseID uint64) ([]*pb.ReleaseSchedule, error)
+	// GetPendingReleases returns the release schedules for all courses that have not yet been released.
+	GetPendingReleases() ([]*pb.ReleaseSchedule, error)
+	// DeleteReleaseSchedule removes the release schedule for the given assignment.
+	DeleteReleaseSchedule(assignmentID uint64) error
 	// CreateReview adds a new submission review.
 	CreateReview(*pb.Review) error
 	// UpdateReview updates the given review.
 	UpdateReview(*pb.Review) error
 	// DeleteReview removes all review records matching the query.
 	DeleteReview(*pb.Review) error
+	// GetReview returns the review matching the given query.
+	GetReview(query *pb.Review) (*pb.Review, error)
+	// UpdatePeerReviewFlags updates the outlier and excluded flags of the given peer review.
+	UpdatePeerReviewFlags(*pb.Review) error
+	// AssignPeerReviewers creates the peer reviewer assignments returned by the assign function
+	// for the given assignment, given the assignment's existing peer reviewer assignments.
+	AssignPeerReviewers(assignmentID uint64, assign func(assigned []*pb.PeerReviewer) []*pb.PeerReviewer) error
+	// GetPeerReviewers returns the peer reviewer assignments matching the query.
+	GetPeerReviewers(query *pb.PeerReviewer) ([]*pb.PeerReviewer, error)
+	// UpdateGradeOverride sets or, given a NONE grade, removes the lead teacher's grade for a criterion of a submission.
+	UpdateGradeOverride(*pb.GradeOverride) error
+	// GetGradeOverrides returns the lead teacher's grade overrides for the given submission.
+	GetGradeOverrides(submissionID uint64) ([]*pb.GradeOverride, error)
+	// CreateCodeComment creates the given code comment on a review.
+	CreateCodeComment(*pb.CodeComment) error
+	// GetCodeComments returns the code comments matching the query, ordered by file path and line.
+	GetCodeComments(query *pb.CodeComment) ([]*pb.CodeComment, error)
+	// UpdateReviewTasks replaces the review tasks for the given assignment with the given tasks.
+	UpdateReviewTasks(assignmentID uint64, tasks []*pb.ReviewTask) error
+	// GetReviewTasks returns the review tasks matching the query.
+	GetReviewTasks(query *pb.ReviewTask) ([]*pb.ReviewTask, error)
 
 	// CreateRepository creates a new repository.
 	CreateRepository(repo *pb.Repository) error
@@ -127,6 +239,17 @@ type Database interface {
 	// DeleteRepository deletes repository by the given provider's ID
 	DeleteRepositoryByRemoteID(uint64) error
 
-	// UpdateSlipDays updates used slipdays for the given course enrollment
+	// UpdateSlipDays updates used slipdays for the given course enrollment,
+	// and records any changes in the slip day ledger.
 	UpdateSlipDays([]*pb.UsedSlipDays) error
+	// AdjustSlipDays adjusts the slip days used for an assignment by the given ledger entry's change,
+	// and records the manual adjustment in the slip day ledger.
+	AdjustSlipDays(*pb.SlipDayLedgerEntry) error
+	// GetSlipDayLedger returns the slip day ledger entries for the given enrollment.
+	GetSlipDayLedger(enrollmentID uint64) ([]*pb.SlipDayLedgerEntry, error)
+
+	// CreateAuditLogEntry appends the given entry to the audit log.
+	CreateAuditLogEntry(*pb.AuditLogEntry) error
+	// GetAuditLog returns the audit log entries matching the given query, oldest first.
+	GetAuditLog(query *pb.AuditLogRequest) ([]*pb.AuditLogEntry, error)
 }
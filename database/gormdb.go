@@ -23,6 +23,9 @@ var (
 	ErrDuplicateGroup = status.Error(codes.InvalidArgument, "group with this name already registered")
 	// ErrUpdateGroup is returned when updating a group's enrollment fails.
 	ErrUpdateGroup = errors.New("failed to update group enrollment")
+	// ErrGroupSetMember is returned when a user would be a member of more than
+	// one group in the same group set.
+	ErrGroupSetMember = status.Error(codes.InvalidArgument, "user already in another group of this group set")
 	// ErrCourseExists is returned when trying to create an association in
 	// the database for a DirectoryId that already exists in the database.
 	ErrCourseExists = errors.New("course already exists on git provider")
@@ -34,6 +37,9 @@ var (
 	// ErrNotEnrolled is returned when the requested user or group do not have
 	// the expected association with the given course
 	ErrNotEnrolled = errors.New("user or group not enrolled in the course")
+	// ErrNegativeSlipDays is returned when adjusting the slip days used
+	// for an assignment would make them negative.
+	ErrNegativeSlipDays = errors.New("slip days used for an assignment cannot be negative")
 )
 
 // GormDB implements the Database interface.
@@ -52,22 +58,43 @@ func NewGormDB(path string, logger *zap.Logger) (*GormDB, error) {
 
 	if err := conn.AutoMigrate(
 		&pb.User{},
+		&pb.NotificationOptOut{},
+		&pb.ChatIdentity{},
+		&pb.APIToken{},
+		&pb.Session{},
+		&pb.OAuthState{},
 		&pb.RemoteIdentity{},
 		&pb.Course{},
+		&pb.ChatIntegration{},
+		&pb.ChatReminder{},
+		&pb.Webhook{},
+		&pb.WebhookDelivery{},
 		&pb.Enrollment{},
 		&pb.Assignment{},
+		&pb.ReleaseSchedule{},
 		&pb.Submission{},
 		&pb.Group{},
+		&pb.GroupMembership{},
 		&pb.Repository{},
 		&pb.UsedSlipDays{},
+		&pb.SlipDayLedgerEntry{},
 		&pb.GradingBenchmark{},
 		&pb.GradingCriterion{},
+		&pb.RubricTemplate{},
 		&pb.Review{},
+		&pb.PeerReviewer{},
+		&pb.ReviewTask{},
+		&pb.CodeComment{},
+		&pb.GradeOverride{},
+		&pb.AuditLogEntry{},
 		&score.BuildInfo{},
 		&score.Score{},
 	); err != nil {
 		return nil, err
 	}
+	if err := protectAuditLog(conn); err != nil {
+		return nil, err
+	}
 
 	return &GormDB{conn}, nil
 }
@@ -0,0 +1,33 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// CreateAPIToken creates a new API token.
+func (db *GormDB) CreateAPIToken(token *pb.APIToken) error {
+	return db.conn.Create(token).Error
+}
+
+// GetAPIToken returns the API token matching the given query.
+func (db *GormDB) GetAPIToken(query *pb.APIToken) (*pb.APIToken, error) {
+	var token pb.APIToken
+	if err := db.conn.Where(query).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetAPITokens returns the API tokens of the given user, oldest first.
+func (db *GormDB) GetAPITokens(userID uint64) ([]*pb.APIToken, error) {
+	var tokens []*pb.APIToken
+	if err := db.conn.Where(&pb.APIToken{UserID: userID}).Order("id").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// DeleteAPIToken deletes the API token with the given ID.
+func (db *GormDB) DeleteAPIToken(tokenID uint64) error {
+	return db.conn.Delete(&pb.APIToken{}, tokenID).Error
+}
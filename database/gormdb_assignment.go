@@ -39,6 +39,12 @@ func (db *GormDB) CreateAssignment(assignment *pb.Assignment) error {
 			"is_group_lab":      assignment.IsGroupLab,
 			"reviewers":         assignment.Reviewers,
 			"container_timeout": assignment.ContainerTimeout,
+			"late_policy":       assignment.LatePolicy,
+			"late_penalty":      assignment.LatePenalty,
+			"late_days":         assignment.LateDays,
+			"peer_reviewers":    assignment.PeerReviewers,
+			"blind_review":      assignment.BlindReview,
+			"group_set":         assignment.GroupSet,
 		}).FirstOrCreate(assignment).Error
 }
 
@@ -108,6 +114,7 @@ func (db *GormDB) GetAssignmentsWithSubmissions(courseID uint64, submissionType
 		Preload("Submissions.Reviews").
 		Preload("Submissions.Reviews.GradingBenchmarks").
 		Preload("Submissions.Reviews.GradingBenchmarks.Criteria").
+		Preload("Submissions.Reviews.CodeComments").
 		Preload("Submissions.BuildInfo").
 		Preload("Submissions.Scores").
 		Where(&pb.Assignment{CourseID: courseID}).
@@ -147,6 +154,15 @@ func (db *GormDB) CreateBenchmark(query *pb.GradingBenchmark) error {
 	return db.conn.Create(query).Error
 }
 
+// GetBenchmark returns the grading benchmark with the given ID.
+func (db *GormDB) GetBenchmark(benchmarkID uint64) (*pb.GradingBenchmark, error) {
+	var benchmark pb.GradingBenchmark
+	if err := db.conn.First(&benchmark, benchmarkID).Error; err != nil {
+		return nil, err
+	}
+	return &benchmark, nil
+}
+
 // UpdateBenchmark updates the given benchmark
 func (db *GormDB) UpdateBenchmark(query *pb.GradingBenchmark) error {
 	return db.conn.
@@ -170,6 +186,15 @@ func (db *GormDB) CreateCriterion(query *pb.GradingCriterion) error {
 	return db.conn.Create(query).Error
 }
 
+// GetCriterion returns the grading criterion with the given ID.
+func (db *GormDB) GetCriterion(criterionID uint64) (*pb.GradingCriterion, error) {
+	var criterion pb.GradingCriterion
+	if err := db.conn.First(&criterion, criterionID).Error; err != nil {
+		return nil, err
+	}
+	return &criterion, nil
+}
+
 // UpdateCriterion updates the given criterion
 func (db *GormDB) UpdateCriterion(query *pb.GradingCriterion) error {
 	return db.conn.
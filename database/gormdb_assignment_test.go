@@ -1,10 +1,12 @@
 package database_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/internal/qtest"
 	"github.com/autograde/quickfeed/kit/score"
 	"github.com/google/go-cmp/cmp"
@@ -330,3 +332,52 @@ func TestUpdateBenchmarks(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateRubricRollback(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{}
+	admin := qtest.CreateFakeUser(t, db, 10)
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "Assignment 1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	// a failed update stores none of its changes
+	errFailed := errors.New("update failed")
+	err := db.UpdateRubric(func(tx database.Database) error {
+		if err := tx.CreateBenchmark(&pb.GradingBenchmark{AssignmentID: assignment.ID, Heading: "Code"}); err != nil {
+			return err
+		}
+		if err := tx.UpdateAssignmentRubric(assignment.ID, 1); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if err != errFailed {
+		t.Errorf("UpdateRubric() = %v, want %v", err, errFailed)
+	}
+	got, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetGradingBenchmarks()) != 0 || got.GetRubricTemplateID() != 0 {
+		t.Errorf("failed UpdateRubric() stored benchmarks %v and rubric template %d", got.GetGradingBenchmarks(), got.GetRubricTemplateID())
+	}
+
+	if err := db.UpdateRubric(func(tx database.Database) error {
+		return tx.CreateBenchmark(&pb.GradingBenchmark{AssignmentID: assignment.ID, Heading: "Code"})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetAssignment(&pb.Assignment{ID: assignment.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetGradingBenchmarks()) != 1 {
+		t.Errorf("UpdateRubric() stored %d benchmarks, want %d", len(got.GetGradingBenchmarks()), 1)
+	}
+}
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// protectAuditLog makes the audit log append-only by rejecting updates and deletes of its entries.
+func protectAuditLog(conn *gorm.DB) error {
+	for _, operation := range []string{"update", "delete"} {
+		trigger := fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS audit_log_entries_no_%[1]s BEFORE %[1]s ON audit_log_entries
+			BEGIN SELECT RAISE(ABORT, 'audit log is append-only'); END`, operation)
+		if err := conn.Exec(trigger).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateAuditLogEntry appends the given entry to the audit log.
+func (db *GormDB) CreateAuditLogEntry(entry *pb.AuditLogEntry) error {
+	return db.conn.Create(entry).Error
+}
+
+// GetAuditLog returns the audit log entries matching the given query, oldest first.
+func (db *GormDB) GetAuditLog(query *pb.AuditLogRequest) ([]*pb.AuditLogEntry, error) {
+	tx := db.conn.Where(&pb.AuditLogEntry{CourseID: query.GetCourseID(), Action: query.GetAction()})
+	if userID := query.GetUserID(); userID > 0 {
+		tx = tx.Where("(actor_id = ? OR user_id = ?)", userID, userID)
+	}
+	var entries []*pb.AuditLogEntry
+	if err := tx.Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,69 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm/clause"
+)
+
+// UpdateChatIntegration creates or updates the given chat integration.
+func (db *GormDB) UpdateChatIntegration(integration *pb.ChatIntegration) error {
+	return db.conn.Save(integration).Error
+}
+
+// GetChatIntegration returns the chat integration with the given ID.
+func (db *GormDB) GetChatIntegration(integrationID uint64) (*pb.ChatIntegration, error) {
+	var integration pb.ChatIntegration
+	if err := db.conn.First(&integration, integrationID).Error; err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// GetChatIntegrations returns the chat integrations matching the query.
+func (db *GormDB) GetChatIntegrations(query *pb.ChatIntegration) ([]*pb.ChatIntegration, error) {
+	var integrations []*pb.ChatIntegration
+	if err := db.conn.Where(query).Order("id").Find(&integrations).Error; err != nil {
+		return nil, err
+	}
+	return integrations, nil
+}
+
+// DeleteChatIntegration removes the chat integration with the given ID.
+func (db *GormDB) DeleteChatIntegration(integrationID uint64) error {
+	return db.conn.Delete(&pb.ChatIntegration{}, integrationID).Error
+}
+
+// CreateChatReminder records the given deadline reminder, and returns false if it was already recorded.
+func (db *GormDB) CreateChatReminder(reminder *pb.ChatReminder) (bool, error) {
+	result := db.conn.Clauses(clause.OnConflict{DoNothing: true}).Create(reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateChatIdentity links the identity's user to the given chat account, replacing any previous
+// account of the user on the same chat platform. Fails if the chat account is linked to another user.
+func (db *GormDB) UpdateChatIdentity(identity *pb.ChatIdentity) error {
+	tx := db.conn.Begin()
+	if err := tx.Where("user_id = ? AND platform = ?", identity.GetUserID(), identity.GetPlatform()).
+		Delete(&pb.ChatIdentity{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	identity.ID = 0
+	if err := tx.Create(identity).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// GetChatIdentity returns the identity linked to the given chat account.
+func (db *GormDB) GetChatIdentity(platform pb.ChatIntegration_Platform, chatUserID string) (*pb.ChatIdentity, error) {
+	var identity pb.ChatIdentity
+	if err := db.conn.Where("platform = ? AND chat_user_id = ?", platform, chatUserID).First(&identity).Error; err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
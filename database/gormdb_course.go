@@ -137,7 +137,18 @@ func (db *GormDB) GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_User
 
 // UpdateCourse updates course information.
 func (db *GormDB) UpdateCourse(course *pb.Course) error {
+	// select fields to allow course settings to be reset to their zero values
+	fields := []string{
+		"name", "code", "year", "tag", "provider", "organization_id", "organization_path",
+		"slip_days", "grace_period", "slip_day_unit", "slip_days_cap", "group_slip_days",
+		"submission_mode", "submission_trigger",
+		"min_group_size", "max_group_size", "group_deadline", "group_freeze_date",
+	}
+	if course.GetCourseCreatorID() > 0 {
+		fields = append(fields, "course_creator_id")
+	}
 	return db.conn.Model(&pb.Course{}).
 		Where(&pb.Course{ID: course.GetID()}).
+		Select(fields).
 		Updates(course).Error
 }
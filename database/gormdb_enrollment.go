@@ -1,8 +1,11 @@
 package database
 
 import (
+	"time"
+
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateEnrollment creates a new pending enrollment.
@@ -75,6 +78,7 @@ func (db *GormDB) getEnrollments(model interface{}, statuses ...pb.Enrollment_Us
 			pb.Enrollment_PENDING,
 			pb.Enrollment_STUDENT,
 			pb.Enrollment_TEACHER,
+			pb.Enrollment_TA,
 		}
 	}
 	var enrollments []*pb.Enrollment
@@ -91,17 +95,99 @@ func (db *GormDB) getEnrollments(model interface{}, statuses ...pb.Enrollment_Us
 	return enrollments, nil
 }
 
-// UpdateSlipDays updates used slip days for the given course enrollment
+// UpdateSlipDays updates used slip days for the given course enrollment,
+// and records any changes in the slip day ledger.
 func (db *GormDB) UpdateSlipDays(usedSlipDays []*pb.UsedSlipDays) error {
+	tx := db.conn.Begin()
 	for _, slipDaysForAssignment := range usedSlipDays {
-		if err := db.updateSlipDays(slipDaysForAssignment); err != nil {
+		if err := updateSlipDays(tx, slipDaysForAssignment); err != nil {
+			tx.Rollback()
 			return err
 		}
 	}
-	return nil
+	return tx.Commit().Error
+}
+
+// updateSlipdays updates or creates UsedSlipDays record with the query's automatic slip days,
+// and records the change in the slip day ledger.
+// The used slip days are computed from the stored manual adjustment, so that
+// a teacher's concurrent manual adjustment is kept.
+func updateSlipDays(tx *gorm.DB, query *pb.UsedSlipDays) error {
+	var previous pb.UsedSlipDays
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&pb.UsedSlipDays{
+		EnrollmentID: query.EnrollmentID,
+		AssignmentID: query.AssignmentID,
+	}).FirstOrInit(&previous).Error; err != nil {
+		return err
+	}
+	query.Adjustment = previous.Adjustment
+	query.UsedSlipDays = previous.Adjusted(query.AutomaticSlipDays)
+	if previous.ID == 0 {
+		if err := tx.Create(query).Error; err != nil {
+			return err
+		}
+	} else {
+		query.ID = previous.ID
+		if err := tx.Model(&pb.UsedSlipDays{ID: previous.ID}).Updates(map[string]interface{}{
+			"automatic_slip_days": query.AutomaticSlipDays,
+			"used_slip_days":      query.UsedSlipDays,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	change := int32(query.UsedSlipDays) - int32(previous.UsedSlipDays)
+	if change == 0 {
+		return nil
+	}
+	return tx.Create(&pb.SlipDayLedgerEntry{
+		EnrollmentID: query.EnrollmentID,
+		AssignmentID: query.AssignmentID,
+		Source:       pb.SlipDayLedgerEntry_AUTOMATIC,
+		Change:       change,
+		UsedSlipDays: query.UsedSlipDays,
+		Date:         time.Now().Format(pb.TimeLayout),
+	}).Error
 }
 
-// updateSlipdays updates or creates UsedSlipDays record
-func (db *GormDB) updateSlipDays(query *pb.UsedSlipDays) error {
-	return db.conn.Save(query).Error
+// AdjustSlipDays adjusts the slip days used by the entry's enrollment for the entry's assignment,
+// and records the manual adjustment in the slip day ledger.
+func (db *GormDB) AdjustSlipDays(entry *pb.SlipDayLedgerEntry) error {
+	tx := db.conn.Begin()
+	var used pb.UsedSlipDays
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&pb.UsedSlipDays{
+		EnrollmentID: entry.EnrollmentID,
+		AssignmentID: entry.AssignmentID,
+	}).FirstOrInit(&used).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if int64(used.UsedSlipDays)+int64(entry.Change) < 0 {
+		tx.Rollback()
+		return ErrNegativeSlipDays
+	}
+	used.Adjustment += entry.Change
+	used.UsedSlipDays = used.Adjusted(used.AutomaticSlipDays)
+	if err := tx.Save(&used).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	entry.Source = pb.SlipDayLedgerEntry_MANUAL
+	entry.UsedSlipDays = used.UsedSlipDays
+	entry.Date = time.Now().Format(pb.TimeLayout)
+	if err := tx.Create(entry).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// GetSlipDayLedger returns the slip day ledger entries for the given enrollment, oldest first.
+func (db *GormDB) GetSlipDayLedger(enrollmentID uint64) ([]*pb.SlipDayLedgerEntry, error) {
+	var entries []*pb.SlipDayLedgerEntry
+	if err := db.conn.Where(&pb.SlipDayLedgerEntry{EnrollmentID: enrollmentID}).
+		Order("id").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
 }
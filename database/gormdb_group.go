@@ -3,9 +3,11 @@ package database
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateGroup creates a new group and assign users to newly created group.
@@ -27,7 +29,12 @@ func (db *GormDB) CreateGroup(group *pb.Group) error {
 	}
 
 	tx := db.conn.Begin()
-	if err := tx.Model(&pb.Group{}).Create(group).Error; err != nil {
+	create := tx.Model(&pb.Group{})
+	if group.GroupSet != "" {
+		// the members of groups in a group set are not recorded in their enrollments
+		create = create.Omit("Enrollments")
+	}
+	if err := create.Create(group).Error; err != nil {
 		tx.Rollback()
 		if strings.HasPrefix(err.Error(), "UNIQUE constraint failed") {
 			return ErrDuplicateGroup
@@ -39,18 +46,13 @@ func (db *GormDB) CreateGroup(group *pb.Group) error {
 	for _, u := range group.Users {
 		userids = append(userids, u.ID)
 	}
-	query := tx.Model(&pb.Enrollment{}).
-		Where(&pb.Enrollment{CourseID: group.CourseID}).
-		Where("user_id IN (?) AND status IN (?)", userids,
-			[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
-		Updates(&pb.Enrollment{GroupID: group.ID})
-	if query.Error != nil {
+	if err := setGroupMembers(tx, group, userids); err != nil {
 		tx.Rollback()
-		return query.Error
+		return err
 	}
-	if query.RowsAffected != int64(len(userids)) {
+	if err := updateGroupMemberships(tx, group.ID, nil, userids); err != nil {
 		tx.Rollback()
-		return ErrUpdateGroup
+		return err
 	}
 
 	tx.Commit()
@@ -72,15 +74,28 @@ func (db *GormDB) UpdateGroup(group *pb.Group) error {
 		return gorm.ErrRecordNotFound
 	}
 
+	// a group cannot be moved to another group set
+	var stored pb.Group
+	if err := db.conn.First(&stored, group.ID).Error; err != nil {
+		return err
+	}
+	group.GroupSet = stored.GroupSet
+
 	tx := db.conn.Begin()
-	if err := tx.Model(group).Updates(group).Error; err != nil {
+	update := tx.Model(group)
+	if group.GroupSet != "" {
+		// the members of groups in a group set are not recorded in their enrollments
+		update = update.Omit(clause.Associations)
+	}
+	if err := update.Updates(group).Error; err != nil {
 		tx.Rollback()
 		if strings.HasPrefix(err.Error(), "UNIQUE constraint failed") {
 			return ErrDuplicateGroup
 		}
 		return err
 	}
-	if err := tx.Exec("UPDATE enrollments SET group_id= ? WHERE group_id= ?", 0, group.ID).Error; err != nil {
+	members, err := groupMembers(tx, group)
+	if err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -89,18 +104,13 @@ func (db *GormDB) UpdateGroup(group *pb.Group) error {
 	for _, u := range group.Users {
 		userids = append(userids, u.ID)
 	}
-	query := tx.Model(&pb.Enrollment{}).
-		Where(&pb.Enrollment{CourseID: group.CourseID}).
-		Where("user_id IN (?) AND status IN (?)", userids,
-			[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
-		Updates(&pb.Enrollment{GroupID: group.ID})
-	if query.Error != nil {
+	if err := setGroupMembers(tx, group, userids); err != nil {
 		tx.Rollback()
-		return query.Error
+		return err
 	}
-	if query.RowsAffected != int64(len(userids)) {
+	if err := updateGroupMemberships(tx, group.ID, members, userids); err != nil {
 		tx.Rollback()
-		return ErrUpdateGroup
+		return err
 	}
 
 	tx.Commit()
@@ -119,6 +129,11 @@ func (db *GormDB) DeleteGroup(groupID uint64) error {
 		return err
 	}
 
+	var members []uint64
+	for _, user := range group.Users {
+		members = append(members, user.GetID())
+	}
+
 	tx := db.conn.Begin()
 	if err := tx.Delete(group).Error; err != nil {
 		tx.Rollback()
@@ -128,6 +143,14 @@ func (db *GormDB) DeleteGroup(groupID uint64) error {
 		tx.Rollback()
 		return err
 	}
+	if err := tx.Exec("DELETE FROM group_users WHERE group_id= ?", groupID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := updateGroupMemberships(tx, groupID, members, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
 	tx.Commit()
 	return nil
 }
@@ -141,21 +164,8 @@ func (db *GormDB) GetGroup(groupID uint64) (*pb.Group, error) {
 		}
 		return nil, fmt.Errorf("error fetching group record for group with ID %d: %w", groupID, err)
 	}
-	var userIds []uint64
-	for _, enrollment := range group.Enrollments {
-		userIds = append(userIds, enrollment.UserID)
-		u, err := db.GetUser(enrollment.UserID)
-		if err != nil {
-			return nil, err
-		}
-		enrollment.User = u
-	}
-	if len(userIds) > 0 {
-		users, err := db.GetUsers(userIds...)
-		if err != nil {
-			return nil, err
-		}
-		group.Users = users
+	if err := db.loadGroupMembers(&group); err != nil {
+		return nil, err
 	}
 	return &group, nil
 }
@@ -179,22 +189,190 @@ func (db *GormDB) GetGroupsByCourse(courseID uint64, statuses ...pb.Group_GroupS
 	}
 
 	for _, group := range groups {
-		var userIds []uint64
-		for _, enrollment := range group.Enrollments {
-			userIds = append(userIds, enrollment.UserID)
-			u, err := db.GetUser(enrollment.UserID)
-			if err != nil {
-				return nil, err
+		if err := db.loadGroupMembers(group); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// GetGroupInSet returns the user's group in the given group set of the course.
+// An empty group set gives the user's group among the course's default groups.
+func (db *GormDB) GetGroupInSet(courseID, userID uint64, groupSet string) (*pb.Group, error) {
+	var groupIDs []uint64
+	if groupSet == "" {
+		if err := db.conn.Model(&pb.Enrollment{}).
+			Where(&pb.Enrollment{CourseID: courseID, UserID: userID}).
+			Where("group_id > ?", 0).
+			Pluck("group_id", &groupIDs).Error; err != nil {
+			return nil, err
+		}
+	} else {
+		if err := db.conn.Table("group_users").
+			Joins("JOIN `groups` ON `groups`.id = group_users.group_id").
+			Where("`groups`.course_id = ? AND `groups`.group_set = ? AND group_users.user_id = ?", courseID, groupSet, userID).
+			Pluck("`groups`.id", &groupIDs).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(groupIDs) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return db.GetGroup(groupIDs[0])
+}
+
+// loadGroupMembers sets the users of the given group, and the enrollments of its users.
+// The members of the course's default groups are recorded in their enrollments,
+// while the members of groups in a group set are recorded in the group_users table,
+// since a user may be a member of one group in each of the course's group sets.
+func (db *GormDB) loadGroupMembers(group *pb.Group) error {
+	if group.GroupSet != "" {
+		userIDs, err := groupMembers(db.conn, group)
+		if err != nil {
+			return err
+		}
+		group.Enrollments = nil
+		if len(userIDs) > 0 {
+			if err := db.conn.Preload("UsedSlipDays").
+				Where("course_id = ? AND user_id IN (?)", group.CourseID, userIDs).
+				Find(&group.Enrollments).Error; err != nil {
+				return err
 			}
-			enrollment.User = u
 		}
-		if len(userIds) > 0 {
-			users, err := db.GetUsers(userIds...)
-			if err != nil {
-				return nil, err
+	}
+	var userIds []uint64
+	for _, enrollment := range group.Enrollments {
+		userIds = append(userIds, enrollment.UserID)
+		u, err := db.GetUser(enrollment.UserID)
+		if err != nil {
+			return err
+		}
+		enrollment.User = u
+	}
+	group.Users = nil
+	if len(userIds) > 0 {
+		users, err := db.GetUsers(userIds...)
+		if err != nil {
+			return err
+		}
+		group.Users = users
+	}
+	return nil
+}
+
+// groupMembers returns the IDs of the users that are members of the given group.
+func groupMembers(tx *gorm.DB, group *pb.Group) ([]uint64, error) {
+	var members []uint64
+	if group.GroupSet == "" {
+		err := tx.Model(&pb.Enrollment{}).Where(&pb.Enrollment{GroupID: group.ID}).Pluck("user_id", &members).Error
+		return members, err
+	}
+	err := tx.Table("group_users").Where("group_id = ?", group.ID).Pluck("user_id", &members).Error
+	return members, err
+}
+
+// setGroupMembers makes the given users, which must be accepted into the group's course,
+// the members of the group, replacing its previous members.
+// The users cannot be members of another group in the group's group set.
+func setGroupMembers(tx *gorm.DB, group *pb.Group, userids []uint64) error {
+	if group.GroupSet == "" {
+		if err := tx.Exec("UPDATE enrollments SET group_id= ? WHERE group_id= ?", 0, group.ID).Error; err != nil {
+			return err
+		}
+		query := tx.Model(&pb.Enrollment{}).
+			Where(&pb.Enrollment{CourseID: group.CourseID}).
+			Where("user_id IN (?) AND status IN (?)", userids,
+				[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
+			Updates(&pb.Enrollment{GroupID: group.ID})
+		if query.Error != nil {
+			return query.Error
+		}
+		if query.RowsAffected != int64(len(userids)) {
+			return ErrUpdateGroup
+		}
+		return nil
+	}
+
+	var enrolled int64
+	if err := tx.Model(&pb.Enrollment{}).
+		Where(&pb.Enrollment{CourseID: group.CourseID}).
+		Where("user_id IN (?) AND status IN (?)", userids,
+			[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
+		Count(&enrolled).Error; err != nil {
+		return err
+	}
+	if enrolled != int64(len(userids)) {
+		return ErrUpdateGroup
+	}
+	var grouped int64
+	if err := tx.Table("group_users").
+		Joins("JOIN `groups` ON `groups`.id = group_users.group_id").
+		Where("`groups`.course_id = ? AND `groups`.group_set = ? AND `groups`.id <> ? AND group_users.user_id IN (?)",
+			group.CourseID, group.GroupSet, group.ID, userids).
+		Count(&grouped).Error; err != nil {
+		return err
+	}
+	if grouped > 0 {
+		return ErrGroupSetMember
+	}
+	if err := tx.Exec("DELETE FROM group_users WHERE group_id= ?", group.ID).Error; err != nil {
+		return err
+	}
+	for _, userID := range userids {
+		if err := tx.Exec("INSERT INTO group_users (group_id, user_id) VALUES (?, ?)", group.ID, userID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGroupMemberships returns the membership history of the given group, in the order the users joined the group.
+func (db *GormDB) GetGroupMemberships(groupID uint64) ([]*pb.GroupMembership, error) {
+	var memberships []*pb.GroupMembership
+	if err := db.conn.Preload("User").
+		Where(&pb.GroupMembership{GroupID: groupID}).
+		Order("id").
+		Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// updateGroupMemberships records that the users that were members of the group before, but not after,
+// have left the group, and that the users that are members after, but not before, have joined the group.
+func updateGroupMemberships(tx *gorm.DB, groupID uint64, before, after []uint64) error {
+	now := time.Now().Format(pb.TimeLayout)
+	wasMember, isMember := make(map[uint64]bool), make(map[uint64]bool)
+	for _, userID := range before {
+		wasMember[userID] = true
+	}
+	for _, userID := range after {
+		isMember[userID] = true
+	}
+	for _, userID := range before {
+		if isMember[userID] {
+			continue
+		}
+		query := tx.Model(&pb.GroupMembership{}).
+			Where("group_id = ? AND user_id = ? AND left_date = ?", groupID, userID, "").
+			Update("left_date", now)
+		if query.Error != nil {
+			return query.Error
+		}
+		if query.RowsAffected == 0 {
+			// the user joined the group before membership history was recorded
+			if err := tx.Create(&pb.GroupMembership{GroupID: groupID, UserID: userID, LeftDate: now}).Error; err != nil {
+				return err
 			}
-			group.Users = users
 		}
 	}
-	return groups, nil
+	for _, userID := range after {
+		if wasMember[userID] {
+			continue
+		}
+		if err := tx.Create(&pb.GroupMembership{GroupID: groupID, UserID: userID, JoinedDate: now}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
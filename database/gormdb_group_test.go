@@ -364,3 +364,183 @@ func TestGetGroupsByCourse(t *testing.T) {
 		t.Errorf("Expected one pending and one approved group, got %d pending, %d approved", len(pendingGroups), len(approvedGroups))
 	}
 }
+
+func TestGroupMemberships(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 10)
+	course := &pb.Course{CourseCreatorID: teacher.ID}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var users []*pb.User
+	for i := uint64(1); i <= 3; i++ {
+		user := qtest.CreateFakeUser(t, db, i)
+		qtest.EnrollStudent(t, db, user, course)
+		users = append(users, user)
+	}
+
+	type membership struct {
+		userID       uint64
+		joined, left bool
+	}
+	checkMemberships := func(groupID uint64, want []membership) {
+		t.Helper()
+		memberships, err := db.GetGroupMemberships(groupID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []membership
+		for _, m := range memberships {
+			if m.GetUser().GetID() != m.GetUserID() {
+				t.Errorf("membership of user %d has user %d", m.GetUserID(), m.GetUser().GetID())
+			}
+			got = append(got, membership{m.GetUserID(), m.GetJoinedDate() != "", m.GetLeftDate() != ""})
+		}
+		if diff := cmp.Diff(want, got, cmp.AllowUnexported(membership{})); diff != "" {
+			t.Errorf("GetGroupMemberships() mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: users[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	checkMemberships(group.ID, []membership{
+		{users[0].ID, true, false},
+		{users[1].ID, true, false},
+	})
+
+	// the second user leaves, and the third user joins the group
+	if err := db.UpdateGroup(&pb.Group{ID: group.ID, Name: group.Name, CourseID: course.ID, Users: []*pb.User{users[0], users[2]}}); err != nil {
+		t.Fatal(err)
+	}
+	checkMemberships(group.ID, []membership{
+		{users[0].ID, true, false},
+		{users[1].ID, true, true},
+		{users[2].ID, true, false},
+	})
+
+	if err := db.DeleteGroup(group.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkMemberships(group.ID, []membership{
+		{users[0].ID, true, true},
+		{users[1].ID, true, true},
+		{users[2].ID, true, true},
+	})
+}
+
+func TestGroupSets(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 10)
+	course := &pb.Course{CourseCreatorID: teacher.ID}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var users []*pb.User
+	for i := uint64(1); i <= 3; i++ {
+		user := qtest.CreateFakeUser(t, db, i)
+		qtest.EnrollStudent(t, db, user, course)
+		users = append(users, user)
+	}
+	userIDs := func(group *pb.Group) []uint64 {
+		var ids []uint64
+		for _, user := range group.GetUsers() {
+			ids = append(ids, user.GetID())
+		}
+		return ids
+	}
+
+	// the users can be members of one group in each group set
+	defaultGroup := &pb.Group{Name: "default", CourseID: course.ID, Users: users[:2]}
+	if err := db.CreateGroup(defaultGroup); err != nil {
+		t.Fatal(err)
+	}
+	projectGroup := &pb.Group{Name: "project", CourseID: course.ID, GroupSet: "project", Users: users[1:]}
+	if err := db.CreateGroup(projectGroup); err != nil {
+		t.Fatal(err)
+	}
+	err := db.CreateGroup(&pb.Group{Name: "project-2", CourseID: course.ID, GroupSet: "project", Users: users[:2]})
+	if !errors.Is(err, database.ErrGroupSetMember) {
+		t.Errorf("CreateGroup() with a member of another group in the group set returned %v, want %v", err, database.ErrGroupSetMember)
+	}
+
+	for _, test := range []struct {
+		user     *pb.User
+		groupSet string
+		want     uint64
+	}{
+		{users[0], "", defaultGroup.ID},
+		{users[0], "project", 0},
+		{users[1], "", defaultGroup.ID},
+		{users[1], "project", projectGroup.ID},
+		{users[2], "", 0},
+		{users[2], "project", projectGroup.ID},
+		{users[2], "other", 0},
+	} {
+		group, err := db.GetGroupInSet(course.ID, test.user.ID, test.groupSet)
+		switch {
+		case test.want == 0 && err != gorm.ErrRecordNotFound:
+			t.Errorf("GetGroupInSet(%d, %q) = %v, %v, want %v", test.user.ID, test.groupSet, group, err, gorm.ErrRecordNotFound)
+		case test.want > 0 && (err != nil || group.GetID() != test.want):
+			t.Errorf("GetGroupInSet(%d, %q) = %v, %v, want group %d", test.user.ID, test.groupSet, group, err, test.want)
+		}
+	}
+
+	// the members of groups in a group set are not recorded in their enrollments
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, users[2].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetGroupID() != 0 {
+		t.Errorf("enrollment of user %d has group %d, want 0", users[2].ID, enrollment.GetGroupID())
+	}
+	group, err := db.GetGroup(projectGroup.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]uint64{users[1].ID, users[2].ID}, userIDs(group)); diff != "" {
+		t.Errorf("GetGroup() users mismatch (-want +got):\n%s", diff)
+	}
+	if len(group.GetEnrollments()) != 2 {
+		t.Errorf("GetGroup() returned %d enrollments, want 2", len(group.GetEnrollments()))
+	}
+
+	// the group stays in its group set when updated
+	if err := db.UpdateGroup(&pb.Group{ID: projectGroup.ID, Name: projectGroup.Name, CourseID: course.ID, Users: users[:1]}); err != nil {
+		t.Fatal(err)
+	}
+	groups, err := db.GetGroupsByCourse(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, group := range groups {
+		want := []uint64{users[0].ID, users[1].ID}
+		if group.GetID() == projectGroup.ID {
+			if group.GetGroupSet() != "project" {
+				t.Errorf("group %d has group set %q, want %q", group.GetID(), group.GetGroupSet(), "project")
+			}
+			want = []uint64{users[0].ID}
+		}
+		if diff := cmp.Diff(want, userIDs(group)); diff != "" {
+			t.Errorf("GetGroupsByCourse() users of group %d mismatch (-want +got):\n%s", group.GetID(), diff)
+		}
+	}
+	if _, err := db.GetGroupInSet(course.ID, users[2].ID, "project"); err != gorm.ErrRecordNotFound {
+		t.Errorf("GetGroupInSet() for removed member returned %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	if err := db.DeleteGroup(projectGroup.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetGroupInSet(course.ID, users[0].ID, "project"); err != gorm.ErrRecordNotFound {
+		t.Errorf("GetGroupInSet() for deleted group returned %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if group, err := db.GetGroupInSet(course.ID, users[0].ID, ""); err != nil || group.GetID() != defaultGroup.ID {
+		t.Errorf("GetGroupInSet() for default group = %v, %v, want group %d", group, err, defaultGroup.ID)
+	}
+}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// CreateOAuthState creates a new OAuth state for a login in progress.
+func (db *GormDB) CreateOAuthState(state *pb.OAuthState) error {
+	return db.conn.Create(state).Error
+}
+
+// ConsumeOAuthState returns and deletes the OAuth state with the given hash.
+// Only one of several concurrent calls for the same state succeeds.
+func (db *GormDB) ConsumeOAuthState(hash string) (*pb.OAuthState, error) {
+	var state pb.OAuthState
+	if err := db.conn.Where(&pb.OAuthState{Hash: hash}).First(&state).Error; err != nil {
+		return nil, err
+	}
+	tx := db.conn.Where(&pb.OAuthState{ID: state.ID}).Delete(&pb.OAuthState{})
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &state, nil
+}
+
+// DeleteExpiredOAuthStates deletes the OAuth states that expired before the given date.
+func (db *GormDB) DeleteExpiredOAuthStates(date string) error {
+	return db.conn.Where("expiry_date < ?", date).Delete(&pb.OAuthState{}).Error
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// UpdateReleaseSchedule creates or replaces the release schedule for the schedule's assignment.
+func (db *GormDB) UpdateReleaseSchedule(schedule *pb.ReleaseSchedule) error {
+	return db.conn.
+		Where(pb.ReleaseSchedule{AssignmentID: schedule.GetAssignmentID()}).
+		Assign(map[string]interface{}{
+			"course_id":           schedule.GetCourseID(),
+			"release_time":        schedule.GetReleaseTime(),
+			"days_after_deadline": schedule.GetDaysAfterDeadline(),
+			"score_limit":         schedule.GetScoreLimit(),
+			"approve":             schedule.GetApprove(),
+			"released_date":       schedule.GetReleasedDate(),
+		}).FirstOrCreate(schedule).Error
+}
+
+// GetReleaseSchedules returns the release schedules for the given course.
+func (db *GormDB) GetReleaseSchedules(courseID uint64) ([]*pb.ReleaseSchedule, error) {
+	var schedules []*pb.ReleaseSchedule
+	if err := db.conn.Where(&pb.ReleaseSchedule{CourseID: courseID}).Order("assignment_id").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// GetPendingReleases returns the release schedules for all courses that have not yet been released.
+func (db *GormDB) GetPendingReleases() ([]*pb.ReleaseSchedule, error) {
+	var schedules []*pb.ReleaseSchedule
+	if err := db.conn.Where("released_date = ?", "").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// DeleteReleaseSchedule removes the release schedule for the given assignment.
+func (db *GormDB) DeleteReleaseSchedule(assignmentID uint64) error {
+	return db.conn.Where(&pb.ReleaseSchedule{AssignmentID: assignmentID}).Delete(&pb.ReleaseSchedule{}).Error
+}
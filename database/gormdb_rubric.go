@@ -0,0 +1,72 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// CreateRubricTemplate creates a new version of the given rubric template with its benchmarks and criteria.
+// The version is one above the latest version of the course's template with the same name.
+func (db *GormDB) CreateRubricTemplate(template *pb.RubricTemplate) error {
+	tx := db.conn.Begin()
+	var latest pb.RubricTemplate
+	if err := tx.Where(&pb.RubricTemplate{CourseID: template.GetCourseID(), Name: template.GetName()}).
+		Order("version desc").
+		Limit(1).
+		Find(&latest).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	template.ID = 0
+	template.Version = latest.GetVersion() + 1
+	for _, bm := range template.GetBenchmarks() {
+		bm.ID, bm.AssignmentID, bm.ReviewID = 0, 0, 0
+		for _, c := range bm.GetCriteria() {
+			c.ID, c.BenchmarkID, c.Grade = 0, 0, pb.GradingCriterion_NONE
+		}
+	}
+	if err := tx.Create(template).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// GetRubricTemplate returns the rubric template with the given ID, including its benchmarks and criteria.
+func (db *GormDB) GetRubricTemplate(templateID uint64) (*pb.RubricTemplate, error) {
+	var template pb.RubricTemplate
+	if err := db.conn.Preload("Benchmarks").
+		Preload("Benchmarks.Criteria").
+		First(&template, templateID).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// GetRubricTemplates returns all versions of the given course's rubric templates and of
+// the rubric templates shared by other courses, ordered by name and version.
+func (db *GormDB) GetRubricTemplates(courseID uint64) ([]*pb.RubricTemplate, error) {
+	var templates []*pb.RubricTemplate
+	if err := db.conn.Preload("Benchmarks").
+		Preload("Benchmarks.Criteria").
+		Where("course_id = ? OR shared = ?", courseID, true).
+		Order("name").
+		Order("version").
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// UpdateAssignmentRubric records the rubric template that the given assignment's benchmarks were loaded from.
+func (db *GormDB) UpdateAssignmentRubric(assignmentID, templateID uint64) error {
+	return db.conn.Model(&pb.Assignment{ID: assignmentID}).Update("rubric_template_id", templateID).Error
+}
+
+// UpdateRubric runs the given update of an assignment's rubric, and of the assignment's reviews,
+// in a single transaction. If the update fails, none of its changes are stored.
+func (db *GormDB) UpdateRubric(update func(tx Database) error) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		return update(&GormDB{conn: tx})
+	})
+}
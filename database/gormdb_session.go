@@ -0,0 +1,38 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// CreateSession creates a new session.
+func (db *GormDB) CreateSession(session *pb.Session) error {
+	return db.conn.Create(session).Error
+}
+
+// GetSession returns the session matching the given query.
+func (db *GormDB) GetSession(query *pb.Session) (*pb.Session, error) {
+	var session pb.Session
+	if err := db.conn.Where(query).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// GetSessions returns the sessions of the given user, oldest first.
+func (db *GormDB) GetSessions(userID uint64) ([]*pb.Session, error) {
+	var sessions []*pb.Session
+	if err := db.conn.Where(&pb.Session{UserID: userID}).Order("id").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// DeleteSessions deletes the sessions matching the given query.
+func (db *GormDB) DeleteSessions(query *pb.Session) error {
+	return db.conn.Where(query).Delete(&pb.Session{}).Error
+}
+
+// DeleteExpiredSessions deletes the sessions that expired before the given date.
+func (db *GormDB) DeleteExpiredSessions(date string) error {
+	return db.conn.Where("expiry_date < ?", date).Delete(&pb.Session{}).Error
+}
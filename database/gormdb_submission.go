@@ -3,6 +3,7 @@ package database
 import (
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateSubmission creates a new submission record or updates the most
@@ -82,6 +83,7 @@ func (db *GormDB) GetSubmission(query *pb.Submission) (*pb.Submission, error) {
 		Preload("Scores").
 		Preload("Reviews.GradingBenchmarks").
 		Preload("Reviews.GradingBenchmarks.Criteria").
+		Preload("Reviews.CodeComments").
 		Where(query).Last(&submission).Error; err != nil {
 		return nil, err
 	}
@@ -152,7 +154,12 @@ func (db *GormDB) CreateReview(query *pb.Review) error {
 
 // UpdateReview updates feedback text, review and ready status
 func (db *GormDB) UpdateReview(query *pb.Review) error {
-	return db.conn.Model(&pb.Review{ID: query.ID}).Updates(&pb.Review{
+	// select fields to allow feedback to be cleared and ready status to be withdrawn
+	fields := []string{"feedback", "ready", "score", "edited"}
+	if query.ReviewerID > 0 {
+		fields = append(fields, "reviewer_id")
+	}
+	return db.conn.Model(&pb.Review{ID: query.ID}).Select(fields).Updates(&pb.Review{
 		Feedback:   query.Feedback,
 		Ready:      query.Ready,
 		Score:      query.Score,
@@ -161,6 +168,130 @@ func (db *GormDB) UpdateReview(query *pb.Review) error {
 	}).Error
 }
 
+// GetReview returns the review matching the given query.
+func (db *GormDB) GetReview(query *pb.Review) (*pb.Review, error) {
+	var review pb.Review
+	if err := db.conn.Preload("GradingBenchmarks").
+		Preload("GradingBenchmarks.Criteria").
+		Preload("CodeComments").
+		Where(query).First(&review).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// CreateCodeComment creates the given code comment on a review.
+func (db *GormDB) CreateCodeComment(comment *pb.CodeComment) error {
+	return db.conn.Create(comment).Error
+}
+
+// GetCodeComments returns the code comments matching the query, ordered by file path and line.
+func (db *GormDB) GetCodeComments(query *pb.CodeComment) ([]*pb.CodeComment, error) {
+	var comments []*pb.CodeComment
+	if err := db.conn.Where(query).Order("path").Order("start_line").Order("id").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// UpdateGradeOverride replaces any existing override of the same criterion for the submission
+// with the given grade override. An override with a NONE grade removes the existing override.
+func (db *GormDB) UpdateGradeOverride(override *pb.GradeOverride) error {
+	tx := db.conn.Begin()
+	if err := tx.Where(&pb.GradeOverride{
+		SubmissionID: override.GetSubmissionID(),
+		Heading:      override.GetHeading(),
+		Description:  override.GetDescription(),
+	}).Delete(&pb.GradeOverride{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if override.GetGrade() != pb.GradingCriterion_NONE {
+		override.ID = 0
+		if err := tx.Create(override).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// GetGradeOverrides returns the lead teacher's grade overrides for the given submission.
+func (db *GormDB) GetGradeOverrides(submissionID uint64) ([]*pb.GradeOverride, error) {
+	var overrides []*pb.GradeOverride
+	if err := db.conn.Where(&pb.GradeOverride{SubmissionID: submissionID}).Find(&overrides).Error; err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// UpdatePeerReviewFlags updates the outlier and excluded flags of the given peer review.
+func (db *GormDB) UpdatePeerReviewFlags(query *pb.Review) error {
+	return db.conn.Model(&pb.Review{ID: query.ID}).
+		Select("outlier", "excluded").
+		Updates(&pb.Review{
+			Outlier:  query.Outlier,
+			Excluded: query.Excluded,
+		}).Error
+}
+
+// AssignPeerReviewers creates the peer reviewer assignments returned by the assign function
+// for the given assignment. The assign function is called with the assignment's existing peer
+// reviewer assignments, and both run in a single transaction. Assignments that already exist
+// are skipped, so that concurrent calls never assign the same reviewer to a submission twice.
+func (db *GormDB) AssignPeerReviewers(assignmentID uint64, assign func(assigned []*pb.PeerReviewer) []*pb.PeerReviewer) error {
+	tx := db.conn.Begin()
+	var assigned []*pb.PeerReviewer
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&pb.PeerReviewer{AssignmentID: assignmentID}).
+		Order("id").Find(&assigned).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	peerReviewers := assign(assigned)
+	if len(peerReviewers) > 0 {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(peerReviewers).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// GetPeerReviewers returns the peer reviewer assignments matching the query.
+func (db *GormDB) GetPeerReviewers(query *pb.PeerReviewer) ([]*pb.PeerReviewer, error) {
+	var peerReviewers []*pb.PeerReviewer
+	if err := db.conn.Where(query).Order("id").Find(&peerReviewers).Error; err != nil {
+		return nil, err
+	}
+	return peerReviewers, nil
+}
+
+// UpdateReviewTasks replaces the review tasks for the given assignment with the given tasks.
+func (db *GormDB) UpdateReviewTasks(assignmentID uint64, tasks []*pb.ReviewTask) error {
+	tx := db.conn.Begin()
+	if err := tx.Where(&pb.ReviewTask{AssignmentID: assignmentID}).Delete(&pb.ReviewTask{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if len(tasks) > 0 {
+		if err := tx.Create(tasks).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// GetReviewTasks returns the review tasks matching the query.
+func (db *GormDB) GetReviewTasks(query *pb.ReviewTask) ([]*pb.ReviewTask, error) {
+	var tasks []*pb.ReviewTask
+	if err := db.conn.Where(query).Order("id").Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
 // DeleteReview removes all reviews matching the query
 func (db *GormDB) DeleteReview(query *pb.Review) error {
 	return db.conn.Delete(&pb.Review{}, &query).Error
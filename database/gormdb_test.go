@@ -404,10 +404,10 @@ func TestGormDBGetCoursesByUser(t *testing.T) {
 	}
 
 	wantCourses := []*pb.Course{
-		{ID: c1.ID, OrganizationID: 1, Enrolled: pb.Enrollment_PENDING},
-		{ID: c2.ID, OrganizationID: 2, Enrolled: pb.Enrollment_NONE},
-		{ID: c3.ID, OrganizationID: 3, Enrolled: pb.Enrollment_STUDENT},
-		{ID: c4.ID, OrganizationID: 4, Enrolled: pb.Enrollment_NONE},
+		{ID: c1.ID, OrganizationID: 1, Enrolled: pb.Enrollment_PENDING, GracePeriod: 120},
+		{ID: c2.ID, OrganizationID: 2, Enrolled: pb.Enrollment_NONE, GracePeriod: 120},
+		{ID: c3.ID, OrganizationID: 3, Enrolled: pb.Enrollment_STUDENT, GracePeriod: 120},
+		{ID: c4.ID, OrganizationID: 4, Enrolled: pb.Enrollment_NONE, GracePeriod: 120},
 	}
 	if !reflect.DeepEqual(courses, wantCourses) {
 		t.Errorf("have course %+v want %+v", courses, wantCourses)
@@ -615,6 +615,9 @@ func TestGormDBCreateCourse(t *testing.T) {
 	if course.ID == 0 {
 		t.Error("expected id to be set")
 	}
+	if course.GracePeriod != 120 {
+		t.Errorf("expected default grace period of 120 minutes, but got %d", course.GracePeriod)
+	}
 
 	// check that admin (teacher) was automatically enrolled when creating course
 	enroll, err := db.GetEnrollmentByCourseAndUser(course.ID, admin.ID)
@@ -753,6 +756,10 @@ func TestGormDBUpdateCourse(t *testing.T) {
 		Tag:            "Autumn",
 		Provider:       "gitlab",
 		OrganizationID: 12345,
+		GracePeriod:    30,
+		SlipDayUnit:    pb.Course_HOURS,
+		SlipDaysCap:    48,
+		GroupSlipDays:  pb.Course_SHARED,
 	}
 
 	db, cleanup := qtest.TestDB(t)
@@ -779,6 +786,60 @@ func TestGormDBUpdateCourse(t *testing.T) {
 	}
 }
 
+func TestGormDBUpdateCourseResetSettings(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 10)
+	course := &pb.Course{Name: "Test Course", Code: "DAT100", Year: 2017, Tag: "Spring", Provider: "github", OrganizationID: 1234}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+
+	course.GracePeriod = 30
+	course.SlipDayUnit = pb.Course_HOURS
+	course.SlipDaysCap = 48
+	course.GroupSlipDays = pb.Course_SHARED
+	course.SubmissionMode = pb.Course_PULL_REQUEST
+	course.SubmissionTrigger = pb.Course_TAG
+	course.MinGroupSize = 2
+	course.MaxGroupSize = 4
+	course.GroupDeadline = "2021-01-01T12:00:00"
+	course.GroupFreezeDate = "2021-02-01T12:00:00"
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	updatedCourse, err := db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(updatedCourse, course) {
+		t.Errorf("have course %+v want %+v", updatedCourse, course)
+	}
+
+	// settings can be reset to their zero values
+	course.GracePeriod = 0
+	course.SlipDayUnit = pb.Course_DAYS
+	course.SlipDaysCap = 0
+	course.GroupSlipDays = pb.Course_PER_MEMBER
+	course.SubmissionMode = pb.Course_PUSH
+	course.SubmissionTrigger = pb.Course_CHANGED_FILES
+	course.MinGroupSize = 0
+	course.MaxGroupSize = 0
+	course.GroupDeadline = ""
+	course.GroupFreezeDate = ""
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	updatedCourse, err = db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(updatedCourse, course) {
+		t.Errorf("have course %+v want %+v", updatedCourse, course)
+	}
+}
+
 func TestGormDBGetEmptyRepo(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
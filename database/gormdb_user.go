@@ -99,3 +99,37 @@ func (db *GormDB) UpdateUser(user *pb.User) error {
 	}
 	return db.conn.Save(&user).Error
 }
+
+// UpdateNotificationOptOuts replaces the notification categories that the given user has opted out of.
+func (db *GormDB) UpdateNotificationOptOuts(userID uint64, categories []pb.NotificationOptOut_Category) error {
+	tx := db.conn.Begin()
+	if err := tx.Where("user_id = ?", userID).Delete(&pb.NotificationOptOut{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	seen := make(map[pb.NotificationOptOut_Category]bool)
+	for _, category := range categories {
+		if seen[category] {
+			continue
+		}
+		seen[category] = true
+		if err := tx.Create(&pb.NotificationOptOut{UserID: userID, Category: category}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// GetNotificationOptOuts returns the notification categories that the given user has opted out of.
+func (db *GormDB) GetNotificationOptOuts(userID uint64) ([]pb.NotificationOptOut_Category, error) {
+	var optOuts []*pb.NotificationOptOut
+	if err := db.conn.Where("user_id = ?", userID).Order("category").Find(&optOuts).Error; err != nil {
+		return nil, err
+	}
+	categories := make([]pb.NotificationOptOut_Category, len(optOuts))
+	for i, optOut := range optOuts {
+		categories[i] = optOut.GetCategory()
+	}
+	return categories, nil
+}
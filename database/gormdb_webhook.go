@@ -0,0 +1,92 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// UpdateWebhook creates or updates the given outbound webhook.
+func (db *GormDB) UpdateWebhook(webhook *pb.Webhook) error {
+	return db.conn.Save(webhook).Error
+}
+
+// GetWebhook returns the outbound webhook with the given ID.
+func (db *GormDB) GetWebhook(webhookID uint64) (*pb.Webhook, error) {
+	var webhook pb.Webhook
+	if err := db.conn.First(&webhook, webhookID).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetWebhooks returns the outbound webhooks matching the query.
+func (db *GormDB) GetWebhooks(query *pb.Webhook) ([]*pb.Webhook, error) {
+	var webhooks []*pb.Webhook
+	if err := db.conn.Where(query).Order("id").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook removes the outbound webhook with the given ID and its delivery log.
+func (db *GormDB) DeleteWebhook(webhookID uint64) error {
+	tx := db.conn.Begin()
+	if err := tx.Where("webhook_id = ?", webhookID).Delete(&pb.WebhookDelivery{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Delete(&pb.Webhook{}, webhookID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// CreateWebhookDelivery records a new webhook delivery.
+func (db *GormDB) CreateWebhookDelivery(delivery *pb.WebhookDelivery) error {
+	return db.conn.Create(delivery).Error
+}
+
+// UpdateWebhookDelivery updates the given webhook delivery after a delivery attempt.
+func (db *GormDB) UpdateWebhookDelivery(delivery *pb.WebhookDelivery) error {
+	return db.conn.Save(delivery).Error
+}
+
+// ClaimWebhookDelivery reschedules the given pending delivery to the given time, unless it has been
+// attempted, delivered or rescheduled since it was read. It returns true if the delivery was claimed,
+// such that only one of several concurrent callers attempts the delivery.
+func (db *GormDB) ClaimWebhookDelivery(delivery *pb.WebhookDelivery, nextAttempt string) (bool, error) {
+	tx := db.conn.Model(&pb.WebhookDelivery{}).
+		Where("id = ? AND delivered = ? AND attempts = ? AND next_attempt = ?",
+			delivery.GetID(), false, delivery.GetAttempts(), delivery.GetNextAttempt()).
+		Update("next_attempt", nextAttempt)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return false, nil
+	}
+	delivery.NextAttempt = nextAttempt
+	return true, nil
+}
+
+// GetWebhookDeliveries returns the most recent deliveries to the given webhook, newest first.
+func (db *GormDB) GetWebhookDeliveries(webhookID uint64, limit int) ([]*pb.WebhookDelivery, error) {
+	var deliveries []*pb.WebhookDelivery
+	if err := db.conn.Where("webhook_id = ?", webhookID).Order("id desc").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// GetPendingWebhookDeliveries returns the undelivered deliveries due to be retried at the given time.
+func (db *GormDB) GetPendingWebhookDeliveries(now string) ([]*pb.WebhookDelivery, error) {
+	var deliveries []*pb.WebhookDelivery
+	if err := db.conn.
+		Where("delivered = ?", false).
+		Where("next_attempt <> ? AND next_attempt <= ?", "", now).
+		Order("id").
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
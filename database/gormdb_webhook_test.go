@@ -0,0 +1,64 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+func TestGormDBClaimWebhookDelivery(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	webhook := &pb.Webhook{CourseID: 1, URL: "https://lms.example.com/quickfeed", Secret: "hush", Active: true}
+	if err := db.UpdateWebhook(webhook); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &pb.WebhookDelivery{WebhookID: webhook.ID, Event: "group.changed", Attempts: 1, NextAttempt: "2021-03-01T12:00:00"}
+	if err := db.CreateWebhookDelivery(delivery); err != nil {
+		t.Fatal(err)
+	}
+
+	// two concurrent retries read the same pending delivery
+	first, err := db.GetPendingWebhookDeliveries("2021-03-01T12:05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := db.GetPendingWebhookDeliveries("2021-03-01T12:05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 1 || len(second) != 1 {
+		t.Fatalf("GetPendingWebhookDeliveries() returned %d and %d deliveries, want %d", len(first), len(second), 1)
+	}
+
+	// only the first claim succeeds
+	claimed, err := db.ClaimWebhookDelivery(first[0], "2021-03-01T12:06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !claimed || first[0].GetNextAttempt() != "2021-03-01T12:06:00" {
+		t.Errorf("ClaimWebhookDelivery() = %t with next attempt %q, want the delivery claimed", claimed, first[0].GetNextAttempt())
+	}
+	if claimed, err = db.ClaimWebhookDelivery(second[0], "2021-03-01T12:06:00"); err != nil {
+		t.Fatal(err)
+	}
+	if claimed {
+		t.Error("ClaimWebhookDelivery() claimed a delivery already claimed by another caller")
+	}
+	if pending, err := db.GetPendingWebhookDeliveries("2021-03-01T12:05:00"); err != nil || len(pending) != 0 {
+		t.Errorf("GetPendingWebhookDeliveries() after claim = %v (error %v), want no deliveries", pending, err)
+	}
+
+	// a delivery attempted since it was read cannot be claimed
+	first[0].Attempts++
+	first[0].NextAttempt = "2021-03-01T12:10:00"
+	if err := db.UpdateWebhookDelivery(first[0]); err != nil {
+		t.Fatal(err)
+	}
+	stale := &pb.WebhookDelivery{ID: delivery.ID, Attempts: 1, NextAttempt: "2021-03-01T12:10:00"}
+	if claimed, err = db.ClaimWebhookDelivery(stale, "2021-03-01T12:11:00"); err != nil || claimed {
+		t.Errorf("ClaimWebhookDelivery() of attempted delivery = %t (error %v), want false", claimed, err)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,9 +9,12 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/autograde/quickfeed/ci"
 	logq "github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
 
@@ -57,13 +61,18 @@ var reg = prometheus.NewRegistry()
 
 func main() {
 	var (
-		baseURL  = flag.String("service.url", "", "base service DNS name")
-		dbFile   = flag.String("database.file", "qf.db", "database file")
-		public   = flag.String("http.public", "public", "path to content to serve")
-		httpAddr = flag.String("http.addr", ":8081", "HTTP listen address")
-		grpcAddr = flag.String("grpc.addr", ":9090", "gRPC listen address")
+		baseURL           = flag.String("service.url", "", "base service DNS name")
+		dbFile            = flag.String("database.file", "qf.db", "database file")
+		public            = flag.String("http.public", "public", "path to content to serve")
+		httpAddr          = flag.String("http.addr", ":8081", "HTTP listen address")
+		grpcAddr          = flag.String("grpc.addr", ":9090", "gRPC listen address")
+		schedulerInterval = flag.Duration("scheduler.interval", time.Minute, "interval between checks for scheduled releases, deadline reminders and webhook retries")
+		smtpAddr          = flag.String("smtp.addr", "", "SMTP server address for email notifications (host:port); disabled if empty")
+		smtpFrom          = flag.String("smtp.from", "quickfeed@localhost", "sender address of email notifications")
+		webhookAllow      = flag.String("webhook.allow", "", "comma-separated hosts on private networks that course webhooks and chat channels may deliver to")
 	)
 	flag.Parse()
+	pb.AllowWebhookHosts(strings.Split(*webhookAllow, ",")...)
 
 	logger := logq.Zap(true)
 	defer logger.Sync()
@@ -90,13 +99,21 @@ func main() {
 	defer runner.Close()
 
 	agService := web.NewAutograderService(logger, db, scms, bh, runner)
-	go web.New(agService, *public, *httpAddr)
+	if *smtpAddr != "" {
+		mailer, err := notify.NewMailer(*smtpAddr, *smtpFrom, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+		if err != nil {
+			log.Fatalf("failed to set up email notifications: %v\n", err)
+		}
+		agService.SetNotificationSender(mailer)
+	}
+	go web.New(agService, *public, *httpAddr, os.Getenv("SESSION_KEYS"))
+	go agService.RunScheduler(context.Background(), *schedulerInterval)
 
 	lis, err := net.Listen("tcp", *grpcAddr)
 	if err != nil {
 		log.Fatalf("failed to start tcp listener: %v\n", err)
 	}
-	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier(), pb.Interceptor(logger))
+	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier(db), agService.AccessControl(), agService.Audit(), pb.Interceptor(logger))
 	grpcServer := grpc.NewServer(opt)
 
 	// Create a HTTP server for prometheus.
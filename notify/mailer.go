@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Mailer sends notifications by email through an SMTP server.
+type Mailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewMailer returns a mailer that sends email from the given address through the SMTP server at addr.
+// If username is empty, no authentication is used.
+func NewMailer(addr, from, username, password string) (*Mailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP server address %q: %w", addr, err)
+	}
+	m := &Mailer{addr: addr, from: from}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m, nil
+}
+
+// Send sends an email with the given subject and body to the user's email address.
+func (m *Mailer) Send(to *pb.User, subject, body string) error {
+	if to.GetEmail() == "" {
+		return fmt.Errorf("user %s has no email address", to.GetLogin())
+	}
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{to.GetEmail()}, m.message(to.GetEmail(), subject, body))
+}
+
+// message returns the email message with headers.
+func (m *Mailer) message(to, subject, body string) []byte {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", m.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return []byte(msg.String())
+}
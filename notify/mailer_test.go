@@ -0,0 +1,194 @@
+package notify_test
+
+import (
+	"bufio"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"go.uber.org/zap"
+)
+
+// mail is an email received by the SMTP stand-in.
+type mail struct {
+	from string
+	to   []string
+	data string
+}
+
+// smtpServer is a minimal SMTP server that records the received emails.
+type smtpServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	mails    []mail
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpServer{listener: listener}
+	go s.serve()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *smtpServer) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *smtpServer) received() []mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]mail(nil), s.mails...)
+}
+
+// waitForMails waits until the SMTP stand-in has received n emails and returns the received emails.
+func (s *smtpServer) waitForMails(t *testing.T, n int) []mail {
+	t.Helper()
+	for i := 0; i < 100 && len(s.received()) < n; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	return s.received()
+}
+
+func (s *smtpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost SMTP stand-in")
+	var m mail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250 localhost")
+		case "MAIL":
+			m = mail{from: strings.TrimPrefix(line, "MAIL FROM:")}
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			m.to = append(m.to, strings.TrimPrefix(line, "RCPT TO:"))
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 send data")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			m.data = string(data)
+			s.mu.Lock()
+			s.mails = append(s.mails, m)
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+func TestMailer(t *testing.T) {
+	server := newSMTPServer(t)
+	mailer, err := notify.NewMailer(server.addr(), "quickfeed@example.com", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &pb.User{Login: "student", Email: "student@example.com"}
+	if err := mailer.Send(user, "Feedback released", "Hi,\nYour feedback is ready.\n"); err != nil {
+		t.Fatal(err)
+	}
+	mails := server.received()
+	if len(mails) != 1 {
+		t.Fatalf("received %d emails, want %d", len(mails), 1)
+	}
+	if len(mails[0].to) != 1 || mails[0].to[0] != "<student@example.com>" {
+		t.Errorf("email recipients = %v, want %v", mails[0].to, []string{"<student@example.com>"})
+	}
+	msg, err := textproto.NewReader(bufio.NewReader(strings.NewReader(mails[0].data))).ReadMIMEHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.Get("Subject"); got != "Feedback released" {
+		t.Errorf("Subject = %q, want %q", got, "Feedback released")
+	}
+	if !strings.Contains(mails[0].data, "Your feedback is ready.") {
+		t.Errorf("email body missing from %q", mails[0].data)
+	}
+
+	if err := mailer.Send(&pb.User{Login: "noemail"}, "subject", "body"); err == nil {
+		t.Error("Send() to user without email address: expected error")
+	}
+}
+
+func TestNotifyOptOut(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	server := newSMTPServer(t)
+	mailer, err := notify.NewMailer(server.addr(), "quickfeed@example.com", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifier := notify.NewNotifier(zap.NewNop().Sugar(), db, mailer)
+
+	users := make([]*pb.User, 2)
+	for i := range users {
+		users[i] = qtest.CreateFakeUser(t, db, uint64(i+1))
+		users[i].Email = users[i].GetLogin() + "@example.com"
+	}
+	if err := db.UpdateNotificationOptOuts(users[1].ID, []pb.NotificationOptOut_Category{pb.NotificationOptOut_GROUP}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Name: "Operating Systems", Code: "DAT320"}
+
+	group := &pb.Group{Name: "os-group"}
+	notifier.Notify(pb.NotificationOptOut_GROUP, users, &notify.Event{Course: course, Group: group})
+	// the notification is sent about the event as it was when queued
+	group.Name = "renamed"
+	mails := server.waitForMails(t, 1)
+	if len(mails) != 1 {
+		t.Fatalf("received %d group notifications, want %d", len(mails), 1)
+	}
+	if !strings.Contains(mails[0].data, "Subject: DAT320: group os-group approved") {
+		t.Errorf("unexpected group notification: %q", mails[0].data)
+	}
+
+	notifier.Notify(pb.NotificationOptOut_ENROLLMENT, users, &notify.Event{Course: course, Enrollment: &pb.Enrollment{Status: pb.Enrollment_STUDENT}})
+	mails = server.waitForMails(t, 3)
+	if len(mails) != 3 {
+		t.Fatalf("received %d notifications, want %d", len(mails), 3)
+	}
+	if !strings.Contains(mails[2].data, "Your enrollment in Operating Systems has been accepted") {
+		t.Errorf("unexpected enrollment notification: %q", mails[2].data)
+	}
+
+	// a nil notifier sends nothing
+	var none *notify.Notifier
+	none.Notify(pb.NotificationOptOut_GROUP, users, &notify.Event{Course: course, Group: &pb.Group{Name: "os-group"}})
+	if len(server.received()) != 3 {
+		t.Errorf("nil notifier sent notifications")
+	}
+}
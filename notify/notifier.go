@@ -0,0 +1,238 @@
+// Package notify sends notifications about course events to users.
+// Notifications are rendered from per-category templates and delivered by a Sender,
+// e.g., by email through an SMTP server. Users that have opted out of a category
+// do not receive notifications of that category.
+// Course events are also announced in the chat channels configured for the course,
+// and delivered as signed JSON payloads to the course's outbound webhooks.
+package notify
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
+	"github.com/autograde/quickfeed/database"
+)
+
+// announceTimeout is the maximum time spent posting a message to a chat channel.
+const announceTimeout = 30 * time.Second
+
+// Event holds the details of the course event that a notification is about.
+// Only the fields relevant for the notification's category need to be set.
+type Event struct {
+	Course     *pb.Course
+	User       *pb.User // the user the event concerns; resolved from Submission for announcements
+	Assignment *pb.Assignment
+	Submission *pb.Submission
+	Group      *pb.Group
+	Enrollment *pb.Enrollment
+}
+
+// Sender delivers a rendered notification to a user.
+type Sender interface {
+	Send(to *pb.User, subject, body string) error
+}
+
+// Notifier renders and sends notifications to users that have not opted out.
+// A nil Notifier sends no notifications.
+type Notifier struct {
+	logger   *zap.SugaredLogger
+	db       database.Database
+	sender   Sender
+	chat     *chat.Client
+	webhooks *http.Client
+	queue    *queue
+}
+
+// NewNotifier returns a notifier that delivers notifications with the given sender.
+// If sender is nil, notifications are only logged.
+func NewNotifier(logger *zap.SugaredLogger, db database.Database, sender Sender) *Notifier {
+	if sender == nil {
+		sender = &logSender{logger: logger}
+	}
+	return &Notifier{
+		logger:   logger,
+		db:       db,
+		sender:   sender,
+		chat:     chat.NewClient(newWebhookClient()),
+		webhooks: newWebhookClient(),
+		queue:    newQueue(logger),
+	}
+}
+
+// Notify sends a notification of the given category about the event to the given users,
+// except to users that have opted out of the category. The notifications are sent in the
+// background; failures are logged, but do not prevent the notification from being sent
+// to the remaining users.
+func (n *Notifier) Notify(category pb.NotificationOptOut_Category, users []*pb.User, event *Event) {
+	if n == nil {
+		return
+	}
+	// the queued notifications use a copy of the event, since the caller may go on to modify it
+	e := event.clone()
+	for _, user := range users {
+		user := user
+		n.queue.enqueue(category.String(), func() {
+			n.send(category, user, e)
+		})
+	}
+}
+
+// clone returns a deep copy of the event.
+func (e *Event) clone() *Event {
+	return &Event{
+		Course:     proto.Clone(e.Course).(*pb.Course),
+		User:       proto.Clone(e.User).(*pb.User),
+		Assignment: proto.Clone(e.Assignment).(*pb.Assignment),
+		Submission: proto.Clone(e.Submission).(*pb.Submission),
+		Group:      proto.Clone(e.Group).(*pb.Group),
+		Enrollment: proto.Clone(e.Enrollment).(*pb.Enrollment),
+	}
+}
+
+// send sends a notification of the given category about the event to the given user,
+// unless the user has opted out of the category. Failures are logged.
+func (n *Notifier) send(category pb.NotificationOptOut_Category, user *pb.User, event *Event) {
+	optedOut, err := n.optedOut(user.GetID(), category)
+	if err != nil {
+		n.logger.Errorf("Failed to get notification settings for user %d: %v", user.GetID(), err)
+		return
+	}
+	if optedOut {
+		return
+	}
+	subject, body, err := render(category, user, event)
+	if err != nil {
+		n.logger.Errorf("Failed to render %s notification: %v", category, err)
+		return
+	}
+	if err := n.sender.Send(user, subject, body); err != nil {
+		n.logger.Errorf("Failed to send %s notification to %s: %v", category, user.GetLogin(), err)
+	}
+}
+
+// optedOut returns true if the given user has opted out of the given category.
+func (n *Notifier) optedOut(userID uint64, category pb.NotificationOptOut_Category) (bool, error) {
+	categories, err := n.db.GetNotificationOptOuts(userID)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range categories {
+		if c == category {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// logSender only logs the notifications; it is used when no other sender is configured.
+type logSender struct {
+	logger *zap.SugaredLogger
+}
+
+func (s *logSender) Send(to *pb.User, subject, _ string) error {
+	s.logger.Debugf("Notification to %s: %s", to.GetLogin(), subject)
+	return nil
+}
+
+// NotifyOwners sends a notification of the given category about the event to the owners of
+// the event's submission, i.e., the submitting user or the members of the submitting group.
+func (n *Notifier) NotifyOwners(category pb.NotificationOptOut_Category, event *Event) {
+	if n == nil {
+		return
+	}
+	users, err := n.owners(event.Submission)
+	if err != nil {
+		n.logger.Errorf("Failed to get owners of submission %d: %v", event.Submission.GetID(), err)
+		return
+	}
+	n.Notify(category, users, event)
+}
+
+// owners returns the submitting user or the members of the submitting group.
+func (n *Notifier) owners(submission *pb.Submission) ([]*pb.User, error) {
+	if submission.GetGroupID() > 0 {
+		group, err := n.db.GetGroup(submission.GetGroupID())
+		if err != nil {
+			return nil, err
+		}
+		return group.GetUsers(), nil
+	}
+	user, err := n.db.GetUser(submission.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	return []*pb.User{user}, nil
+}
+
+// Announce posts a message about the event to the chat channels of the event's course
+// that are configured for the given kind of event. The messages are posted in the
+// background; failures are logged.
+func (n *Notifier) Announce(kind chat.Event, event *Event) {
+	if n == nil {
+		return
+	}
+	integrations, err := n.db.GetChatIntegrations(&pb.ChatIntegration{CourseID: event.Course.GetID()})
+	if err != nil {
+		n.logger.Errorf("Failed to get chat integrations for course %d: %v", event.Course.GetID(), err)
+		return
+	}
+	var message string
+	for _, integration := range integrations {
+		if !kind.EnabledFor(integration) {
+			continue
+		}
+		if message == "" {
+			if err := n.resolveSubmitter(event); err != nil {
+				n.logger.Errorf("Failed to get owners of submission %d: %v", event.Submission.GetID(), err)
+				return
+			}
+			if message, err = Announcement(kind, event); err != nil {
+				n.logger.Errorf("Failed to render %s announcement: %v", kind, err)
+				return
+			}
+		}
+		n.PostChat(integration, message)
+	}
+}
+
+// PostChat posts the message to the integration's chat channel in the background. Failures are logged.
+func (n *Notifier) PostChat(integration *pb.ChatIntegration, message string) {
+	if n == nil {
+		return
+	}
+	n.queue.enqueue("chat", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), announceTimeout)
+		defer cancel()
+		if err := n.chat.Post(ctx, integration, message); err != nil {
+			n.logger.Errorf("Failed to post to %s channel of course %d: %v", integration.GetPlatform(), integration.GetCourseID(), err)
+		}
+	})
+}
+
+// resolveSubmitter sets the event's user or group to the submitter of the event's submission, if not already set.
+func (n *Notifier) resolveSubmitter(event *Event) error {
+	submission := event.Submission
+	if submission == nil || event.User != nil || event.Group != nil {
+		return nil
+	}
+	if submission.GetGroupID() > 0 {
+		group, err := n.db.GetGroup(submission.GetGroupID())
+		if err != nil {
+			return err
+		}
+		event.Group = group
+		return nil
+	}
+	user, err := n.db.GetUser(submission.GetUserID())
+	if err != nil {
+		return err
+	}
+	event.User = user
+	return nil
+}
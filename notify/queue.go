@@ -0,0 +1,42 @@
+package notify
+
+import "go.uber.org/zap"
+
+const (
+	// queueSize is the maximum number of notifications waiting to be sent.
+	queueSize = 1000
+	// queueWorkers is the number of notifications sent concurrently.
+	queueWorkers = 4
+)
+
+// queue sends notifications in the background, so that slow mail servers and
+// chat platforms do not delay the requests and test runs that trigger the notifications.
+type queue struct {
+	logger *zap.SugaredLogger
+	jobs   chan func()
+}
+
+// newQueue returns a queue whose notifications are sent by a fixed number of workers.
+func newQueue(logger *zap.SugaredLogger) *queue {
+	q := &queue{logger: logger, jobs: make(chan func(), queueSize)}
+	for i := 0; i < queueWorkers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+// enqueue queues the job for sending a notification of the given kind.
+// If the queue is full, the notification is dropped and logged, rather than blocking the caller.
+func (q *queue) enqueue(kind string, job func()) {
+	select {
+	case q.jobs <- job:
+	default:
+		q.logger.Errorf("Dropped %s notification: notification queue is full", kind)
+	}
+}
+
+func (q *queue) work() {
+	for job := range q.jobs {
+		job()
+	}
+}
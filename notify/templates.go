@@ -0,0 +1,103 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
+)
+
+// templates holds the subject and body templates for each notification category.
+var templates = map[pb.NotificationOptOut_Category]*template.Template{
+	pb.NotificationOptOut_BUILD: template.Must(template.New("build").Parse(`
+{{- define "subject"}}{{.Course.Code}}: test results for {{.Assignment.Name}}{{end}}
+{{- define "body"}}Hi {{.User.Name}},
+
+Your latest submission for {{.Assignment.Name}} in {{.Course.Name}} has been tested.
+
+Score: {{.Submission.Score}}%
+Status: {{.Submission.Status}}
+{{end}}`)),
+
+	pb.NotificationOptOut_SUBMISSION: template.Must(template.New("submission").Parse(`
+{{- define "subject"}}{{.Course.Code}}: {{.Assignment.Name}} {{.Submission.Status}}{{end}}
+{{- define "body"}}Hi {{.User.Name}},
+
+Your submission for {{.Assignment.Name}} in {{.Course.Name}} has been marked as {{.Submission.Status}}.
+{{end}}`)),
+
+	pb.NotificationOptOut_RELEASE: template.Must(template.New("release").Parse(`
+{{- define "subject"}}{{.Course.Code}}: feedback for {{.Assignment.Name}} released{{end}}
+{{- define "body"}}Hi {{.User.Name}},
+
+The reviews and score for your submission for {{.Assignment.Name}} in {{.Course.Name}} are now available in QuickFeed.
+{{end}}`)),
+
+	pb.NotificationOptOut_GROUP: template.Must(template.New("group").Parse(`
+{{- define "subject"}}{{.Course.Code}}: group {{.Group.Name}} approved{{end}}
+{{- define "body"}}Hi {{.User.Name}},
+
+Your group {{.Group.Name}} in {{.Course.Name}} has been approved, and the group repository is ready.
+{{end}}`)),
+
+	pb.NotificationOptOut_ENROLLMENT: template.Must(template.New("enrollment").Parse(`
+{{- define "subject"}}{{.Course.Code}}: enrollment {{if .Enrollment.IsTeacher}}changed{{else if .Enrollment.IsStudent}}accepted{{else}}rejected{{end}}{{end}}
+{{- define "body"}}Hi {{.User.Name}},
+
+{{if .Enrollment.IsTeacher}}You are now a teacher in {{.Course.Name}}.
+{{- else if .Enrollment.IsStudent}}Your enrollment in {{.Course.Name}} has been accepted, and your repositories are ready.
+{{- else}}Your enrollment in {{.Course.Name}} has been rejected.
+{{- end}}
+{{end}}`)),
+}
+
+// announcements holds the chat message templates for each kind of chat event.
+var announcements = map[chat.Event]*template.Template{
+	chat.BuildFailure: template.Must(template.New("failure").Parse(
+		`{{.Course.Code}}: {{with .Group}}group {{.Name}}{{else}}{{.User.Login}}{{end}} scored {{.Submission.Score}}% on {{.Assignment.Name}}, below the limit of {{.Assignment.ScoreLimit}}%`)),
+	chat.DeadlineReminder: template.Must(template.New("reminder").Parse(
+		`{{.Course.Code}}: {{.Assignment.Name}} is due {{.Assignment.Deadline}}`)),
+	chat.Approval: template.Must(template.New("approval").Parse(
+		`{{.Course.Code}}: {{.Assignment.Name}} approved for {{with .Group}}group {{.Name}}{{else}}{{.User.Login}}{{end}}`)),
+	chat.EnrollmentRequest: template.Must(template.New("enrollment").Parse(
+		`{{.Course.Code}}: {{.User.Name}}{{with .User.Login}} ({{.}}){{end}} requested to enroll`)),
+}
+
+// Announcement returns the chat message about the given kind of event.
+func Announcement(kind chat.Event, event *Event) (string, error) {
+	tmpl, ok := announcements[kind]
+	if !ok {
+		return "", fmt.Errorf("no template for chat event %s", kind)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// render returns the subject and body of the notification of the given category
+// about the event for the given user.
+func render(category pb.NotificationOptOut_Category, user *pb.User, event *Event) (subject, body string, err error) {
+	tmpl, ok := templates[category]
+	if !ok {
+		return "", "", fmt.Errorf("no template for notification category %s", category)
+	}
+	data := struct {
+		User *pb.User
+		*Event
+	}{User: user, Event: event}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", err
+	}
+	subject = buf.String()
+	buf.Reset()
+	if err := tmpl.ExecuteTemplate(&buf, "body", data); err != nil {
+		return "", "", err
+	}
+	return subject, buf.String(), nil
+}
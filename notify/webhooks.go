@@ -0,0 +1,277 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Webhook events delivered to the outbound webhooks of a course.
+const (
+	SubmissionCreated = "submission.created"
+	SubmissionStatus  = "submission.status_changed"
+	EnrollmentChanged = "enrollment.changed"
+	GroupChanged      = "group.changed"
+	AssignmentUpdated = "assignment.updated"
+)
+
+// Actions of group.changed events.
+const (
+	Created = "created"
+	Updated = "updated"
+	Deleted = "deleted"
+)
+
+// Headers of webhook deliveries.
+const (
+	EventHeader     = "X-QuickFeed-Event"
+	DeliveryHeader  = "X-QuickFeed-Delivery"
+	SignatureHeader = "X-QuickFeed-Signature-256"
+)
+
+// webhookTimeout is the maximum time spent on a single delivery attempt.
+const webhookTimeout = 10 * time.Second
+
+// webhookBackoff holds the delays before each retry of a failed delivery.
+// A delivery is given up after it has been retried once for each delay.
+var webhookBackoff = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	12 * time.Hour,
+}
+
+// payload is the JSON document posted to webhooks.
+type payload struct {
+	Event     string          `json:"event"`
+	Action    string          `json:"action,omitempty"`
+	CourseID  uint64          `json:"course_id"`
+	Timestamp string          `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// remoteIDRemover is implemented by messages that may hold the remote identities of users.
+type remoteIDRemover interface {
+	RemoveRemoteID()
+}
+
+// Dispatch delivers the event with the given data to the active webhooks of the course
+// that subscribe to the event. Each delivery is recorded in the webhook's delivery log
+// and attempted in the background by the notification queue; failed deliveries are retried by RetryDeliveries.
+func (n *Notifier) Dispatch(event string, courseID uint64, data proto.Message) {
+	n.DispatchAction(event, "", courseID, data)
+}
+
+// DispatchAction is like Dispatch, but also tells the webhooks what happened to the data,
+// e.g., that a group was created, updated or deleted.
+func (n *Notifier) DispatchAction(event, action string, courseID uint64, data proto.Message) {
+	if n == nil {
+		return
+	}
+	webhooks, err := n.db.GetWebhooks(&pb.Webhook{CourseID: courseID, Active: true})
+	if err != nil {
+		n.logger.Errorf("Failed to get webhooks for course %d: %v", courseID, err)
+		return
+	}
+	var body []byte
+	for _, webhook := range webhooks {
+		if !subscribes(webhook, event) {
+			continue
+		}
+		now := time.Now()
+		if body == nil {
+			if body, err = marshalPayload(event, action, courseID, now, data); err != nil {
+				n.logger.Errorf("Failed to marshal %s payload: %v", event, err)
+				return
+			}
+		}
+		delivery := &pb.WebhookDelivery{
+			WebhookID:   webhook.GetID(),
+			Event:       event,
+			Payload:     string(body),
+			CreatedDate: now.Format(pb.TimeLayout),
+			// rescheduled after the first attempt; ensures a retry should the attempt be lost
+			NextAttempt: now.Add(webhookBackoff[0]).Format(pb.TimeLayout),
+		}
+		if err := n.db.CreateWebhookDelivery(delivery); err != nil {
+			n.logger.Errorf("Failed to record %s delivery to webhook %d: %v", event, webhook.GetID(), err)
+			continue
+		}
+		// a delivery dropped from a full queue is attempted by RetryDeliveries
+		webhook, delivery := webhook, delivery
+		n.queue.enqueue("webhook", func() { n.deliver(webhook, delivery) })
+	}
+}
+
+// RetryDeliveries retries the failed webhook deliveries that are due at the given time.
+// Each delivery is only retried once, even if RetryDeliveries is called concurrently.
+func (n *Notifier) RetryDeliveries(now time.Time) {
+	if n == nil {
+		return
+	}
+	deliveries, err := n.db.GetPendingWebhookDeliveries(now.Format(pb.TimeLayout))
+	if err != nil {
+		n.logger.Errorf("Failed to get pending webhook deliveries: %v", err)
+		return
+	}
+	for _, delivery := range deliveries {
+		// claim the delivery before attempting it, so that it is not retried by concurrent callers;
+		// should the attempt be lost, the delivery is retried again once the claim expires
+		claimed, err := n.db.ClaimWebhookDelivery(delivery, now.Add(webhookBackoff[0]).Format(pb.TimeLayout))
+		if err != nil {
+			n.logger.Errorf("Failed to claim webhook delivery %d: %v", delivery.GetID(), err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		webhook, err := n.db.GetWebhook(delivery.GetWebhookID())
+		if err != nil || !webhook.GetActive() {
+			// the webhook has been deleted or deactivated; give up the delivery
+			delivery.NextAttempt = ""
+			if err := n.db.UpdateWebhookDelivery(delivery); err != nil {
+				n.logger.Errorf("Failed to update webhook delivery %d: %v", delivery.GetID(), err)
+			}
+			continue
+		}
+		n.deliver(webhook, delivery)
+	}
+}
+
+// deliver attempts to deliver the payload to the webhook and records the outcome in the delivery log.
+func (n *Notifier) deliver(webhook *pb.Webhook, delivery *pb.WebhookDelivery) {
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttempt = now.Format(pb.TimeLayout)
+	statusCode, err := n.post(webhook, delivery)
+	delivery.StatusCode = uint32(statusCode)
+	if err != nil {
+		delivery.Error = err.Error()
+		delivery.NextAttempt = nextAttempt(delivery.GetAttempts(), now)
+		n.logger.Errorf("Failed to deliver %s to webhook %d (attempt %d): %v", delivery.GetEvent(), webhook.GetID(), delivery.GetAttempts(), err)
+	} else {
+		delivery.Delivered = true
+		delivery.Error = ""
+		delivery.NextAttempt = ""
+	}
+	if err := n.db.UpdateWebhookDelivery(delivery); err != nil {
+		n.logger.Errorf("Failed to update webhook delivery %d: %v", delivery.GetID(), err)
+	}
+}
+
+// newWebhookClient returns an HTTP client that only connects to public addresses, unless
+// the host has been allowed with ag.AllowWebhookHosts. The addresses are checked after DNS
+// resolution, also when following redirects, so that webhooks cannot reach internal services.
+// The client is also used to post to chat integrations' webhooks.
+func newWebhookClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would connect to the webhook on our behalf, bypassing the address check
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialer := &net.Dialer{Timeout: webhookTimeout}
+		if host, _, err := net.SplitHostPort(addr); err != nil || !pb.IsAllowedWebhookHost(host) {
+			dialer.Control = func(_, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if ip := net.ParseIP(host); err != nil || ip == nil || !pb.IsPublicIP(ip) {
+					return fmt.Errorf("webhook address %s is not public", address)
+				}
+				return nil
+			}
+		}
+		return dialer.DialContext(ctx, network, addr)
+	}
+	return &http.Client{Timeout: webhookTimeout, Transport: transport}
+}
+
+// post sends the delivery's payload to the webhook, returning the response status code, if any.
+func (n *Notifier) post(webhook *pb.Webhook, delivery *pb.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	body := []byte(delivery.GetPayload())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.GetURL(), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "QuickFeed-Hookshot")
+	req.Header.Set(EventHeader, delivery.GetEvent())
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(delivery.GetID(), 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.GetSecret(), body))
+	resp, err := n.webhooks.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the value of the signature header for the given payload,
+// i.e., the hex encoded HMAC-SHA256 of the payload keyed with the webhook's secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// nextAttempt returns the time of the next retry after the given number of failed attempts,
+// or the empty string if the delivery should be given up.
+func nextAttempt(attempts uint32, now time.Time) string {
+	if attempts == 0 || int(attempts) > len(webhookBackoff) {
+		return ""
+	}
+	return now.Add(webhookBackoff[attempts-1]).Format(pb.TimeLayout)
+}
+
+// subscribes returns true if the webhook subscribes to the given event.
+func subscribes(webhook *pb.Webhook, event string) bool {
+	switch event {
+	case SubmissionCreated:
+		return webhook.GetSubmissionCreated()
+	case SubmissionStatus:
+		return webhook.GetSubmissionStatus()
+	case EnrollmentChanged:
+		return webhook.GetEnrollmentChanged()
+	case GroupChanged:
+		return webhook.GetGroupChanged()
+	case AssignmentUpdated:
+		return webhook.GetAssignmentUpdated()
+	}
+	return false
+}
+
+// marshalPayload returns the JSON payload of the event, without the remote identities of any users.
+func marshalPayload(event, action string, courseID uint64, now time.Time, data proto.Message) ([]byte, error) {
+	data = proto.Clone(data)
+	if msg, ok := data.(remoteIDRemover); ok {
+		msg.RemoveRemoteID()
+	}
+	raw, err := protojson.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&payload{
+		Event:     event,
+		Action:    action,
+		CourseID:  courseID,
+		Timestamp: now.Format(time.RFC3339),
+		Data:      raw,
+	})
+}
@@ -0,0 +1,238 @@
+package notify_test
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"go.uber.org/zap"
+)
+
+// webhookReceiver is a local stand-in for an external system receiving webhook deliveries.
+// It responds with an error to the given number of deliveries before accepting them.
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	received []receivedDelivery
+}
+
+// receivedDelivery is a delivery received by the webhook stand-in.
+type receivedDelivery struct {
+	event       string
+	validSecret bool
+	body        string
+}
+
+func newWebhookReceiver(t *testing.T, secret string, failures int) *webhookReceiver {
+	t.Helper()
+	// the stand-in listens on the loopback address
+	pb.AllowWebhookHosts("127.0.0.1")
+	receiver := &webhookReceiver{failures: failures}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		valid := hmac.Equal([]byte(r.Header.Get(notify.SignatureHeader)), []byte(notify.Sign(secret, body)))
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.received = append(receiver.received, receivedDelivery{
+			event:       r.Header.Get(notify.EventHeader),
+			validSecret: valid,
+			body:        string(body),
+		})
+		if receiver.failures > 0 {
+			receiver.failures--
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *webhookReceiver) deliveries() []receivedDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedDelivery(nil), r.received...)
+}
+
+// waitForAttempt waits until the newest delivery to the webhook has been attempted and returns it.
+func waitForAttempt(t *testing.T, db database.Database, webhookID uint64) *pb.WebhookDelivery {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		deliveries, err := db.GetWebhookDeliveries(webhookID, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deliveries) == 1 && deliveries[0].GetAttempts() > 0 {
+			return deliveries[0]
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("no delivery attempted for webhook %d", webhookID)
+	return nil
+}
+
+func createWebhookCourse(t *testing.T, db database.Database) *pb.Course {
+	t.Helper()
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Name: "Test", Code: "DAT320", CourseCreatorID: teacher.ID}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	return course
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := createWebhookCourse(t, db)
+	receiver := newWebhookReceiver(t, "hush", 1)
+	webhook := &pb.Webhook{
+		CourseID:          course.ID,
+		URL:               receiver.URL,
+		Secret:            "hush",
+		SubmissionCreated: true,
+		GroupChanged:      true,
+		Active:            true,
+	}
+	if err := db.UpdateWebhook(webhook); err != nil {
+		t.Fatal(err)
+	}
+	notifier := notify.NewNotifier(zap.NewNop().Sugar(), db, nil)
+
+	submission := &pb.Submission{ID: 7, AssignmentID: 1, UserID: 2, Score: 80}
+	notifier.Dispatch(notify.SubmissionCreated, course.ID, submission)
+	delivery := waitForAttempt(t, db, webhook.ID)
+	if delivery.GetDelivered() || delivery.GetStatusCode() != http.StatusInternalServerError || delivery.GetNextAttempt() == "" {
+		t.Fatalf("first attempt: got delivered=%t status=%d next=%q, want a failed delivery to be retried",
+			delivery.GetDelivered(), delivery.GetStatusCode(), delivery.GetNextAttempt())
+	}
+
+	// the failed delivery is not retried before its backoff has passed
+	notifier.RetryDeliveries(time.Now())
+	if got := len(receiver.deliveries()); got != 1 {
+		t.Fatalf("received %d deliveries before backoff passed, want %d", got, 1)
+	}
+	notifier.RetryDeliveries(time.Now().Add(2 * time.Minute))
+	deliveries, err := db.GetWebhookDeliveries(webhook.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || !deliveries[0].GetDelivered() || deliveries[0].GetAttempts() != 2 || deliveries[0].GetNextAttempt() != "" {
+		t.Fatalf("delivery log after retry = %v, want one delivery delivered on the second attempt", deliveries)
+	}
+
+	received := receiver.deliveries()
+	if len(received) != 2 {
+		t.Fatalf("received %d deliveries, want %d", len(received), 2)
+	}
+	for _, r := range received {
+		if r.event != notify.SubmissionCreated || !r.validSecret {
+			t.Errorf("received %s delivery with valid signature %t, want %s with valid signature", r.event, r.validSecret, notify.SubmissionCreated)
+		}
+	}
+	var payload struct {
+		Event    string          `json:"event"`
+		CourseID uint64          `json:"course_id"`
+		Data     json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(received[1].body), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Event != notify.SubmissionCreated || payload.CourseID != course.ID || !strings.Contains(string(payload.Data), `"score":80`) {
+		t.Errorf("payload = %s, want the created submission", received[1].body)
+	}
+
+	// events the webhook does not subscribe to are not delivered
+	notifier.Dispatch(notify.EnrollmentChanged, course.ID, &pb.Enrollment{UserID: 2, CourseID: course.ID})
+	if deliveries, _ := db.GetWebhookDeliveries(webhook.ID, 10); len(deliveries) != 1 {
+		t.Errorf("delivery log has %d deliveries after unsubscribed event, want %d", len(deliveries), 1)
+	}
+
+	// remote identities of users are not revealed to webhooks
+	group := &pb.Group{ID: 1, CourseID: course.ID, Name: "group", Users: []*pb.User{
+		{ID: 2, Login: "student", RemoteIdentities: []*pb.RemoteIdentity{{AccessToken: "access-token"}}},
+	}}
+	notifier.DispatchAction(notify.GroupChanged, notify.Created, course.ID, group)
+	delivery = waitForAttempt(t, db, webhook.ID)
+	if !delivery.GetDelivered() || delivery.GetEvent() != notify.GroupChanged {
+		t.Fatalf("group delivery: got %s delivered=%t, want delivered %s", delivery.GetEvent(), delivery.GetDelivered(), notify.GroupChanged)
+	}
+	if strings.Contains(delivery.GetPayload(), "access-token") {
+		t.Errorf("payload %s reveals the user's access token", delivery.GetPayload())
+	}
+	if !strings.Contains(delivery.GetPayload(), `"action":"created"`) {
+		t.Errorf("payload %s is missing the action", delivery.GetPayload())
+	}
+	if len(group.GetUsers()[0].GetRemoteIdentities()) != 1 {
+		t.Error("DispatchAction() modified the dispatched group")
+	}
+}
+
+func TestWebhookDeliveryGivenUp(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := createWebhookCourse(t, db)
+	receiver := newWebhookReceiver(t, "hush", 100)
+	webhook := &pb.Webhook{CourseID: course.ID, URL: receiver.URL, Secret: "hush", AssignmentUpdated: true, Active: true}
+	if err := db.UpdateWebhook(webhook); err != nil {
+		t.Fatal(err)
+	}
+	notifier := notify.NewNotifier(zap.NewNop().Sugar(), db, nil)
+	notifier.Dispatch(notify.AssignmentUpdated, course.ID, &pb.Assignments{})
+	waitForAttempt(t, db, webhook.ID)
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		now = now.Add(24 * time.Hour)
+		notifier.RetryDeliveries(now)
+	}
+	deliveries, err := db.GetWebhookDeliveries(webhook.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("delivery log has %d deliveries, want %d", len(deliveries), 1)
+	}
+	if got := deliveries[0]; got.GetDelivered() || got.GetAttempts() != 6 || got.GetNextAttempt() != "" || got.GetError() == "" {
+		t.Errorf("delivery = %v, want an undelivered delivery given up after %d attempts", got, 6)
+	}
+}
+
+func TestWebhookDeliveryToPrivateAddress(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := createWebhookCourse(t, db)
+	receiver := newWebhookReceiver(t, "hush", 0)
+	// localhost is not an allowed host, and resolves to the loopback address
+	privateURL := strings.Replace(receiver.URL, "127.0.0.1", "localhost", 1)
+	webhook := &pb.Webhook{CourseID: course.ID, URL: privateURL, Secret: "hush", AssignmentUpdated: true, Active: true}
+	if err := db.UpdateWebhook(webhook); err != nil {
+		t.Fatal(err)
+	}
+	notifier := notify.NewNotifier(zap.NewNop().Sugar(), db, nil)
+	notifier.Dispatch(notify.AssignmentUpdated, course.ID, &pb.Assignments{})
+	delivery := waitForAttempt(t, db, webhook.ID)
+	if delivery.GetDelivered() || !strings.Contains(delivery.GetError(), "not public") {
+		t.Errorf("delivery to %s: delivered=%t error=%q, want a rejected delivery", privateURL, delivery.GetDelivered(), delivery.GetError())
+	}
+	if received := receiver.deliveries(); len(received) != 0 {
+		t.Errorf("webhook on private address received %d deliveries, want %d", len(received), 0)
+	}
+}
@@ -3,6 +3,7 @@ package scm
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -14,6 +15,14 @@ type FakeSCM struct {
 	Organizations map[uint64]*pb.Organization
 	Hooks         map[uint64]int
 	Teams         map[uint64]*Team
+	Comments      []*CommitCommentOptions
+	Statuses      []*CommitStatusOptions
+	// PullRequestFiles holds the files changed by each pull request, by pull request number.
+	PullRequestFiles    map[uint64][]string
+	PullRequestComments []*PullRequestCommentOptions
+	MergedPullRequests  []*PullRequestOptions
+	// Commits holds the commits of all repositories, by commit ID.
+	Commits map[string]*Commit
 }
 
 // NewFakeSCMClient returns a new Fake client implementing the SCM interface.
@@ -23,6 +32,9 @@ func NewFakeSCMClient() *FakeSCM {
 		Organizations: make(map[uint64]*pb.Organization),
 		Hooks:         make(map[uint64]int),
 		Teams:         make(map[uint64]*Team),
+
+		PullRequestFiles: make(map[uint64][]string),
+		Commits:          make(map[string]*Commit),
 	}
 }
 
@@ -223,3 +235,90 @@ func (s *FakeSCM) GetFileContent(context.Context, *FileOptions) (string, error)
 	// TODO no implementation provided yet
 	return "", nil
 }
+
+// CreateCommitComment implements the SCM interface.
+func (s *FakeSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.Comments = append(s.Comments, opt)
+	return nil
+}
+
+// CreateCommitStatus implements the SCM interface.
+func (s *FakeSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.Statuses = append(s.Statuses, opt)
+	return nil
+}
+
+// GetPullRequestFiles implements the SCM interface.
+func (s *FakeSCM) GetPullRequestFiles(ctx context.Context, opt *PullRequestOptions) ([]string, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetPullRequestFiles",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	return s.PullRequestFiles[opt.Number], nil
+}
+
+// CreatePullRequestComment implements the SCM interface.
+func (s *FakeSCM) CreatePullRequestComment(ctx context.Context, opt *PullRequestCommentOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreatePullRequestComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.PullRequestComments = append(s.PullRequestComments, opt)
+	return nil
+}
+
+// MergePullRequest implements the SCM interface.
+func (s *FakeSCM) MergePullRequest(ctx context.Context, opt *PullRequestOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "MergePullRequest",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	s.MergedPullRequests = append(s.MergedPullRequests, opt)
+	return nil
+}
+
+// GetCommitLog implements the SCM interface.
+func (s *FakeSCM) GetCommitLog(ctx context.Context, opt *CommitLogOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetCommitLog",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if _, ok := s.Commits[opt.CommitHash]; !ok {
+		return nil, errors.New("commit not found")
+	}
+	var commits []*Commit
+	seen := make(map[string]bool)
+	queue := []string{opt.CommitHash}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		commit, ok := s.Commits[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		commits = append(commits, commit)
+		queue = append(queue, commit.Parents...)
+	}
+	return commits, nil
+}
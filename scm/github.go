@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -317,6 +318,8 @@ func (s *GithubSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) erro
 			"content_type": "json",
 			"insecure_ssl": "0",
 		},
+		// pull request events are needed for courses where students submit by opening pull requests
+		Events: []string{"push", "pull_request"},
 	}
 	var err error
 	// prioritize creating an organization hook
@@ -728,3 +731,209 @@ func (s *GithubSCM) GetFileContent(ctx context.Context, opt *FileOptions) (strin
 	}
 	return contentString, nil
 }
+
+// CreateCommitComment implements the SCM interface
+func (s *GithubSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// commit comments can only be positioned in the commit's diff; refer to the lines in the comment body instead
+	body := fmt.Sprintf("**%s#%s**\n\n%s", opt.Path, opt.lines(), opt.Body)
+	_, _, err := s.client.Repositories.CreateComment(ctx, opt.Owner, opt.Repository, opt.CommitHash, &github.RepositoryComment{
+		Body: &body,
+		Path: &opt.Path,
+	})
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "CreateCommitComment",
+			GitError: fmt.Errorf("failed to comment on commit %s in repo %s of organization %s: %w", opt.CommitHash, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to comment on %s", opt.Path),
+		}
+	}
+	return nil
+}
+
+// CreateCommitStatus implements the SCM interface.
+// The results are reported as a check run, showing the details of the results, if the
+// access token allows it; check runs can only be created by GitHub Apps. Otherwise,
+// the results are reported as a commit status, which only shows the summary.
+func (s *GithubSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	err := s.createCheckRun(ctx, opt)
+	if err == nil {
+		return nil
+	}
+	s.logger.Debugf("Failed to create check run for commit %s in repo %s, creating commit status instead: %v", opt.CommitHash, opt.Repository, err)
+	state := string(opt.State)
+	description := opt.description()
+	_, _, err = s.client.Repositories.CreateStatus(ctx, opt.Owner, opt.Repository, opt.CommitHash, &github.RepoStatus{
+		State:       &state,
+		TargetURL:   &opt.TargetURL,
+		Description: &description,
+		Context:     &opt.Name,
+	})
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "CreateCommitStatus",
+			GitError: fmt.Errorf("failed to create status for commit %s in repo %s of organization %s: %w", opt.CommitHash, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to report results for commit %s", opt.CommitHash),
+		}
+	}
+	return nil
+}
+
+// createCheckRun reports the results as a check run.
+func (s *GithubSCM) createCheckRun(ctx context.Context, opt *CommitStatusOptions) error {
+	checkRun := github.CreateCheckRunOptions{
+		Name:       opt.Name,
+		HeadSHA:    opt.CommitHash,
+		DetailsURL: &opt.TargetURL,
+		Output: &github.CheckRunOutput{
+			Title:   &opt.Title,
+			Summary: &opt.Title,
+			Text:    &opt.Details,
+		},
+	}
+	if opt.State == CommitPending {
+		checkRun.Status = github.String("in_progress")
+	} else {
+		conclusion := "success"
+		if opt.State != CommitSuccess {
+			conclusion = "failure"
+		}
+		checkRun.Status = github.String("completed")
+		checkRun.Conclusion = &conclusion
+		checkRun.CompletedAt = &github.Timestamp{Time: time.Now()}
+	}
+	_, _, err := s.client.Checks.CreateCheckRun(ctx, opt.Owner, opt.Repository, checkRun)
+	return err
+}
+
+// GetPullRequestFiles implements the SCM interface.
+func (s *GithubSCM) GetPullRequestFiles(ctx context.Context, opt *PullRequestOptions) ([]string, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetPullRequestFiles",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	var files []string
+	listOptions := &github.ListOptions{PerPage: 100}
+	for {
+		commitFiles, resp, err := s.client.PullRequests.ListFiles(ctx, opt.Owner, opt.Repository, int(opt.Number), listOptions)
+		if err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "GetPullRequestFiles",
+				GitError: fmt.Errorf("failed to list files of pull request %d in repo %s of organization %s: %w", opt.Number, opt.Repository, opt.Owner, err),
+				Message:  fmt.Sprintf("failed to get the files of pull request %d", opt.Number),
+			}
+		}
+		for _, file := range commitFiles {
+			files = append(files, file.GetFilename())
+		}
+		if resp.NextPage == 0 {
+			return files, nil
+		}
+		listOptions.Page = resp.NextPage
+	}
+}
+
+// CreatePullRequestComment implements the SCM interface.
+func (s *GithubSCM) CreatePullRequestComment(ctx context.Context, opt *PullRequestCommentOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreatePullRequestComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// comments on the pull request's conversation are issue comments
+	_, _, err := s.client.Issues.CreateComment(ctx, opt.Owner, opt.Repository, int(opt.Number), &github.IssueComment{
+		Body: &opt.Body,
+	})
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "CreatePullRequestComment",
+			GitError: fmt.Errorf("failed to comment on pull request %d in repo %s of organization %s: %w", opt.Number, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to comment on pull request %d", opt.Number),
+		}
+	}
+	return nil
+}
+
+// MergePullRequest implements the SCM interface.
+func (s *GithubSCM) MergePullRequest(ctx context.Context, opt *PullRequestOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "MergePullRequest",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, _, err := s.client.PullRequests.Merge(ctx, opt.Owner, opt.Repository, int(opt.Number), "", nil)
+	if err != nil {
+		return ErrFailedSCM{
+			Method:   "MergePullRequest",
+			GitError: fmt.Errorf("failed to merge pull request %d in repo %s of organization %s: %w", opt.Number, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to merge pull request %d", opt.Number),
+		}
+	}
+	return nil
+}
+
+// GetCommitLog implements the SCM interface.
+// The commit log does not include the lines changed, which are fetched for each commit.
+func (s *GithubSCM) GetCommitLog(ctx context.Context, opt *CommitLogOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetCommitLog",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	failed := func(err error) error {
+		return ErrFailedSCM{
+			Method:   "GetCommitLog",
+			GitError: fmt.Errorf("failed to get commit log of commit %s in repo %s of organization %s: %w", opt.CommitHash, opt.Repository, opt.Owner, err),
+			Message:  fmt.Sprintf("failed to get the commit log of commit %s", opt.CommitHash),
+		}
+	}
+	var commits []*Commit
+	listOptions := &github.CommitsListOptions{SHA: opt.CommitHash, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		repoCommits, resp, err := s.client.Repositories.ListCommits(ctx, opt.Owner, opt.Repository, listOptions)
+		if err != nil {
+			return nil, failed(err)
+		}
+		for _, logCommit := range repoCommits {
+			// the commit log does not include the lines changed by each commit
+			repoCommit, _, err := s.client.Repositories.GetCommit(ctx, opt.Owner, opt.Repository, logCommit.GetSHA())
+			if err != nil {
+				return nil, failed(err)
+			}
+			commit := &Commit{
+				ID:        repoCommit.GetSHA(),
+				Author:    repoCommit.GetAuthor().GetLogin(),
+				Additions: repoCommit.GetStats().GetAdditions(),
+				Deletions: repoCommit.GetStats().GetDeletions(),
+			}
+			if commit.Author == "" {
+				// the commit's author is not linked to a GitHub account
+				commit.Author = repoCommit.GetCommit().GetAuthor().GetName()
+			}
+			for _, parent := range repoCommit.Parents {
+				commit.Parents = append(commit.Parents, parent.GetSHA())
+			}
+			commits = append(commits, commit)
+		}
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		listOptions.Page = resp.NextPage
+	}
+}
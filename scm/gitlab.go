@@ -2,6 +2,7 @@ package scm
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -289,3 +290,124 @@ func (s *GitlabSCM) GetFileContent(context.Context, *FileOptions) (string, error
 		Method: "GetFileContent",
 	}
 }
+
+// CreateCommitComment implements the SCM interface
+func (s *GitlabSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// GitLab anchors the comment to a single line; refer to the full line range in the comment body
+	body := fmt.Sprintf("**%s#%s**\n\n%s", opt.Path, opt.lines(), opt.Body)
+	_, _, err := s.client.Commits.PostCommitComment(opt.Owner+"/"+opt.Repository, opt.CommitHash, &gitlab.PostCommitCommentOptions{
+		Note:     &body,
+		Path:     &opt.Path,
+		Line:     gitlab.Int(int(opt.EndLine)),
+		LineType: gitlab.String("new"),
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// CreateCommitStatus implements the SCM interface
+func (s *GitlabSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	states := map[CommitState]gitlab.BuildStateValue{
+		CommitPending: gitlab.Running,
+		CommitSuccess: gitlab.Success,
+		CommitFailure: gitlab.Failed,
+		CommitError:   gitlab.Failed,
+	}
+	_, _, err := s.client.Commits.SetCommitStatus(opt.Owner+"/"+opt.Repository, opt.CommitHash, &gitlab.SetCommitStatusOptions{
+		State:       states[opt.State],
+		Name:        &opt.Name,
+		TargetURL:   &opt.TargetURL,
+		Description: gitlab.String(opt.description()),
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// GetPullRequestFiles implements the SCM interface
+func (s *GitlabSCM) GetPullRequestFiles(ctx context.Context, opt *PullRequestOptions) ([]string, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetPullRequestFiles",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	mergeRequest, _, err := s.client.MergeRequests.GetMergeRequestChanges(opt.Owner+"/"+opt.Repository, int(opt.Number), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, change := range mergeRequest.Changes {
+		files = append(files, change.NewPath)
+	}
+	return files, nil
+}
+
+// CreatePullRequestComment implements the SCM interface
+func (s *GitlabSCM) CreatePullRequestComment(ctx context.Context, opt *PullRequestCommentOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreatePullRequestComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, _, err := s.client.Notes.CreateMergeRequestNote(opt.Owner+"/"+opt.Repository, int(opt.Number), &gitlab.CreateMergeRequestNoteOptions{
+		Body: &opt.Body,
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// MergePullRequest implements the SCM interface
+func (s *GitlabSCM) MergePullRequest(ctx context.Context, opt *PullRequestOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "MergePullRequest",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, _, err := s.client.MergeRequests.AcceptMergeRequest(opt.Owner+"/"+opt.Repository, int(opt.Number), &gitlab.AcceptMergeRequestOptions{}, gitlab.WithContext(ctx))
+	return err
+}
+
+// GetCommitLog implements the SCM interface.
+// GitLab does not link commits to user accounts, so the commits' authors are given by name.
+func (s *GitlabSCM) GetCommitLog(ctx context.Context, opt *CommitLogOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetCommitLog",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	var commits []*Commit
+	listOptions := &gitlab.ListCommitsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		RefName:     &opt.CommitHash,
+		WithStats:   gitlab.Bool(true),
+	}
+	for {
+		gitlabCommits, resp, err := s.client.Commits.ListCommits(opt.Owner+"/"+opt.Repository, listOptions, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range gitlabCommits {
+			commit := &Commit{ID: c.ID, Parents: c.ParentIDs, Author: c.AuthorName}
+			if c.Stats != nil {
+				commit.Additions, commit.Deletions = c.Stats.Additions, c.Stats.Deletions
+			}
+			commits = append(commits, commit)
+		}
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		listOptions.Page = resp.NextPage
+	}
+}
@@ -1,6 +1,9 @@
 package scm
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 const (
 	// Organization roles //
@@ -117,6 +120,45 @@ func (opt FileOptions) valid() bool {
 		opt.Path != "" && opt.Repository != ""
 }
 
+func (opt CommitCommentOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" &&
+		opt.CommitHash != "" && opt.Path != "" &&
+		opt.StartLine > 0 && opt.EndLine >= opt.StartLine &&
+		opt.Body != ""
+}
+
+// lines returns a reference to the commented lines, e.g. L3-L5, for use in the comment body.
+func (opt CommitCommentOptions) lines() string {
+	if opt.StartLine == opt.EndLine {
+		return fmt.Sprintf("L%d", opt.StartLine)
+	}
+	return fmt.Sprintf("L%d-L%d", opt.StartLine, opt.EndLine)
+}
+
+func (opt CommitStatusOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" &&
+		opt.CommitHash != "" && opt.Name != "" &&
+		opt.State != ""
+}
+
+// description returns the title, truncated to the maximum length of a commit status description.
+func (opt CommitStatusOptions) description() string {
+	const maxLength = 140
+	if len(opt.Title) <= maxLength {
+		return opt.Title
+	}
+	return opt.Title[:maxLength-3] + "..."
+}
+
+func (opt PullRequestOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" && opt.Number > 0
+}
+
+func (opt PullRequestCommentOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" &&
+		opt.Number > 0 && opt.Body != ""
+}
+
 // Errors //
 
 // ErrNotSupported is returned when the source code management solution used
@@ -154,3 +196,7 @@ type ErrFailedSCM struct {
 func (e ErrFailedSCM) Error() string {
 	return "github method " + e.Method + " failed: " + e.GitError.Error() + "\n" + e.Message
 }
+
+func (opt CommitLogOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" && opt.CommitHash != ""
+}
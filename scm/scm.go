@@ -64,6 +64,18 @@ type SCM interface {
 	GetUserScopes(context.Context) *Authorization
 	// GetFileContent returns the content of a single file in the given repository.
 	GetFileContent(context.Context, *FileOptions) (string, error)
+	// CreateCommitComment comments on a range of lines in a file of the given commit.
+	CreateCommitComment(context.Context, *CommitCommentOptions) error
+	// CreateCommitStatus reports the test results of the given commit as a commit status or check run.
+	CreateCommitStatus(context.Context, *CommitStatusOptions) error
+	// GetPullRequestFiles returns the paths of the files changed by the given pull request.
+	GetPullRequestFiles(context.Context, *PullRequestOptions) ([]string, error)
+	// CreatePullRequestComment comments on the given pull request.
+	CreatePullRequestComment(context.Context, *PullRequestCommentOptions) error
+	// MergePullRequest merges the given pull request.
+	MergePullRequest(context.Context, *PullRequestOptions) error
+	// GetCommitLog returns the commits reachable from the given commit, with the lines changed by each commit.
+	GetCommitLog(context.Context, *CommitLogOptions) ([]*Commit, error)
 }
 
 // NewSCMClient returns a new provider client implementing the SCM interface.
@@ -126,6 +138,71 @@ type FileOptions struct {
 	Repository string
 }
 
+// CommitCommentOptions is used to comment on a range of lines in a file of a commit.
+type CommitCommentOptions struct {
+	Owner      string
+	Repository string
+	CommitHash string
+	Path       string
+	StartLine  uint32
+	EndLine    uint32
+	Body       string
+}
+
+// CommitState is the state of a commit status.
+type CommitState string
+
+// States of a commit status.
+const (
+	CommitPending CommitState = "pending" // the tests are running
+	CommitSuccess CommitState = "success" // the tests passed
+	CommitFailure CommitState = "failure" // the tests failed
+	CommitError   CommitState = "error"   // the tests could not be run
+)
+
+// CommitStatusOptions is used to report the test results of a commit.
+type CommitStatusOptions struct {
+	Owner      string
+	Repository string
+	CommitHash string
+	Name       string // Name of the status, e.g., "quickfeed/lab1".
+	State      CommitState
+	Title      string // Short summary of the results.
+	Details    string // Markdown details of the results, e.g., the score of each test. Only shown by check runs.
+	TargetURL  string // Link to the submission in QuickFeed.
+}
+
+// PullRequestOptions identifies a pull request, or a merge request on GitLab.
+type PullRequestOptions struct {
+	Owner      string
+	Repository string
+	Number     uint64
+}
+
+// PullRequestCommentOptions is used to comment on a pull request.
+type PullRequestCommentOptions struct {
+	Owner      string
+	Repository string
+	Number     uint64
+	Body       string
+}
+
+// CommitLogOptions identifies the commit whose commit log to get.
+type CommitLogOptions struct {
+	Owner      string
+	Repository string
+	CommitHash string
+}
+
+// Commit is a commit in a repository's commit log.
+type Commit struct {
+	ID        string
+	Parents   []string
+	Author    string // SCM login of the author, or the author's name if not linked to an SCM account
+	Additions int
+	Deletions int
+}
+
 // Hook contains information about a webhook for a repository.
 type Hook struct {
 	ID     uint64
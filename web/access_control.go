@@ -74,7 +74,7 @@ func (s *AutograderService) hasCourseAccess(userID, courseID uint64, check func(
 // isEnrolled returns true if the given user is enrolled in the given course.
 func (s *AutograderService) isEnrolled(userID, courseID uint64) bool {
 	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
-		return e.Status == pb.Enrollment_STUDENT || e.Status == pb.Enrollment_TEACHER
+		return e.Status == pb.Enrollment_STUDENT || e.Status == pb.Enrollment_TEACHER || e.Status == pb.Enrollment_TA
 	})
 }
 
@@ -119,12 +119,79 @@ func (s *AutograderService) isValidSubmission(submissionID uint64) bool {
 }
 
 // isTeacher returns true if the given user is teacher for the given course.
+// Teaching assistants are not teachers.
 func (s *AutograderService) isTeacher(userID, courseID uint64) bool {
 	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
 		return e.Status == pb.Enrollment_TEACHER
 	})
 }
 
+// isTeacherOrTA returns true if the given user is teacher or teaching assistant for the given course.
+// Teaching assistants can only view, review and rebuild submissions.
+func (s *AutograderService) isTeacherOrTA(userID, courseID uint64) bool {
+	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
+		return e.Status == pb.Enrollment_TEACHER || e.Status == pb.Enrollment_TA
+	})
+}
+
+// isPeerReviewer returns true if the given user is a student of the given course,
+// assigned to review the given submission to an assignment in the same course.
+func (s *AutograderService) isPeerReviewer(userID, courseID, submissionID uint64) bool {
+	if userID == 0 || submissionID == 0 {
+		return false
+	}
+	peerReviewers, err := s.db.GetPeerReviewers(&pb.PeerReviewer{SubmissionID: submissionID, ReviewerID: userID})
+	if err != nil || len(peerReviewers) == 0 {
+		return false
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: peerReviewers[0].GetAssignmentID()})
+	if err != nil || assignment.GetCourseID() != courseID {
+		return false
+	}
+	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
+		return e.Status == pb.Enrollment_STUDENT
+	})
+}
+
+// isReviewer returns true if the given user is the author of the given review in the given course.
+func (s *AutograderService) isReviewer(userID, courseID, reviewID uint64) bool {
+	review, ok := s.getCourseReview(courseID, reviewID)
+	return ok && userID > 0 && review.GetReviewerID() == userID
+}
+
+// isSubmissionOwner returns true if the given user made the given submission,
+// either alone or as a member of the submitting group.
+func (s *AutograderService) isSubmissionOwner(usr *pb.User, submissionID uint64) bool {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return false
+	}
+	if submission.GetUserID() > 0 && usr.IsOwner(submission.GetUserID()) {
+		return true
+	}
+	// grp may be nil if the submission is not a group submission; this is fine, since the grp.Contains() returns false in this case.
+	grp, _ := s.getGroup(&pb.GetGroupRequest{GroupID: submission.GetGroupID()})
+	return grp.Contains(usr)
+}
+
+// getCourseReview returns the given review and true if it is of a submission
+// to an assignment in the given course.
+func (s *AutograderService) getCourseReview(courseID, reviewID uint64) (*pb.Review, bool) {
+	review, err := s.db.GetReview(&pb.Review{ID: reviewID})
+	if err != nil {
+		return nil, false
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: review.GetSubmissionID()})
+	if err != nil {
+		return nil, false
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil || assignment.GetCourseID() != courseID {
+		return nil, false
+	}
+	return review, true
+}
+
 // isCourseCreator returns true if the given user is course creator for the given course.
 func (s *AutograderService) isCourseCreator(courseID, userID uint64) bool {
 	course, _ := s.db.GetCourse(courseID, false)
@@ -0,0 +1,78 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+	"google.golang.org/grpc/metadata"
+)
+
+// getCurrentToken returns the API token that authenticated the request,
+// or nil if the request was authenticated with a session cookie.
+func (s *AutograderService) getCurrentToken(ctx context.Context) (*pb.APIToken, error) {
+	meta, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("malformed request")
+	}
+	tokenValues := meta.Get(auth.TokenKey)
+	if len(tokenValues) == 0 {
+		return nil, nil
+	}
+	tokenID, err := strconv.ParseUint(tokenValues[0], 10, 64)
+	if err != nil || tokenID == 0 {
+		return nil, errors.New("invalid token payload in context")
+	}
+	return s.db.GetAPIToken(&pb.APIToken{ID: tokenID})
+}
+
+// createAPIToken creates a new API token for the given user.
+// The returned token holds the token itself, which is not stored.
+func (s *AutograderService) createAPIToken(user *pb.User, request *pb.APITokenRequest) (*pb.APIToken, error) {
+	token, hash, err := auth.NewAPIToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	apiToken := &pb.APIToken{
+		UserID:      user.GetID(),
+		Name:        request.GetName(),
+		CourseID:    request.GetCourseID(),
+		ReadOnly:    request.GetReadOnly(),
+		CreatedDate: now.Format(pb.TimeLayout),
+		ExpiryDate:  now.AddDate(0, 0, int(request.GetDays())).Format(pb.TimeLayout),
+		Hash:        hash,
+	}
+	if err := s.db.CreateAPIToken(apiToken); err != nil {
+		return nil, err
+	}
+	apiToken.RemoveHash()
+	apiToken.Token = token
+	return apiToken, nil
+}
+
+// getAPITokens returns the API tokens of the given user.
+func (s *AutograderService) getAPITokens(user *pb.User) (*pb.APITokens, error) {
+	tokens, err := s.db.GetAPITokens(user.GetID())
+	if err != nil {
+		return nil, err
+	}
+	apiTokens := &pb.APITokens{Tokens: tokens}
+	apiTokens.RemoveHash()
+	return apiTokens, nil
+}
+
+// revokeAPIToken deletes the given user's API token with the given ID.
+func (s *AutograderService) revokeAPIToken(user *pb.User, tokenID uint64) error {
+	if tokenID == 0 {
+		return errors.New("missing token ID")
+	}
+	token, err := s.db.GetAPIToken(&pb.APIToken{ID: tokenID, UserID: user.GetID()})
+	if err != nil {
+		return err
+	}
+	return s.db.DeleteAPIToken(token.GetID())
+}
@@ -0,0 +1,133 @@
+package web_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPITokens(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{Code: "DAT100", Provider: "fake", OrganizationID: 1, CourseCreatorID: admin.ID}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	otherCourse := &pb.Course{Code: "DAT200", Provider: "fake", OrganizationID: 2, CourseCreatorID: admin.ID}
+	if err := db.CreateCourse(admin.ID, otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	adminCtx := withUserContext(context.Background(), admin)
+	studentCtx := withUserContext(context.Background(), student)
+
+	if _, err := callWithAccessControl(studentCtx, ags, "CreateAPIToken", &pb.APITokenRequest{Name: "other", CourseID: otherCourse.ID, Days: 30}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateAPIToken() for course without enrollment: expected permission denied, got %v", err)
+	}
+	readOnly, err := ags.CreateAPIToken(studentCtx, &pb.APITokenRequest{Name: "grades", ReadOnly: true, Days: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readOnly.GetToken() == "" || readOnly.GetHash() != "" || readOnly.GetExpiryDate() == "" {
+		t.Errorf("CreateAPIToken() = %+v, want token without hash", readOnly)
+	}
+	full, err := ags.CreateAPIToken(studentCtx, &pb.APITokenRequest{Name: "submit", Days: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	courseLimited, err := ags.CreateAPIToken(adminCtx, &pb.APITokenRequest{Name: "course", CourseID: course.ID, Days: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens, err := ags.GetAPITokens(studentCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens.GetTokens()) != 2 {
+		t.Fatalf("GetAPITokens() returned %d tokens, want 2", len(tokens.GetTokens()))
+	}
+	for _, token := range tokens.GetTokens() {
+		if token.GetToken() != "" || token.GetHash() != "" {
+			t.Errorf("GetAPITokens() returned token or hash: %+v", token)
+		}
+	}
+
+	interceptor := ags.AccessControl()
+	tests := []struct {
+		name    string
+		user    *pb.User
+		token   *pb.APIToken
+		method  string
+		request interface{}
+		allowed bool
+	}{
+		{"read-only token gets enrollments", student, readOnly, "GetEnrollmentsByCourse", &pb.EnrollmentRequest{CourseID: course.ID}, true},
+		{"read-only token submits commit", student, readOnly, "SubmitCommit", &pb.SubmitRequest{CourseID: course.ID}, false},
+		{"token submits commit", student, full, "SubmitCommit", &pb.SubmitRequest{CourseID: course.ID}, true},
+		{"read-only token gets peer review submissions", student, readOnly, "GetPeerReviewSubmissions", &pb.AssignmentRequest{CourseID: course.ID}, false},
+		{"token gets peer review submissions", student, full, "GetPeerReviewSubmissions", &pb.AssignmentRequest{CourseID: course.ID}, true},
+		{"token creates token", student, full, "CreateAPIToken", &pb.APITokenRequest{Name: "new", Days: 30}, false},
+		{"course token updates course enrollments", admin, courseLimited, "UpdateEnrollments", &pb.CourseRequest{CourseID: course.ID}, true},
+		{"course token updates other course enrollments", admin, courseLimited, "UpdateEnrollments", &pb.CourseRequest{CourseID: otherCourse.ID}, false},
+		{"course token gets users", admin, courseLimited, "GetUsers", &pb.Void{}, true},
+		{"course token updates user", admin, courseLimited, "UpdateUser", &pb.User{ID: student.ID}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return &pb.Void{}, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.AutograderService_ServiceDesc.ServiceName + "/" + tt.method}
+			_, err := interceptor(withTokenContext(tt.user, tt.token), tt.request, info, handler)
+			if tt.allowed != called {
+				t.Errorf("%s with token %q: called = %t, want %t (err: %v)", tt.method, tt.token.GetName(), called, tt.allowed, err)
+			}
+		})
+	}
+
+	// users can only revoke their own tokens
+	if _, err := ags.RevokeAPIToken(adminCtx, &pb.APIToken{ID: full.GetID()}); status.Code(err) != codes.NotFound {
+		t.Errorf("RevokeAPIToken() of another user's token: expected not found, got %v", err)
+	}
+	if _, err := ags.RevokeAPIToken(studentCtx, &pb.APIToken{ID: full.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err = ags.GetAPITokens(studentCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens.GetTokens()) != 1 || tokens.GetTokens()[0].GetID() != readOnly.GetID() {
+		t.Errorf("GetAPITokens() after revoking = %v, want only token %d", tokens.GetTokens(), readOnly.GetID())
+	}
+	if _, err := db.GetAPIToken(&pb.APIToken{Hash: auth.HashAPIToken(full.GetToken())}); err == nil {
+		t.Error("revoked token is still valid")
+	}
+}
+
+func withTokenContext(user *pb.User, token *pb.APIToken) context.Context {
+	meta := metadata.New(map[string]string{
+		auth.UserKey:  strconv.FormatUint(user.GetID(), 10),
+		auth.TokenKey: strconv.FormatUint(token.GetID(), 10),
+	})
+	return metadata.NewIncomingContext(context.Background(), meta)
+}
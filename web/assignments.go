@@ -9,6 +9,7 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -32,7 +33,8 @@ func (s *AutograderService) getAssignments(courseID uint64) (*pb.Assignments, er
 	return &pb.Assignments{Assignments: allAssignments}, nil
 }
 
-// updateAssignments updates the assignments for the given course.
+// updateAssignments updates the assignments for the given course,
+// and notifies the course's webhooks about the updated assignments.
 func (s *AutograderService) updateAssignments(ctx context.Context, sc scm.SCM, courseID uint64) error {
 	course, err := s.db.GetCourse(courseID, false)
 	if err != nil {
@@ -45,6 +47,7 @@ func (s *AutograderService) updateAssignments(ctx context.Context, sc scm.SCM, c
 	if err = s.db.UpdateAssignments(assignments); err != nil {
 		return err
 	}
+	s.notifier.Dispatch(notify.AssignmentUpdated, course.GetID(), &pb.Assignments{Assignments: assignments})
 	return nil
 }
 
@@ -107,26 +110,8 @@ func (s *AutograderService) loadCriteria(ctx context.Context, sc scm.SCM, reques
 		return nil, err
 	}
 
-	if len(assignment.GradingBenchmarks) > 0 {
-		if err := s.removeOldCriteriaAndReviews(assignment); err != nil {
-			return nil, err
-		}
-	}
-
-	for _, bm := range benchmarks {
-		bm.AssignmentID = assignment.ID
-		if err := s.db.CreateBenchmark(bm); err != nil {
-			return nil, err
-		}
-		for _, c := range bm.Criteria {
-			c.BenchmarkID = bm.ID
-			if err := s.db.CreateCriterion(c); err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	return benchmarks, nil
+	// the benchmarks are no longer loaded from a rubric template
+	return s.updateRubric(assignment, benchmarks, 0)
 }
 
 func (s *AutograderService) createReview(review *pb.Review) (*pb.Review, error) {
@@ -138,12 +123,18 @@ func (s *AutograderService) createReview(review *pb.Review) (*pb.Review, error)
 	if err != nil {
 		return nil, err
 	}
-	if len(submission.Reviews) >= int(assignment.Reviewers) {
+	if review.PeerReview {
+		if err := checkPeerReview(assignment, submission, review.ReviewerID); err != nil {
+			return nil, err
+		}
+	} else if len(submission.TeacherReviews()) >= int(assignment.Reviewers) {
 		return nil, fmt.Errorf("Failed to create a new review for submission %d to assignment %s: all %d reviews already created",
 			submission.ID, assignment.Name, assignment.Reviewers)
 	}
 	review.Edited = time.Now().Format(reviewLayout)
 	review.ComputeScore()
+	// code comments are added to the review with CreateCodeComment
+	review.CodeComments = nil
 
 	for _, bm := range review.GradingBenchmarks {
 		bm.ID = 0
@@ -154,18 +145,28 @@ func (s *AutograderService) createReview(review *pb.Review) (*pb.Review, error)
 	if err := s.db.CreateReview(review); err != nil {
 		return nil, err
 	}
-
+	if review.PeerReview {
+		if _, err := s.updatePeerScore(submission.ID); err != nil {
+			return nil, err
+		}
+	}
 	return review, nil
 }
 
-func (s *AutograderService) updateReview(review *pb.Review) (*pb.Review, error) {
-	if review.ID == 0 {
-		return nil, fmt.Errorf("Cannot update review with empty ID")
+// updateReview updates the stored review with the feedback, score and grading of the given review.
+// The review's submission, reviewer and kind are always taken from the stored review.
+func (s *AutograderService) updateReview(stored, review *pb.Review) (*pb.Review, error) {
+	if err := checkReviewGrading(stored, review); err != nil {
+		return nil, err
 	}
-	submission, err := s.db.GetSubmission(&pb.Submission{ID: review.SubmissionID})
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: stored.SubmissionID})
 	if err != nil {
 		return nil, err
 	}
+	review.ID = stored.ID
+	review.SubmissionID = stored.SubmissionID
+	review.ReviewerID = stored.ReviewerID
+	review.PeerReview = stored.PeerReview
 
 	review.Edited = time.Now().Format(reviewLayout)
 	review.ComputeScore()
@@ -184,6 +185,12 @@ func (s *AutograderService) updateReview(review *pb.Review) (*pb.Review, error)
 			}
 		}
 	}
+	if review.PeerReview {
+		if _, err := s.updatePeerScore(submission.ID); err != nil {
+			return nil, err
+		}
+		return review, nil
+	}
 	if submission.Released {
 		// Updated review will most probably have a new score. Update the submission score as well
 		// for submissions with released review.
@@ -196,24 +203,31 @@ func (s *AutograderService) updateReview(review *pb.Review) (*pb.Review, error)
 	return review, nil
 }
 
-func (s *AutograderService) removeOldCriteriaAndReviews(assignment *pb.Assignment) error {
-	for _, bm := range assignment.GradingBenchmarks {
+// checkReviewGrading returns an error if the given review grades benchmarks or criteria
+// that do not belong to the stored review. Otherwise, it sets the stored review,
+// assignment and benchmark of each benchmark and criterion, so that updates only match the stored rows.
+func checkReviewGrading(stored, review *pb.Review) error {
+	benchmarks := make(map[uint64]*pb.GradingBenchmark)
+	criteria := make(map[uint64]*pb.GradingCriterion)
+	for _, bm := range stored.GradingBenchmarks {
+		benchmarks[bm.ID] = bm
 		for _, c := range bm.Criteria {
-			if err := s.db.DeleteCriterion(c); err != nil {
-				fmt.Printf("Failed to delete criteria %v: %s\n", c, err)
-			}
-		}
-		if err := s.db.DeleteBenchmark(bm); err != nil {
-			fmt.Printf("Failed to delete benchmark %v: %s\n", bm, err)
+			criteria[c.ID] = c
 		}
 	}
-	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
-	if err != nil {
-		return err
-	}
-	for _, submission := range submissions {
-		if err := s.db.DeleteReview(&pb.Review{SubmissionID: submission.ID}); err != nil {
-			return err
+	for _, bm := range review.GradingBenchmarks {
+		storedBenchmark, ok := benchmarks[bm.ID]
+		if !ok {
+			return fmt.Errorf("benchmark %d does not belong to review %d", bm.ID, stored.ID)
+		}
+		bm.ReviewID = stored.ID
+		bm.AssignmentID = storedBenchmark.AssignmentID
+		for _, c := range bm.Criteria {
+			storedCriterion, ok := criteria[c.ID]
+			if !ok || storedCriterion.BenchmarkID != bm.ID {
+				return fmt.Errorf("criterion %d does not belong to benchmark %d of review %d", c.ID, bm.ID, stored.ID)
+			}
+			c.BenchmarkID = bm.ID
 		}
 	}
 	return nil
@@ -0,0 +1,265 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// auditTarget returns the stored record that a request changes, or nil if there is none.
+type auditTarget func(s *AutograderService, in interface{}) proto.Message
+
+// audited maps each mutating teacher and admin method to the record it changes.
+// A nil target records the method's response, or the request if the response is empty.
+var audited = map[string]auditTarget{
+	"UpdateUser":            userTarget,
+	"RevokeSessions":        nil,
+	"UpdateGroup":           groupTarget,
+	"DeleteGroup":           groupTarget,
+	"FormGroups":            nil,
+	"CreateGroup":           nil,
+	"CreateCourse":          nil,
+	"UpdateCourse":          courseTarget,
+	"UpdateChatIntegration": nil,
+	"DeleteChatIntegration": nil,
+	"UpdateWebhook":         nil,
+	"DeleteWebhook":         nil,
+	"UpdateAssignments":     nil,
+	"UpdateEnrollment":      enrollmentTarget,
+	"UpdateEnrollments":     nil,
+	"UpdateSlipDays":        enrollmentTarget,
+	"UpdateSubmission":      submissionTarget,
+	"UpdateSubmissions":     nil,
+	"ScheduleRelease":       nil,
+	"CancelRelease":         nil,
+	"RebuildSubmission":     submissionTarget,
+	"RebuildSubmissions":    nil,
+	"CreateBenchmark":       nil,
+	"UpdateBenchmark":       benchmarkTarget,
+	"DeleteBenchmark":       benchmarkTarget,
+	"CreateCriterion":       nil,
+	"UpdateCriterion":       criterionTarget,
+	"DeleteCriterion":       criterionTarget,
+	"CreateReview":          nil,
+	"UpdateReview":          reviewTarget,
+	"OverrideGrade":         nil,
+	"ModeratePeerReview":    reviewTarget,
+	"DistributeReviews":     nil,
+	"LoadCriteria":          nil,
+	"CreateRubricTemplate":  nil,
+	"ApplyRubricTemplate":   nil,
+	"CreateCodeComment":     nil,
+}
+
+// auditedRole holds the audited methods that students may also call. Calls to these methods
+// are only audited if the caller holds the given role in the request's course.
+var auditedRole = map[string]func(s *AutograderService, userID, courseID uint64) bool{
+	"CreateGroup":       (*AutograderService).isTeacher,
+	"CreateCodeComment": (*AutograderService).isTeacherOrTA,
+}
+
+// Audit returns a unary server interceptor that appends an entry to the audit log
+// for every successful call to an audited method. It must be chained after AccessControl.
+func (s *AutograderService) Audit() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		target, ok := audited[method]
+		if !ok || !strings.HasPrefix(info.FullMethod, "/"+pb.AutograderService_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+		usr, err := s.getCurrentUser(ctx)
+		if err != nil {
+			return handler(ctx, req)
+		}
+		res, err := policies[method].resource(s, req)
+		if hasRole, ok := auditedRole[method]; ok && (err != nil || !hasRole(s, usr.GetID(), res.courseID)) {
+			return handler(ctx, req)
+		}
+		entry := &pb.AuditLogEntry{ActorID: usr.GetID(), Action: method}
+		if err == nil {
+			entry.CourseID = res.courseID
+			entry.UserID = res.userID
+		}
+		var before proto.Message
+		if target != nil {
+			before = target(s, req)
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		var after interface{}
+		switch _, void := resp.(*pb.Void); {
+		case target != nil:
+			after = target(s, req)
+		case void:
+			after = req
+		default:
+			after = resp
+		}
+		record, _ := after.(proto.Message)
+		s.recordAudit(entry, before, record)
+		return resp, nil
+	}
+}
+
+// recordAudit completes the given entry with the records before and after the action and appends it to the audit log.
+func (s *AutograderService) recordAudit(entry *pb.AuditLogEntry, before, after proto.Message) {
+	for _, record := range []proto.Message{after, before} {
+		if record == nil {
+			continue
+		}
+		entry.Target = string(record.ProtoReflect().Descriptor().Name())
+		if r, ok := record.(interface{ GetID() uint64 }); ok && r.GetID() > 0 {
+			entry.Target = fmt.Sprintf("%s %d", entry.Target, r.GetID())
+		}
+		if r, ok := record.(interface{ GetUserID() uint64 }); ok && r.GetUserID() > 0 {
+			entry.UserID = r.GetUserID()
+		}
+		break
+	}
+	entry.Before = auditJSON(before)
+	entry.After = auditJSON(after)
+	entry.Date = time.Now().Format(pb.TimeLayout)
+	if err := s.db.CreateAuditLogEntry(entry); err != nil {
+		s.logger.Errorf("Failed to record %s by user %d in audit log: %v", entry.Action, entry.ActorID, err)
+	}
+}
+
+// auditJSON returns the JSON encoding of the given record without remote identities and secrets.
+func auditJSON(record proto.Message) string {
+	if record == nil {
+		return ""
+	}
+	record = proto.Clone(record)
+	switch r := record.(type) {
+	case *pb.Webhook:
+		r.Secret = ""
+	case *pb.ChatIntegration:
+		r.WebhookURL = ""
+		r.SigningSecret = ""
+	case interface{ RemoveRemoteID() }:
+		r.RemoveRemoteID()
+	}
+	b, err := protojson.Marshal(record)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// getAuditLog returns the audit log entries matching the given request.
+func (s *AutograderService) getAuditLog(request *pb.AuditLogRequest) (*pb.AuditLog, error) {
+	entries, err := s.db.GetAuditLog(request)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditLog{Entries: entries}, nil
+}
+
+func userTarget(s *AutograderService, in interface{}) proto.Message {
+	user, err := s.db.GetUser(in.(*pb.User).GetID())
+	if err != nil {
+		return nil
+	}
+	return user
+}
+
+func groupTarget(s *AutograderService, in interface{}) proto.Message {
+	var groupID uint64
+	switch r := in.(type) {
+	case *pb.Group:
+		groupID = r.GetID()
+	case *pb.GroupRequest:
+		groupID = r.GetGroupID()
+	}
+	if groupID == 0 {
+		return nil
+	}
+	group, err := s.db.GetGroup(groupID)
+	if err != nil {
+		return nil
+	}
+	return group
+}
+
+func courseTarget(s *AutograderService, in interface{}) proto.Message {
+	course, err := s.db.GetCourse(in.(*pb.Course).GetID(), false)
+	if err != nil {
+		return nil
+	}
+	return course
+}
+
+// enrollmentTarget returns the enrollment without the enrolled user and course.
+func enrollmentTarget(s *AutograderService, in interface{}) proto.Message {
+	r, ok := in.(interface {
+		GetCourseID() uint64
+		GetUserID() uint64
+	})
+	if !ok {
+		return nil
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(r.GetCourseID(), r.GetUserID())
+	if err != nil {
+		return nil
+	}
+	enrollment.User, enrollment.Course, enrollment.Group = nil, nil, nil
+	return enrollment
+}
+
+// submissionTarget returns the submission without its reviews and test results.
+func submissionTarget(s *AutograderService, in interface{}) proto.Message {
+	submissionID := in.(interface{ GetSubmissionID() uint64 }).GetSubmissionID()
+	if submissionID == 0 {
+		return nil
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil
+	}
+	submission.Reviews, submission.BuildInfo, submission.Scores = nil, nil, nil
+	return submission
+}
+
+// reviewTarget returns the review without its code comments.
+func reviewTarget(s *AutograderService, in interface{}) proto.Message {
+	var reviewID uint64
+	switch r := in.(type) {
+	case *pb.ReviewRequest:
+		reviewID = r.GetReview().GetID()
+	case *pb.PeerReviewModerationRequest:
+		reviewID = r.GetReviewID()
+	}
+	if reviewID == 0 {
+		return nil
+	}
+	review, err := s.db.GetReview(&pb.Review{ID: reviewID})
+	if err != nil {
+		return nil
+	}
+	review.CodeComments = nil
+	return review
+}
+
+func benchmarkTarget(s *AutograderService, in interface{}) proto.Message {
+	benchmark, err := s.db.GetBenchmark(in.(*pb.GradingBenchmark).GetID())
+	if err != nil {
+		return nil
+	}
+	return benchmark
+}
+
+func criterionTarget(s *AutograderService, in interface{}) proto.Message {
+	criterion, err := s.db.GetCriterion(in.(*pb.GradingCriterion).GetID())
+	if err != nil {
+		return nil
+	}
+	return criterion
+}
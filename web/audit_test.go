@@ -0,0 +1,167 @@
+package web
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditedMethods(t *testing.T) {
+	methods := make(map[string]bool)
+	for _, method := range pb.AutograderService_ServiceDesc.Methods {
+		methods[method.MethodName] = true
+	}
+	for name := range audited {
+		if !methods[name] {
+			t.Errorf("audited method %s is not a method of the service", name)
+		}
+	}
+}
+
+func TestPrivilegedMethodsAudited(t *testing.T) {
+	for method, p := range policies {
+		// methods that students and all users may call, or that only act on the caller's own records, are not privileged
+		privileged := p.roles&(roleAny|roleStudent) == 0 && p.roles&^(roleOwner|roleGroupMember) != 0
+		if privileged && !isReadOnly(method) {
+			if _, ok := audited[method]; !ok {
+				t.Errorf("privileged method %s is not audited", method)
+			}
+		}
+	}
+	for method := range auditedRole {
+		if _, ok := audited[method]; !ok {
+			t.Errorf("method %s audited for a role is not in the audited methods", method)
+		}
+	}
+}
+
+func TestAudit(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	if err := db.UpdateUser(&pb.User{ID: teacher.ID, IsAdmin: true}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{Code: "DAT100", Provider: "fake", OrganizationID: 1, CourseCreatorID: teacher.ID}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	if _, err := fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	ags := NewAutograderService(zap.NewNop(), db, scms, BaseHookOptions{}, &ci.Local{})
+	audit := ags.Audit()
+	accessControl := ags.AccessControl()
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.AutograderService_ServiceDesc.ServiceName + "/UpdateSubmission"}
+	// the server checks the access policy before auditing the call
+	updateSubmission := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return accessControl(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return audit(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return ags.UpdateSubmission(ctx, req.(*pb.UpdateSubmissionRequest))
+			})
+		})
+	}
+	request := &pb.UpdateSubmissionRequest{SubmissionID: submission.ID, CourseID: course.ID, Status: pb.Submission_APPROVED}
+
+	// failed calls are not recorded
+	if _, err := updateSubmission(userContext(student), request); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("UpdateSubmission() by student: expected permission denied, got %v", err)
+	}
+	if _, err := updateSubmission(userContext(teacher), request); err != nil {
+		t.Fatal(err)
+	}
+
+	teacherCtx := userContext(teacher)
+	auditLog, err := ags.GetAuditLog(teacherCtx, &pb.AuditLogRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auditLog.GetEntries()) != 1 {
+		t.Fatalf("GetAuditLog() returned %d entries, want 1", len(auditLog.GetEntries()))
+	}
+	entry := auditLog.GetEntries()[0]
+	if entry.GetActorID() != teacher.ID || entry.GetUserID() != student.ID || entry.GetCourseID() != course.ID ||
+		entry.GetAction() != "UpdateSubmission" || entry.GetDate() == "" {
+		t.Errorf("unexpected audit log entry: %+v", entry)
+	}
+	if !strings.HasPrefix(entry.GetTarget(), "Submission ") {
+		t.Errorf("audit log entry target = %q, want submission", entry.GetTarget())
+	}
+	if strings.Contains(entry.GetBefore(), "APPROVED") || !strings.Contains(entry.GetAfter(), "APPROVED") {
+		t.Errorf("audit log entry does not record approval: before %s, after %s", entry.GetBefore(), entry.GetAfter())
+	}
+
+	for _, query := range []struct {
+		request *pb.AuditLogRequest
+		want    int
+	}{
+		{&pb.AuditLogRequest{CourseID: course.ID, UserID: student.ID}, 1},
+		{&pb.AuditLogRequest{CourseID: course.ID, UserID: teacher.ID}, 1},
+		{&pb.AuditLogRequest{CourseID: course.ID, Action: "UpdateSubmission"}, 1},
+		{&pb.AuditLogRequest{CourseID: course.ID, Action: "DeleteGroup"}, 0},
+		{&pb.AuditLogRequest{CourseID: course.ID + 1}, 0},
+	} {
+		auditLog, err := ags.GetAuditLog(teacherCtx, query.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(auditLog.GetEntries()) != query.want {
+			t.Errorf("GetAuditLog(%+v) returned %d entries, want %d", query.request, len(auditLog.GetEntries()), query.want)
+		}
+	}
+
+	// methods that students may also call are only audited when called by teachers (or TAs)
+	for _, call := range []struct {
+		method  string
+		request interface{}
+		resp    interface{}
+	}{
+		{"CreateGroup", &pb.Group{CourseID: course.ID, Name: "group"}, &pb.Group{ID: 1, CourseID: course.ID}},
+		{"CreateCodeComment", &pb.CodeCommentRequest{CourseID: course.ID}, &pb.CodeComment{ID: 1}},
+	} {
+		info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.AutograderService_ServiceDesc.ServiceName + "/" + call.method}
+		handler := func(context.Context, interface{}) (interface{}, error) { return call.resp, nil }
+		for _, user := range []*pb.User{student, teacher} {
+			if _, err := audit(userContext(user), call.request, info, handler); err != nil {
+				t.Fatal(err)
+			}
+		}
+		auditLog, err := ags.GetAuditLog(teacherCtx, &pb.AuditLogRequest{CourseID: course.ID, Action: call.method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(auditLog.GetEntries()) != 1 || auditLog.GetEntries()[0].GetActorID() != teacher.ID {
+			t.Errorf("GetAuditLog(%s) = %v, want one entry for the teacher's call", call.method, auditLog.GetEntries())
+		}
+	}
+
+	info = &grpc.UnaryServerInfo{FullMethod: "/" + pb.AutograderService_ServiceDesc.ServiceName + "/GetAuditLog"}
+	getAuditLog := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return ags.GetAuditLog(ctx, req.(*pb.AuditLogRequest))
+	}
+	if _, err := accessControl(userContext(student), &pb.AuditLogRequest{CourseID: course.ID}, info, getAuditLog); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetAuditLog() by student: expected permission denied, got %v", err)
+	}
+}
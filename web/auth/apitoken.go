@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// apiTokenPrefix identifies QuickFeed API tokens, e.g., in secret scanners.
+const apiTokenPrefix = "qf_"
+
+// NewAPIToken returns a new random API token and its hash.
+// Only the hash should be stored.
+func NewAPIToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = apiTokenPrefix + hex.EncodeToString(b)
+	return token, HashAPIToken(token), nil
+}
+
+// HashAPIToken returns the hash of the given API token.
+func HashAPIToken(token string) string {
+	return hash(token)
+}
+
+// hash returns the hex-encoded SHA-256 hash of the given secret.
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,88 @@
+package auth_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUserVerifierAPIToken(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user := qtest.CreateFakeUser(t, db, 1)
+	validToken, validHash, err := auth.NewAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiredToken, expiredHash, err := auth.NewAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	valid := &pb.APIToken{UserID: user.ID, Name: "valid", Hash: validHash, ExpiryDate: now.Add(time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateAPIToken(valid); err != nil {
+		t.Fatal(err)
+	}
+	expired := &pb.APIToken{UserID: user.ID, Name: "expired", Hash: expiredHash, ExpiryDate: now.Add(-time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateAPIToken(expired); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := auth.Add(db, "session=apitoken", user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		meta      map[string]string
+		wantUser  string
+		wantToken string
+	}{
+		{"valid token", map[string]string{auth.Authorization: "Bearer " + validToken}, strconv.FormatUint(user.ID, 10), strconv.FormatUint(valid.ID, 10)},
+		{"expired token", map[string]string{auth.Authorization: "Bearer " + expiredToken}, "", ""},
+		{"unknown token", map[string]string{auth.Authorization: "Bearer qf_unknown"}, "", ""},
+		{"token hash", map[string]string{auth.Authorization: "Bearer " + validHash}, "", ""},
+		{"raw user metadata", map[string]string{auth.UserKey: strconv.FormatUint(user.ID, 10)}, "", ""},
+		{"session cookie", map[string]string{auth.Cookie: "session=apitoken"}, strconv.FormatUint(user.ID, 10), ""},
+		{"session cookie with spoofed token metadata", map[string]string{auth.Cookie: "session=apitoken", auth.TokenKey: strconv.FormatUint(valid.ID, 10)}, strconv.FormatUint(user.ID, 10), ""},
+		{"spoofed token metadata", map[string]string{auth.UserKey: strconv.FormatUint(user.ID, 10), auth.TokenKey: strconv.FormatUint(valid.ID, 10)}, "", ""},
+	}
+	verifier := auth.UserVerifier(db)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUser, gotToken string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				meta, _ := metadata.FromIncomingContext(ctx)
+				if values := meta.Get(auth.UserKey); len(values) > 0 {
+					gotUser = values[0]
+				}
+				if values := meta.Get(auth.TokenKey); len(values) > 0 {
+					gotToken = values[0]
+				}
+				return &pb.Void{}, nil
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.New(tt.meta))
+			_, err := verifier(ctx, &pb.Void{}, &grpc.UnaryServerInfo{FullMethod: "/ag.AutograderService/GetUser"}, handler)
+			if tt.wantUser == "" {
+				if err == nil {
+					t.Errorf("UserVerifier() accepted request with metadata %v", tt.meta)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UserVerifier() = %v", err)
+			}
+			if gotUser != tt.wantUser || gotToken != tt.wantToken {
+				t.Errorf("UserVerifier() set user %q and token %q, want %q and %q", gotUser, gotToken, tt.wantUser, tt.wantToken)
+			}
+		})
+	}
+}
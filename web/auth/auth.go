@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/database"
@@ -32,6 +33,12 @@ const (
 	OutgoingCookie = "Set-Cookie"
 )
 
+// Metadata keys for API tokens.
+const (
+	Authorization = "authorization" // holds "Bearer <token>"
+	TokenKey      = "token"         // ID of the API token that authenticated the request
+)
+
 // Query keys.
 const (
 	State    = "state" // As defined by the OAuth2 RFC.
@@ -55,29 +62,15 @@ func (us *UserSession) enableProvider(provider string) {
 	us.Providers[provider] = struct{}{}
 }
 
-// map from session cookies to user IDs.
-var cookieStore = make(map[string]uint64)
-
-// Add adds cookie for userID, replacing userID's current cookie, if any.
-func Add(cookie string, userID uint64) {
-	for currentCookie, id := range cookieStore {
-		if id == userID && currentCookie != cookie {
-			delete(cookieStore, currentCookie)
-		}
-	}
-	cookieStore[cookie] = userID
-}
-
-func Get(cookie string) uint64 {
-	return cookieStore[cookie]
-}
-
 // OAuth2Logout invalidates the session for the logged in user.
-func OAuth2Logout(logger *zap.Logger) echo.HandlerFunc {
+func OAuth2Logout(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		r := c.Request()
 		w := c.Response()
 
+		if err := Remove(db, requestSessionCookie(r)); err != nil {
+			logger.Error("failed to remove session", zap.Error(err))
+		}
 		sess, err := session.Get(SessionKey, c)
 		if err != nil {
 			logger.Error(err.Error())
@@ -131,7 +124,7 @@ func PreAuth(logger *zap.Logger, db database.Database) echo.MiddlewareFunc {
 				us := i.(*UserSession)
 				if _, err := db.GetUser(us.ID); err != nil {
 					logger.Error(err.Error())
-					return OAuth2Logout(logger)(c)
+					return OAuth2Logout(logger, db)(c)
 				}
 			}
 			return next(c)
@@ -151,16 +144,18 @@ func OAuth2Login(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 
-		var teacher int
-		if strings.HasSuffix(provider, TeacherSuffix) {
-			teacher = 1
+		teacher := strings.HasSuffix(provider, TeacherSuffix)
+		redirect := extractRedirectURL(r, Redirect)
+		state, err := newState(db, teacher, redirect)
+		if err != nil {
+			logger.Error("failed to create OAuth state", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
-
 		qv := r.URL.Query()
-		redirect := extractRedirectURL(r, Redirect)
-		// TODO: Add a random string to protect against CSRF.
-		qv.Set(State, strconv.Itoa(teacher)+redirect)
+		qv.Set(State, state)
 		r.URL.RawQuery = qv.Encode()
+		// The state is only accepted in the callback from the browser that started the login.
+		http.SetCookie(w, stateCookie(state, stateTTL))
 
 		url, err := gothic.GetAuthURL(w, r)
 		if err != nil {
@@ -179,15 +174,22 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 		w := c.Response()
 		r := c.Request()
 
+		state, err := verifyState(db, r)
+		if err != nil {
+			logger.Error("failed to verify OAuth state", zap.Error(err))
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		http.SetCookie(w, stateCookie("", 0))
+		redirect := state.GetRedirect()
+
 		qv := r.URL.Query()
-		redirect, teacher := extractState(r, State)
 		provider, err := gothic.GetProviderName(r)
 		if err != nil {
 			logger.Error("failed to get gothic provider", zap.Error(err))
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		// Add teacher suffix if upgrading scope.
-		if teacher {
+		if state.GetTeacher() {
 			qv.Set("provider", provider+TeacherSuffix)
 		}
 		r.URL.RawQuery = qv.Encode()
@@ -218,7 +220,7 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 			i, ok := sess.Values[UserKey]
 			if !ok {
 				logger.Debug("failed to get logged in user from session; logout")
-				return OAuth2Logout(logger)(c)
+				return OAuth2Logout(logger, db)(c)
 			}
 
 			// If type assertions fails, the recover middleware will catch the panic and log a stack trace.
@@ -237,9 +239,15 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 				logger.Error(err.Error())
 				return err
 			}
-			// Enable gRPC requests for session
+			// Enable gRPC requests for session, replacing the session's previous cookie.
 			if token := extractSessionCookie(w); len(token) > 0 {
-				Add(token, us.ID)
+				if err := Remove(db, requestSessionCookie(r)); err != nil {
+					logger.Error("failed to remove session", zap.Error(err))
+				}
+				if err := Add(db, token, us.ID); err != nil {
+					logger.Error("failed to add session", zap.Error(err))
+					return err
+				}
 			}
 			return c.Redirect(http.StatusFound, redirect)
 		}
@@ -296,7 +304,10 @@ func OAuth2Callback(logger *zap.Logger, db database.Database) echo.HandlerFunc {
 
 		// Register session and associated user ID to enable gRPC calls for the session.
 		if token := extractSessionCookie(w); len(token) > 0 {
-			Add(token, us.ID)
+			if err := Add(db, token, us.ID); err != nil {
+				logger.Error("failed to add session", zap.Error(err))
+				return err
+			}
 		}
 
 		return c.Redirect(http.StatusFound, redirect)
@@ -328,6 +339,11 @@ func AccessControl(logger *zap.Logger, db database.Database, scms *Scms) echo.Mi
 
 			// If type assertion fails, the recover middleware will catch the panic and log a stack trace.
 			us := i.(*UserSession)
+			// Invalidate sessions that have expired or been revoked.
+			if Get(db, requestSessionCookie(c.Request())) != us.ID {
+				logger.Info("session not found; logout", zap.Uint64("user", us.ID))
+				return OAuth2Logout(logger, db)(c)
+			}
 			user, err := db.GetUser(us.ID)
 			if err != nil {
 				logger.Error(err.Error())
@@ -335,7 +351,7 @@ func AccessControl(logger *zap.Logger, db database.Database, scms *Scms) echo.Mi
 				// from the database, but a valid session still exists.
 				if err == gorm.ErrRecordNotFound {
 					logger.Error(err.Error())
-					return OAuth2Logout(logger)(c)
+					return OAuth2Logout(logger, db)(c)
 				}
 				logger.Error(echo.ErrUnauthorized.Error())
 				return next(c)
@@ -369,27 +385,15 @@ func AccessControl(logger *zap.Logger, db database.Database, scms *Scms) echo.Mi
 	}
 }
 
+// extractRedirectURL returns the redirect URL given by key, or "/" if the URL is missing or not allowed.
 func extractRedirectURL(r *http.Request, key string) string {
-	// TODO: Validate redirect URL.
-
 	url := r.URL.Query().Get(key)
-	if url == "" {
+	if url == "" || !isAllowedRedirect(url) {
 		url = "/"
 	}
 	return url
 }
 
-func extractState(r *http.Request, key string) (redirect string, teacher bool) {
-	// TODO: Validate redirect URL.
-	url := r.URL.Query().Get(key)
-	teacher = url != "" && url[:1] == "1"
-
-	if url == "" || url[1:] == "" {
-		return "/", teacher
-	}
-	return url[1:], teacher
-}
-
 func extractSessionCookie(w *echo.Response) string {
 	// Helper function that extracts an outgoing session cookie.
 	outgoingCookies := w.Header().Values(OutgoingCookie)
@@ -405,15 +409,18 @@ func extractSessionCookie(w *echo.Response) string {
 var (
 	ErrInvalidSessionCookie = status.Errorf(codes.Unauthenticated, "Request does not contain a valid session cookie.")
 	ErrContextMetadata      = status.Errorf(codes.Unauthenticated, "Could not obtain metadata from context")
+	ErrInvalidAPIToken      = status.Errorf(codes.Unauthenticated, "Request does not contain a valid API token.")
 )
 
-func UserVerifier() grpc.UnaryServerInterceptor {
+// UserVerifier returns a unary server interceptor that authenticates the user
+// with a session cookie, or with an API token given as a bearer token.
+func UserVerifier(db database.Database) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		meta, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
 			return nil, ErrContextMetadata
 		}
-		newMeta, err := userValidation(meta)
+		newMeta, err := userValidation(db, meta)
 		if err != nil {
 			return nil, err
 		}
@@ -424,9 +431,24 @@ func UserVerifier() grpc.UnaryServerInterceptor {
 }
 
 // userValidation returns modified metadata containing a valid user. An error is returned if the user is not authenticated.
-func userValidation(meta metadata.MD) (metadata.MD, error) {
+// Requests authenticated with an API token also contain the token's ID.
+func userValidation(db database.Database, meta metadata.MD) (metadata.MD, error) {
+	delete(meta, TokenKey)
+	for _, authorization := range meta.Get(Authorization) {
+		token := strings.TrimPrefix(authorization, "Bearer ")
+		if token == authorization || token == "" {
+			continue
+		}
+		apiToken, err := db.GetAPIToken(&pb.APIToken{Hash: HashAPIToken(token)})
+		if err != nil || apiToken.IsExpired(time.Now()) {
+			return nil, ErrInvalidAPIToken
+		}
+		meta.Set(UserKey, strconv.FormatUint(apiToken.GetUserID(), 10))
+		meta.Set(TokenKey, strconv.FormatUint(apiToken.GetID(), 10))
+		return meta, nil
+	}
 	for _, cookie := range meta.Get(Cookie) {
-		if user := Get(cookie); user > 0 {
+		if user := Get(db, cookie); user > 0 {
 			meta.Set(UserKey, strconv.FormatUint(user, 10))
 			return meta, nil
 		}
@@ -3,10 +3,15 @@ package auth_test
 import (
 	"testing"
 
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
 	"github.com/autograde/quickfeed/web/auth"
 )
 
 func TestAddGetCookie(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
 	tests := []struct {
 		cookie string
 		id     uint64
@@ -16,10 +21,47 @@ func TestAddGetCookie(t *testing.T) {
 		{cookie: "cookie2", id: 1, wantID: 1},
 	}
 	for _, test := range tests {
-		auth.Add(test.cookie, test.id)
-		got := auth.Get(test.cookie)
+		if err := auth.Add(db, test.cookie, test.id); err != nil {
+			t.Fatal(err)
+		}
+		got := auth.Get(db, test.cookie)
 		if got != test.wantID {
 			t.Errorf("Get(%s) = %d, want %d", test.cookie, got, test.wantID)
 		}
 	}
 }
+
+func TestRemoveCookie(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	for _, cookie := range []string{"cookie1", "cookie2"} {
+		if err := auth.Add(db, cookie, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := auth.Remove(db, "cookie1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := auth.Get(db, "cookie1"); got != 0 {
+		t.Errorf("Get(cookie1) after Remove = %d, want 0", got)
+	}
+	if got := auth.Get(db, "cookie2"); got != 1 {
+		t.Errorf("Get(cookie2) = %d, want 1", got)
+	}
+	// sessions are stored as hashes of the cookie
+	sessions, err := db.GetSessions(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].GetHash() == "cookie2" {
+		t.Errorf("GetSessions(1) = %v, want one hashed session", sessions)
+	}
+	// expired sessions are not accepted
+	if err := db.CreateSession(&pb.Session{UserID: 2, Hash: auth.HashAPIToken("expired"), ExpiryDate: "2020-01-01T00:00:00"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := auth.Get(db, "expired"); got != 0 {
+		t.Errorf("Get(expired) = %d, want 0", got)
+	}
+}
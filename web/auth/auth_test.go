@@ -3,10 +3,12 @@ package auth_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/internal/qtest"
 	"github.com/autograde/quickfeed/web/auth"
 	"github.com/gorilla/sessions"
@@ -35,9 +37,18 @@ func init() {
 }
 
 func TestOAuth2Logout(t *testing.T) {
+	const token = "test"
+
 	r := httptest.NewRequest(http.MethodGet, logoutURL, nil)
+	r.AddCookie(&http.Cookie{Name: auth.SessionKey, Value: token})
 	w := httptest.NewRecorder()
 
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	if err := auth.Add(db, auth.SessionKey+"="+token, 1); err != nil {
+		t.Fatal(err)
+	}
+
 	store := newStore()
 	gothic.Store = store
 
@@ -61,7 +72,7 @@ func TestOAuth2Logout(t *testing.T) {
 		t.Errorf("have %d sessions want %d", ns, 2)
 	}
 
-	authHandler := auth.OAuth2Logout(zap.NewNop())
+	authHandler := auth.OAuth2Logout(zap.NewNop(), db)
 	withSession := session.Middleware(store)(authHandler)
 
 	if err := withSession(c); err != nil {
@@ -73,6 +84,10 @@ func TestOAuth2Logout(t *testing.T) {
 	if ns != 0 {
 		t.Errorf("have %d sessions want %d", ns, 0)
 	}
+	// The server-side session should be removed.
+	if id := auth.Get(db, auth.SessionKey+"="+token); id != 0 {
+		t.Errorf("Get() after logout = %d, want 0", id)
+	}
 }
 
 func TestOAuth2LoginRedirect(t *testing.T) {
@@ -262,11 +277,16 @@ func testOAuth2Callback(t *testing.T, existingUser, haveSession bool) {
 		remoteID = 0
 		secret   = "secret"
 	)
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	state, stateCookie := startLogin(t, db, authURL)
 	r := httptest.NewRequest(http.MethodGet, authURL, nil)
+	r.AddCookie(stateCookie)
 	w := httptest.NewRecorder()
 
 	qv := r.URL.Query()
-	qv.Set(auth.State, "0"+r.URL.Query().Get(auth.Redirect))
+	qv.Set(auth.State, state)
 	r.URL.RawQuery = qv.Encode()
 
 	store := newStore()
@@ -293,9 +313,6 @@ func testOAuth2Callback(t *testing.T, existingUser, haveSession bool) {
 		}
 	}
 
-	db, cleanup := qtest.TestDB(t)
-	defer cleanup()
-
 	if existingUser {
 		if err := db.CreateUserFromRemoteIdentity(&pb.User{}, &pb.RemoteIdentity{
 			Provider:    provider,
@@ -365,11 +382,53 @@ func TestAccessControl(t *testing.T) {
 
 	// Add cookie to mimic logged in request
 	c.Request().AddCookie(&http.Cookie{Name: auth.SessionKey, Value: token})
+	if err := auth.Add(db, auth.SessionKey+"="+token, 1); err != nil {
+		t.Fatal(err)
+	}
 
 	// User is logged in.
 	if err := protected(c); err != nil {
 		t.Error(err)
 	}
+	assertCode(t, w.Code, http.StatusOK)
+
+	// User is logged out when the session is revoked.
+	if err := db.DeleteSessions(&pb.Session{UserID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	w = httptest.NewRecorder()
+	if err := protected(e.NewContext(r, w)); err != nil {
+		t.Error(err)
+	}
+	assertCode(t, w.Code, http.StatusFound)
+}
+
+// startLogin starts a login with the given URL and returns the OAuth state
+// and the cookie that binds the state to the browser.
+func startLogin(t *testing.T, db database.Database, loginURL string) (string, *http.Cookie) {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, loginURL, nil)
+	w := httptest.NewRecorder()
+	store := newStore()
+	gothic.Store = store
+	c := echo.New().NewContext(r, w)
+
+	authHandler := auth.OAuth2Login(zap.NewNop(), db)
+	if err := session.Middleware(store)(authHandler)(c); err != nil {
+		t.Fatal(err)
+	}
+	location, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := location.Query().Get(auth.State)
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == auth.StateCookie && cookie.Value == state {
+			return state, cookie
+		}
+	}
+	t.Fatalf("login did not set a cookie for state %q", state)
+	return "", nil
 }
 
 func assertCode(t *testing.T, haveCode, wantCode int) {
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+)
+
+// SessionMaxAge is how long a user stays logged in.
+const SessionMaxAge = 30 * 24 * time.Hour
+
+// minSessionKeyLength is the minimum length of a session key, as recommended by gorilla/securecookie.
+const minSessionKeyLength = 32
+
+var ErrShortSessionKey = errors.New("session keys must be at least 32 characters")
+
+// SessionKeys returns the session keys in the given comma-separated list, newest first.
+// New session cookies are signed with the first key; cookies signed with the other keys
+// are accepted until the keys are removed, which allows keys to be rotated without logging users out.
+// The returned keys can be passed to sessions.NewCookieStore.
+func SessionKeys(keys string) ([][]byte, error) {
+	var keyPairs [][]byte
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if len(key) < minSessionKeyLength {
+			return nil, ErrShortSessionKey
+		}
+		// sessions are signed, but not encrypted
+		keyPairs = append(keyPairs, []byte(key), nil)
+	}
+	return keyPairs, nil
+}
+
+// NewSessionKey returns a random session key.
+func NewSessionKey() ([]byte, error) {
+	key := make([]byte, minSessionKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Add registers the session cookie for userID, replacing any session with the same cookie,
+// and enables gRPC requests with the cookie. The session is stored in the database,
+// so that it survives restarts and is shared between servers.
+func Add(db database.Database, cookie string, userID uint64) error {
+	now := time.Now()
+	if err := db.DeleteExpiredSessions(now.Format(pb.TimeLayout)); err != nil {
+		return err
+	}
+	if err := Remove(db, cookie); err != nil {
+		return err
+	}
+	return db.CreateSession(&pb.Session{
+		UserID:      userID,
+		CreatedDate: now.Format(pb.TimeLayout),
+		ExpiryDate:  now.Add(SessionMaxAge).Format(pb.TimeLayout),
+		Hash:        hash(cookie),
+	})
+}
+
+// Get returns the ID of the user logged in with the session cookie, or zero if the session
+// does not exist, has expired or has been revoked.
+func Get(db database.Database, cookie string) uint64 {
+	if cookie == "" {
+		return 0
+	}
+	session, err := db.GetSession(&pb.Session{Hash: hash(cookie)})
+	if err != nil || session.IsExpired(time.Now()) {
+		return 0
+	}
+	return session.GetUserID()
+}
+
+// Remove removes the session with the given session cookie.
+func Remove(db database.Database, cookie string) error {
+	if cookie == "" {
+		return nil
+	}
+	return db.DeleteSessions(&pb.Session{Hash: hash(cookie)})
+}
+
+// requestSessionCookie returns the request's session cookie in the same form as extractSessionCookie.
+func requestSessionCookie(r *http.Request) string {
+	cookie, err := r.Cookie(SessionKey)
+	if err != nil {
+		return ""
+	}
+	return SessionKey + "=" + cookie.Value
+}
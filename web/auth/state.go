@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+)
+
+// StateCookie is the name of the cookie that binds an OAuth state value to the browser that started the login.
+const StateCookie = "oauthstate"
+
+// stateTTL is how long a login may take before its OAuth state expires.
+const stateTTL = 10 * time.Minute
+
+var (
+	ErrInvalidState  = errors.New("invalid or expired OAuth state")
+	ErrStateMismatch = errors.New("OAuth state does not match the login started by this browser")
+)
+
+// newState returns a new state value for a login that redirects to the given URL when completed.
+// The state is stored in the database, so that the callback may be handled by another server
+// or after a restart, and can only be used once.
+func newState(db database.Database, teacher bool, redirect string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	value := hex.EncodeToString(b)
+	now := time.Now()
+	if err := db.DeleteExpiredOAuthStates(now.Format(pb.TimeLayout)); err != nil {
+		return "", err
+	}
+	if err := db.CreateOAuthState(&pb.OAuthState{
+		Hash:       hash(value),
+		Teacher:    teacher,
+		Redirect:   redirect,
+		ExpiryDate: now.Add(stateTTL).Format(pb.TimeLayout),
+	}); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// stateCookie returns a cookie that binds the given state value to the browser.
+// A zero maxAge deletes the cookie.
+func stateCookie(value string, maxAge time.Duration) *http.Cookie {
+	cookie := &http.Cookie{
+		Name:     StateCookie,
+		Value:    value,
+		Path:     "/auth",
+		MaxAge:   int(maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if maxAge == 0 {
+		cookie.MaxAge = -1
+	}
+	return cookie
+}
+
+// verifyState returns the login for the request's state value if the login was started by the same browser.
+// The state value cannot be used again.
+func verifyState(db database.Database, r *http.Request) (*pb.OAuthState, error) {
+	value := r.URL.Query().Get(State)
+	cookie, err := r.Cookie(StateCookie)
+	if err != nil || value == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(value)) != 1 {
+		return nil, ErrStateMismatch
+	}
+	state, err := db.ConsumeOAuthState(hash(value))
+	if err != nil || state.IsExpired(time.Now()) {
+		return nil, ErrInvalidState
+	}
+	return state, nil
+}
+
+// redirectHosts holds the hosts, other than QuickFeed itself, that users may be redirected to after login and logout.
+var redirectHosts = struct {
+	sync.RWMutex
+	hosts map[string]bool
+}{hosts: make(map[string]bool)}
+
+// AllowRedirectHosts adds the given hosts to the hosts that users may be redirected to after login and logout.
+// Relative redirect URLs are always allowed.
+func AllowRedirectHosts(hosts ...string) {
+	redirectHosts.Lock()
+	defer redirectHosts.Unlock()
+	for _, host := range hosts {
+		if host != "" {
+			redirectHosts.hosts[strings.ToLower(host)] = true
+		}
+	}
+}
+
+// isAllowedRedirect returns true if the given URL is a path on this server or a URL on an allowed host.
+func isAllowedRedirect(redirect string) bool {
+	// browsers ignore tabs and newlines in URLs and treat backslashes as slashes
+	if strings.ContainsAny(redirect, "\\\t\r\n") {
+		return false
+	}
+	u, err := url.Parse(redirect)
+	if err != nil || u.User != nil {
+		return false
+	}
+	if u.Scheme == "" && u.Host == "" {
+		return strings.HasPrefix(redirect, "/") && !strings.HasPrefix(redirect, "//")
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return false
+	}
+	redirectHosts.RLock()
+	defer redirectHosts.RUnlock()
+	return redirectHosts.hosts[strings.ToLower(u.Host)]
+}
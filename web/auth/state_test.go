@@ -0,0 +1,142 @@
+package auth_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/markbates/goth/gothic"
+	"go.uber.org/zap"
+)
+
+func TestOAuth2CallbackLoginCSRF(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	attackerState, attackerCookie := startLogin(t, db, authURL)
+	_, victimCookie := startLogin(t, db, authURL)
+	expiredState := "0123456789abcdef"
+	sum := sha256.Sum256([]byte(expiredState))
+	if err := db.CreateOAuthState(&pb.OAuthState{Hash: hex.EncodeToString(sum[:]), ExpiryDate: "2021-01-01T12:00:00"}); err != nil {
+		t.Fatal(err)
+	}
+	forged := attackerState[:len(attackerState)-1] + "0"
+	if forged == attackerState {
+		forged = attackerState[:len(attackerState)-1] + "1"
+	}
+
+	tests := []struct {
+		name   string
+		state  string
+		cookie *http.Cookie
+	}{
+		{"no state", "", attackerCookie},
+		{"state without cookie", attackerState, nil},
+		{"state from another browser", attackerState, victimCookie},
+		{"forged state", forged, &http.Cookie{Name: auth.StateCookie, Value: forged}},
+		{"unknown state", "01234567", &http.Cookie{Name: auth.StateCookie, Value: "01234567"}},
+		{"expired state", expiredState, &http.Cookie{Name: auth.StateCookie, Value: expiredState}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := completeLogin(t, db, tt.state, tt.cookie); !isBadRequest(err) {
+				t.Errorf("OAuth2Callback(%q): expected bad request, got %v", tt.state, err)
+			}
+		})
+	}
+
+	// the attacker's state is still unused and works in the browser that started the login, but only once
+	w, err := completeLogin(t, db, attackerState, attackerCookie)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCode(t, w.Code, http.StatusFound)
+	if _, err := completeLogin(t, db, attackerState, attackerCookie); !isBadRequest(err) {
+		t.Errorf("OAuth2Callback() with used state: expected bad request, got %v", err)
+	}
+}
+
+func TestOAuth2OpenRedirect(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	auth.AllowRedirectHosts("quickfeed.example.com")
+	tests := []struct {
+		redirect string
+		want     string
+	}{
+		{"", "/"},
+		{"/app/courses", "/app/courses"},
+		{"https://quickfeed.example.com/app", "https://quickfeed.example.com/app"},
+		{"https://QuickFeed.example.com/app", "https://QuickFeed.example.com/app"},
+		{"https://evil.example.com/app", "/"},
+		{"https://quickfeed.example.com.evil.example.com/", "/"},
+		{"https://quickfeed.example.com@evil.example.com/", "/"},
+		{"https://user@quickfeed.example.com/", "/"},
+		{"//evil.example.com", "/"},
+		{"/\\evil.example.com", "/"},
+		{"\\\\evil.example.com", "/"},
+		{"/\t/evil.example.com", "/"},
+		{"javascript:alert(1)", "/"},
+		{"app/courses", "/"},
+	}
+	for _, tt := range tests {
+		query := "?provider=fake&redirect=" + url.QueryEscape(tt.redirect)
+
+		state, cookie := startLogin(t, db, "/auth"+query)
+		w, err := completeLogin(t, db, state, cookie)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if location := w.Header().Get("Location"); location != tt.want {
+			t.Errorf("login with redirect %q: have Location %q want %q", tt.redirect, location, tt.want)
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/logout"+query, nil)
+		w = httptest.NewRecorder()
+		store := newStore()
+		c := echo.New().NewContext(r, w)
+		if err := session.Middleware(store)(auth.OAuth2Logout(zap.NewNop(), db))(c); err != nil {
+			t.Fatal(err)
+		}
+		if location := w.Header().Get("Location"); location != tt.want {
+			t.Errorf("logout with redirect %q: have Location %q want %q", tt.redirect, location, tt.want)
+		}
+	}
+}
+
+// completeLogin calls the OAuth2 callback with the given state and state cookie.
+func completeLogin(t *testing.T, db database.Database, state string, stateCookie *http.Cookie) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, "/auth/fake/callback?provider=fake", nil)
+	if stateCookie != nil {
+		r.AddCookie(stateCookie)
+	}
+	qv := r.URL.Query()
+	qv.Set(auth.State, state)
+	r.URL.RawQuery = qv.Encode()
+	w := httptest.NewRecorder()
+
+	store := newStore()
+	gothic.Store = store
+	if _, err := gothic.GetAuthURL(w, r); err != nil {
+		t.Fatal(err)
+	}
+	c := echo.New().NewContext(r, w)
+	authHandler := auth.OAuth2Callback(zap.NewNop(), db)
+	return w, session.Middleware(store)(authHandler)(c)
+}
+
+func isBadRequest(err error) bool {
+	httpErr, ok := err.(*echo.HTTPError)
+	return ok && httpErr.Code == http.StatusBadRequest
+}
@@ -3,14 +3,17 @@ package web
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/notify"
 	scms "github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web/auth"
 )
@@ -23,17 +26,21 @@ type AutograderService struct {
 	scms   *auth.Scms
 	bh     BaseHookOptions
 	runner ci.Runner
+	// notifier delivers notifications to users
+	notifier *notify.Notifier
 	pb.UnimplementedAutograderServiceServer
 }
 
 // NewAutograderService returns an AutograderService object.
 func NewAutograderService(logger *zap.Logger, db database.Database, scms *auth.Scms, bh BaseHookOptions, runner ci.Runner) *AutograderService {
+	sugar := logger.Sugar()
 	return &AutograderService{
-		logger: logger.Sugar(),
-		db:     db,
-		scms:   scms,
-		bh:     bh,
-		runner: runner,
+		logger:   sugar,
+		db:       db,
+		scms:     scms,
+		bh:       bh,
+		runner:   runner,
+		notifier: notify.NewNotifier(sugar, db, nil),
 	}
 }
 
@@ -57,15 +64,11 @@ func (s *AutograderService) GetUser(ctx context.Context, in *pb.Void) (*pb.User,
 // Access policy: Admin.
 // Frontend note: This method is called from AdminPage.
 func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.Users, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetUsers failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !usr.IsAdmin {
-		s.logger.Error("GetUsers failed: user is not admin")
-		return nil, status.Error(codes.PermissionDenied, "only admin can access other users")
-	}
 	users, err := s.getUsers()
 	if err != nil {
 		s.logger.Errorf("GetUsers failed: %v", err)
@@ -74,6 +77,137 @@ func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.User
 	return users, nil
 }
 
+// GetNotificationSettings returns the notification categories that the current user has opted out of.
+// Access policy: Any User.
+func (s *AutograderService) GetNotificationSettings(ctx context.Context, in *pb.Void) (*pb.NotificationSettings, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetNotificationSettings failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	settings, err := s.getNotificationSettings(usr.GetID())
+	if err != nil {
+		s.logger.Errorf("GetNotificationSettings failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get notification settings")
+	}
+	return settings, nil
+}
+
+// UpdateNotificationSettings replaces the notification categories that the current user has opted out of.
+// Access policy: Any User.
+func (s *AutograderService) UpdateNotificationSettings(ctx context.Context, in *pb.NotificationSettings) (*pb.NotificationSettings, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateNotificationSettings failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	settings, err := s.updateNotificationSettings(usr.GetID(), in)
+	if err != nil {
+		s.logger.Errorf("UpdateNotificationSettings failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update notification settings")
+	}
+	return settings, nil
+}
+
+// UpdateChatIdentity links the current user to the user's account on a chat platform,
+// allowing the user to query QuickFeed with slash commands from the chat platform.
+// Access policy: Any User.
+func (s *AutograderService) UpdateChatIdentity(ctx context.Context, in *pb.ChatIdentity) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateChatIdentity failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.updateChatIdentity(usr, in); err != nil {
+		s.logger.Errorf("UpdateChatIdentity failed: %v", err)
+		return nil, status.Error(codes.AlreadyExists, "failed to link chat account; it may be linked to another user")
+	}
+	return &pb.Void{}, nil
+}
+
+// CreateAPIToken creates a personal API token for the current user, optionally limited
+// to a course or to read-only access. The token itself is only returned by this call.
+// Access policy: Any User; the user must be enrolled in the course the token is limited to.
+func (s *AutograderService) CreateAPIToken(ctx context.Context, in *pb.APITokenRequest) (*pb.APIToken, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateAPIToken failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if in.GetCourseID() > 0 && !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("CreateAPIToken failed: user %s is not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only enrolled users can create tokens for a course")
+	}
+	token, err := s.createAPIToken(usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateAPIToken failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create API token")
+	}
+	return token, nil
+}
+
+// GetAPITokens returns the current user's API tokens, without the tokens themselves.
+// Access policy: Any User.
+func (s *AutograderService) GetAPITokens(ctx context.Context, in *pb.Void) (*pb.APITokens, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAPITokens failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	tokens, err := s.getAPITokens(usr)
+	if err != nil {
+		s.logger.Errorf("GetAPITokens failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get API tokens")
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken revokes one of the current user's API tokens.
+// Access policy: Owner of the token.
+func (s *AutograderService) RevokeAPIToken(ctx context.Context, in *pb.APIToken) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RevokeAPIToken failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.revokeAPIToken(usr, in.GetID()); err != nil {
+		s.logger.Errorf("RevokeAPIToken failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to revoke API token")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetSessions returns the login sessions of the given user.
+// Access policy: Admin.
+func (s *AutograderService) GetSessions(ctx context.Context, in *pb.UserRequest) (*pb.Sessions, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSessions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	sessions, err := s.getSessions(in.GetUserID())
+	if err != nil {
+		s.logger.Errorf("GetSessions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get sessions")
+	}
+	return sessions, nil
+}
+
+// RevokeSessions logs the given user out of the given session, or out of all the user's sessions.
+// Access policy: Admin.
+func (s *AutograderService) RevokeSessions(ctx context.Context, in *pb.SessionRequest) (*pb.Void, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RevokeSessions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.revokeSessions(in); err != nil {
+		s.logger.Errorf("RevokeSessions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to revoke sessions")
+	}
+	return &pb.Void{}, nil
+}
+
 // GetUserByCourse returns the user matching the given course name and GitHub login
 // specified in CourseUserRequest.
 // Access policy: Admins or course teachers
@@ -101,10 +235,6 @@ func (s *AutograderService) UpdateUser(ctx context.Context, in *pb.User) (*pb.Vo
 		s.logger.Errorf("UpdateUser failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !(usr.IsAdmin || usr.IsOwner(in.GetID())) {
-		s.logger.Errorf("UpdateUser failed to update user %d: user is not admin or course creator", in.GetID())
-		return nil, status.Error(codes.PermissionDenied, "only admin can update another user's information")
-	}
 	if _, err = s.updateUser(usr, in); err != nil {
 		s.logger.Errorf("UpdateUser failed to update user %d: %v", in.GetID(), err)
 		err = status.Error(codes.InvalidArgument, "failed to update user")
@@ -134,10 +264,6 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 		s.logger.Errorf("CreateCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !usr.IsAdmin {
-		s.logger.Error("CreateCourse failed: user is not admin")
-		return nil, status.Error(codes.PermissionDenied, "user must be admin to create course")
-	}
 
 	// make sure that the current user is set as course creator
 	in.CourseCreatorID = usr.GetID()
@@ -163,16 +289,11 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 // UpdateCourse changes the course information details.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateCourse(ctx context.Context, in *pb.Course) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCM(ctx, in.Provider)
+	_, scm, err := s.getUserAndSCM(ctx, in.Provider)
 	if err != nil {
 		s.logger.Errorf("UpdateCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	courseID := in.GetID()
-	if !s.isTeacher(usr.GetID(), courseID) {
-		s.logger.Error("UpdateCourse failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update course")
-	}
 
 	if err = s.updateCourse(ctx, scm, in); err != nil {
 		s.logger.Errorf("UpdateCourse failed: %v", err)
@@ -213,15 +334,11 @@ func (s *AutograderService) GetCourses(ctx context.Context, in *pb.Void) (*pb.Co
 // UpdateCourseVisibility allows to edit what courses are visible in the sidebar.
 // Access policy: Any User.
 func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("ChangeCourseVisibility failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !usr.IsOwner(in.GetUserID()) {
-		s.logger.Errorf("ChangeCourseVisibility failed: user %d attempts to update enrollment for user %d", usr.GetID(), in.GetUserID())
-		return nil, status.Error(codes.PermissionDenied, "users cannot set course visibility for another users")
-	}
 	err = s.changeCourseVisibility(in)
 	if err != nil {
 		s.logger.Errorf("ChangeCourseVisibility failed: %v", err)
@@ -230,6 +347,116 @@ func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.E
 	return &pb.Void{}, err
 }
 
+// UpdateChatIntegration creates or updates a chat integration that posts course events to a chat channel.
+// Access policy: Creator of CourseID.
+func (s *AutograderService) UpdateChatIntegration(ctx context.Context, in *pb.ChatIntegration) (*pb.ChatIntegration, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateChatIntegration failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	integration, err := s.updateChatIntegration(in)
+	if err != nil {
+		s.logger.Errorf("UpdateChatIntegration failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update chat integration")
+	}
+	return integration, nil
+}
+
+// GetChatIntegrations returns the chat integrations of the given course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetChatIntegrations(ctx context.Context, in *pb.CourseRequest) (*pb.ChatIntegrations, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetChatIntegrations failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	integrations, err := s.getChatIntegrations(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetChatIntegrations failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get chat integrations")
+	}
+	return integrations, nil
+}
+
+// DeleteChatIntegration removes a chat integration from the course.
+// Access policy: Creator of CourseID.
+func (s *AutograderService) DeleteChatIntegration(ctx context.Context, in *pb.ChatIntegration) (*pb.Void, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DeleteChatIntegration failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.deleteChatIntegration(in); err != nil {
+		s.logger.Errorf("DeleteChatIntegration failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to delete chat integration")
+	}
+	return &pb.Void{}, nil
+}
+
+// UpdateWebhook creates or updates an outbound webhook that course events are delivered to.
+// Access policy: Creator of CourseID.
+func (s *AutograderService) UpdateWebhook(ctx context.Context, in *pb.Webhook) (*pb.Webhook, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateWebhook failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	webhook, err := s.updateWebhook(in)
+	if err != nil {
+		s.logger.Errorf("UpdateWebhook failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update webhook")
+	}
+	return webhook, nil
+}
+
+// GetWebhooks returns the outbound webhooks of the given course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetWebhooks(ctx context.Context, in *pb.CourseRequest) (*pb.Webhooks, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetWebhooks failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	webhooks, err := s.getWebhooks(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetWebhooks failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get webhooks")
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook removes an outbound webhook and its delivery log from the course.
+// Access policy: Creator of CourseID.
+func (s *AutograderService) DeleteWebhook(ctx context.Context, in *pb.WebhookRequest) (*pb.Void, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DeleteWebhook failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.deleteWebhook(in); err != nil {
+		s.logger.Errorf("DeleteWebhook failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to delete webhook")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetWebhookDeliveries returns the most recent deliveries to an outbound webhook of the course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetWebhookDeliveries(ctx context.Context, in *pb.WebhookRequest) (*pb.WebhookDeliveries, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetWebhookDeliveries failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	deliveries, err := s.getWebhookDeliveries(in)
+	if err != nil {
+		s.logger.Errorf("GetWebhookDeliveries failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get webhook deliveries")
+	}
+	return deliveries, nil
+}
+
 // CreateEnrollment enrolls a new student for the course specified in the request.
 // Access policy: Any User.
 func (s *AutograderService) CreateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
@@ -249,10 +476,6 @@ func (s *AutograderService) UpdateEnrollment(ctx context.Context, in *pb.Enrollm
 		s.logger.Errorf("UpdateEnrollment failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateEnrollment failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update enrollment status")
-	}
 	if s.isCourseCreator(in.CourseID, in.UserID) {
 		s.logger.Errorf("UpdateEnrollment failed: user %s attempted to demote course creator", usr.GetName())
 		return nil, status.Error(codes.PermissionDenied, "course creator cannot be demoted")
@@ -274,15 +497,11 @@ func (s *AutograderService) UpdateEnrollment(ctx context.Context, in *pb.Enrollm
 // UpdateEnrollments changes status of all pending enrollments for the given course to approved
 // Access policy: Teacher of CourseID
 func (s *AutograderService) UpdateEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("UpdateEnrollments failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateEnrollments failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update enrollment status")
-	}
 	err = s.updateEnrollments(ctx, scm, in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("UpdateEnrollments failed: %v", err)
@@ -332,15 +551,11 @@ func (s *AutograderService) GetEnrollmentsByUser(ctx context.Context, in *pb.Enr
 // GetEnrollmentsByCourse returns all enrollments for the course specified in the request.
 // Access policy: Teacher or student of CourseID.
 func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.EnrollmentRequest) (*pb.Enrollments, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetEnrollmentsByCourse failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("GetEnrollmentsByCourse failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can get course enrollments")
-	}
 
 	enrolls, err := s.getEnrollmentsByCourse(in)
 	if err != nil {
@@ -350,10 +565,49 @@ func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.E
 	return enrolls, nil
 }
 
+// UpdateSlipDays adjusts the slip days used by a student for an assignment,
+// for instance to correct mistakes or refund slip days after a rebuild.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateSlipDays(ctx context.Context, in *pb.SlipDaysRequest) (*pb.Enrollment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateSlipDays failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	enrol, err := s.updateSlipDays(usr, in)
+	if err != nil {
+		s.logger.Errorf("UpdateSlipDays failed: %v", err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "enrollment or assignment not found")
+		case errors.Is(err, database.ErrNegativeSlipDays):
+			return nil, status.Error(codes.InvalidArgument, "used slip days cannot be negative")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to update slip days")
+	}
+	return enrol, nil
+}
+
+// GetSlipDayLedger returns the history of slip day changes for a user's course enrollment.
+// Access policy: Teacher of CourseID, or the user with UserID.
+func (s *AutograderService) GetSlipDayLedger(ctx context.Context, in *pb.SlipDayLedgerRequest) (*pb.SlipDayLedger, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSlipDayLedger failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	ledger, err := s.getSlipDayLedger(in)
+	if err != nil {
+		s.logger.Errorf("GetSlipDayLedger failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get slip day ledger")
+	}
+	return ledger, nil
+}
+
 // GetGroup returns information about a group.
 // Access policy: Group members, Teacher of CourseID.
 func (s *AutograderService) GetGroup(ctx context.Context, in *pb.GetGroupRequest) (*pb.Group, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetGroup failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
@@ -363,26 +617,17 @@ func (s *AutograderService) GetGroup(ctx context.Context, in *pb.GetGroupRequest
 		s.logger.Errorf("GetGroup failed: %v", err)
 		return nil, status.Error(codes.NotFound, "failed to get group")
 	}
-	if !(group.Contains(usr) || s.isTeacher(usr.GetID(), group.GetCourseID())) {
-		s.logger.Error("GetGroup failed: user is not group member or teacher")
-		return nil, status.Error(codes.PermissionDenied, "only group members and teachers can access a group")
-	}
 	return group, nil
 }
 
 // GetGroupsByCourse returns a list of groups created for the course id in the record request.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) GetGroupsByCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Groups, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetGroups failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	courseID := in.GetCourseID()
-	if !s.isTeacher(usr.GetID(), courseID) {
-		s.logger.Error("GetGroups failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can access other groups")
-	}
 	groups, err := s.getGroups(in)
 	if err != nil {
 		s.logger.Errorf("GetGroups failed: %v", err)
@@ -421,14 +666,20 @@ func (s *AutograderService) CreateGroup(ctx context.Context, in *pb.Group) (*pb.
 		s.logger.Errorf("CreateGroup failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
-		s.logger.Errorf("CreateGroup failed: user %s not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
-		return nil, status.Error(codes.PermissionDenied, "user not enrolled in given course")
-	}
-	if !(in.Contains(usr) || s.isTeacher(usr.GetID(), in.GetCourseID())) {
+	isTeacher := s.isTeacher(usr.GetID(), in.GetCourseID())
+	if !(in.Contains(usr) || isTeacher) {
 		s.logger.Error("CreateGroup failed: user is not group member or teacher")
 		return nil, status.Error(codes.PermissionDenied, "only group member or teacher can create group")
 	}
+	course, err := s.getCourse(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("CreateGroup failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err := checkGroupRules(course, in, isTeacher, time.Now()); err != nil {
+		s.logger.Errorf("CreateGroup failed: %v", err)
+		return nil, err
+	}
 	group, err := s.createGroup(in)
 	if err != nil {
 		if err == ErrGroupNameDuplicate {
@@ -443,14 +694,19 @@ func (s *AutograderService) CreateGroup(ctx context.Context, in *pb.Group) (*pb.
 // UpdateGroup updates group information.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateGroup(ctx context.Context, in *pb.Group) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("UpdateGroup failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateGroup failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update groups")
+	course, err := s.getCourse(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateGroup failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	if err := checkGroupRules(course, in, true, time.Now()); err != nil {
+		s.logger.Errorf("UpdateGroup failed: %v", err)
+		return nil, err
 	}
 	err = s.updateGroup(ctx, scm, in)
 	if err != nil {
@@ -469,20 +725,15 @@ func (s *AutograderService) UpdateGroup(ctx context.Context, in *pb.Group) (*pb.
 // DeleteGroup removes group record from the database.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("DeleteGroup failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	grp, err := s.getGroup(&pb.GetGroupRequest{GroupID: in.GetGroupID()})
-	if err != nil {
+	if _, err := s.getGroup(&pb.GetGroupRequest{GroupID: in.GetGroupID()}); err != nil {
 		s.logger.Errorf("DeleteGroup failed: %v", err)
 		return nil, status.Error(codes.NotFound, "failed to get group")
 	}
-	if !s.isTeacher(usr.GetID(), grp.GetCourseID()) {
-		s.logger.Error("DeleteGroup failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can delete groups")
-	}
 	if err = s.deleteGroup(ctx, scm, in); err != nil {
 		s.logger.Errorf("DeleteGroup failed: %v", err)
 		if contextCanceled(ctx) {
@@ -496,12 +747,75 @@ func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest
 	return &pb.Void{}, nil
 }
 
+// FormGroups creates and approves groups of approved students in bulk, either groups of
+// the given size from the students without a group, or the groups given as CSV.
+// The result reports each group, and why the groups that could not be formed failed.
+// With dry run, the groups are only reported, not created.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) FormGroups(ctx context.Context, in *pb.GroupFormationRequest) (*pb.GroupFormation, error) {
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("FormGroups failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	formation, err := s.formGroups(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("FormGroups failed: %v", err)
+		if _, ok := status.FromError(err); ok {
+			// malformed groupings are reported to the teacher
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to form groups")
+	}
+	return formation, nil
+}
+
+// GetGroupMemberships returns the membership history of the given group,
+// with the dates when the members joined and left the group.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetGroupMemberships(ctx context.Context, in *pb.GroupRequest) (*pb.GroupMemberships, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetGroupMemberships failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	memberships, err := s.getGroupMemberships(in)
+	if err != nil {
+		s.logger.Errorf("GetGroupMemberships failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get group membership history")
+	}
+	return memberships, nil
+}
+
+// GetGroupContributions returns the number of commits and lines changed by each author
+// in the given group's repository, at the commit of each of the group's submissions.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetGroupContributions(ctx context.Context, in *pb.GroupRequest) (*pb.GroupContributions, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetGroupContributions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	contributions, err := s.getGroupContributions(ctx, in)
+	if err != nil {
+		s.logger.Errorf("GetGroupContributions failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to get group contributions")
+	}
+	return contributions, nil
+}
+
 // GetSubmissions returns the submissions matching the query encoded in the action request.
 // Access policy:
 // Admin enrolled in CourseID,
 // Current User if Owner of submission,
 // Current User if member of group for group submission,
-// Teacher of CourseID.
+// Teacher or Teaching assistant of CourseID.
 func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.SubmissionRequest) (*pb.Submissions, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
@@ -512,9 +826,9 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 	// grp may be nil if there is no group ID in request; this is fine, since the grp.Contains() returns false in this case.
 	grp, _ := s.getGroup(&pb.GetGroupRequest{GroupID: in.GetGroupID()})
 
-	// ensure that current user is teacher, enrolled admin, or the current user is owner of the submission request
+	// ensure that current user is teacher, teaching assistant, enrolled admin, or the current user is owner of the submission request
 	if !s.hasCourseAccess(usr.GetID(), in.GetCourseID(), func(e *pb.Enrollment) bool {
-		return e.Status == pb.Enrollment_TEACHER || (usr.GetIsAdmin() && e.Status == pb.Enrollment_STUDENT) ||
+		return e.Status == pb.Enrollment_TEACHER || e.Status == pb.Enrollment_TA || (usr.GetIsAdmin() && e.Status == pb.Enrollment_STUDENT) ||
 			(e.Status == pb.Enrollment_STUDENT && (usr.IsOwner(in.GetUserID()) || grp.Contains(usr)))
 	}) {
 		s.logger.Error("GetSubmissions failed: user is not teacher or submission author")
@@ -525,19 +839,25 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 		s.logger.Errorf("GetSubmissions failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no submissions found")
 	}
+	if !s.isTeacherOrTA(usr.GetID(), in.GetCourseID()) {
+		// peer reviewers are anonymous to students
+		for _, submission := range submissions.GetSubmissions() {
+			submission.HidePeerReviewers()
+		}
+	}
 	return submissions, nil
 }
 
 // GetSubmissionsByCourse returns all the latest submissions
 // for every individual or group course assignment for all course students/groups.
-// Access policy: Admin enrolled in CourseID, Teacher of CourseID.
+// Access policy: Admin enrolled in CourseID, Teacher or Teaching assistant of CourseID.
 func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetSubmissionsByCourse failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !(s.isTeacher(usr.GetID(), in.GetCourseID()) || usr.IsAdmin && s.isEnrolled(usr.GetID(), in.GetCourseID())) {
+	if !(s.isTeacherOrTA(usr.GetID(), in.GetCourseID()) || usr.IsAdmin && s.isEnrolled(usr.GetID(), in.GetCourseID())) {
 		s.logger.Errorf("GetSubmissionsByCourse failed: user %s is not teacher or submission author", usr.GetLogin())
 		return nil, status.Error(codes.PermissionDenied, "only teachers can get all lab submissions")
 	}
@@ -548,6 +868,7 @@ func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.S
 		s.logger.Errorf("GetSubmissionsByCourse failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no submissions found")
 	}
+	s.hideBlindReviewers(usr, in.GetCourseID(), courseLinks)
 	return courseLinks, nil
 }
 
@@ -558,15 +879,11 @@ func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateS
 		s.logger.Errorf("UpdateSubmission failed: submission author has no access to the course")
 		return nil, status.Error(codes.PermissionDenied, "submission author has no course access")
 	}
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("UpdateSubmission failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("UpdateSubmission failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can approve submissions")
-	}
 	err = s.updateSubmission(in.GetCourseID(), in.GetSubmissionID(), in.GetStatus(), in.GetReleased(), in.GetScore())
 	if err != nil {
 		s.logger.Errorf("UpdateSubmission failed: %v", err)
@@ -576,12 +893,21 @@ func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateS
 }
 
 // RebuildSubmission rebuilds the submission with the given ID
-// Access policy: any user.
+// Access policy: Teacher or Teaching assistant of the assignment's course.
 func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
 	if !s.isValidSubmission(in.GetSubmissionID()) {
 		s.logger.Errorf("ApproveSubmission failed: submitter has no access to the course")
 		return nil, status.Error(codes.PermissionDenied, "submitter has no course access")
 	}
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if _, err := s.db.GetAssignment(&pb.Assignment{ID: in.GetAssignmentID()}); err != nil {
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.NotFound, "assignment not found")
+	}
 	submission, err := s.rebuildSubmission(in)
 	if err != nil {
 		s.logger.Errorf("RebuildSubmission failed: %v", err)
@@ -591,17 +917,13 @@ func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.Rebuil
 }
 
 // RebuildSubmissions runs tests for all submissions for the given assignment ID.
-// Access policy: Teacher of CourseID.
+// Access policy: Teacher or Teaching assistant of CourseID.
 func (s *AutograderService) RebuildSubmissions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("RebuildSubmissions failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("RebuildSubmissions failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can rebuild all submissions")
-	}
 	if err := s.rebuildSubmissions(in); err != nil {
 		s.logger.Errorf("RebuildSubmissions failed: %v", err)
 		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submissions")
@@ -609,6 +931,27 @@ func (s *AutograderService) RebuildSubmissions(ctx context.Context, in *pb.Assig
 	return &pb.Void{}, nil
 }
 
+// SubmitCommit runs the tests for the given assignment on the given commit of the
+// current user's repository, or of the user's group repository for group assignments.
+// The tested commit becomes the user's or group's submission for the assignment.
+// Access policy: Student or Teacher of CourseID.
+func (s *AutograderService) SubmitCommit(ctx context.Context, in *pb.SubmitRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("SubmitCommit failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.submitCommit(usr, in)
+	if err != nil {
+		s.logger.Errorf("SubmitCommit failed: %v", err)
+		if err == ErrUserNotInGroup {
+			return nil, status.Error(codes.FailedPrecondition, "user is not in a group for this group assignment")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to submit commit")
+	}
+	return submission, nil
+}
+
 // CreateBenchmark adds a new grading benchmark for an assignment
 // Access policy: Teacher of CourseID
 func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
@@ -675,18 +1018,15 @@ func (s *AutograderService) DeleteCriterion(ctx context.Context, in *pb.GradingC
 	return &pb.Void{}, err
 }
 
-// LoadCriteria loads grading criteria for an assignment from a json file
+// LoadCriteria loads grading criteria for an assignment from a json file.
+// Existing reviews keep their grades for unchanged criteria.
 // Access policy: Teacher of CourseID
 func (s *AutograderService) LoadCriteria(ctx context.Context, in *pb.AssignmentRequest) (*pb.Benchmarks, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("LoadCriteria failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("LoadCriteria failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can load grading criteria")
-	}
 
 	benchmarks, err := s.loadCriteria(ctx, scm, in)
 	if err != nil {
@@ -696,18 +1036,69 @@ func (s *AutograderService) LoadCriteria(ctx context.Context, in *pb.AssignmentR
 	return &pb.Benchmarks{Benchmarks: benchmarks}, nil
 }
 
+// CreateRubricTemplate creates a new version of a rubric template with grading benchmarks for the course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) CreateRubricTemplate(ctx context.Context, in *pb.RubricTemplate) (*pb.RubricTemplate, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateRubricTemplate failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	template, err := s.createRubricTemplate(usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateRubricTemplate failed for template %s: %v", in.GetName(), err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create rubric template")
+	}
+	return template, nil
+}
+
+// GetRubricTemplates returns all versions of the course's rubric templates and the templates shared by other courses.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetRubricTemplates(ctx context.Context, in *pb.CourseRequest) (*pb.RubricTemplates, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetRubricTemplates failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	templates, err := s.getRubricTemplates(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetRubricTemplates failed for course %d: %v", in.GetCourseID(), err)
+		return nil, status.Error(codes.NotFound, "failed to get rubric templates")
+	}
+	return templates, nil
+}
+
+// ApplyRubricTemplate loads the grading criteria for an assignment from a rubric template.
+// Existing reviews keep their grades for unchanged criteria.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ApplyRubricTemplate(ctx context.Context, in *pb.RubricTemplateRequest) (*pb.Benchmarks, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ApplyRubricTemplate failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	benchmarks, err := s.applyRubricTemplate(in)
+	if err != nil {
+		s.logger.Errorf("ApplyRubricTemplate failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to apply rubric template to assignment")
+	}
+	return &pb.Benchmarks{Benchmarks: benchmarks}, nil
+}
+
 // CreateReview adds a new submission review
-// Access policy: Teacher of CourseID
+// Access policy: Teacher or Teaching assistant of CourseID, Peer reviewer of the given submission
 func (s *AutograderService) CreateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("CreateReview failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("CreateReview failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can add reviews")
+	isStaff := s.isTeacherOrTA(usr.ID, in.GetCourseID())
+	if !isStaff && !s.isPeerReviewer(usr.ID, in.GetCourseID(), in.Review.GetSubmissionID()) {
+		s.logger.Error("CreateReview failed: user is not teacher, teaching assistant or peer reviewer")
+		return nil, status.Error(codes.PermissionDenied, "only teachers, teaching assistants and peer reviewers can add reviews")
 	}
+	in.Review.PeerReview = !isStaff
 	if !usr.IsOwner(in.Review.GetReviewerID()) {
 		s.logger.Errorf("CreateReview failed: current user's ID: %d, when the reviewer's ID is %d ", usr.ID, in.Review.ReviewerID)
 		return nil, status.Error(codes.PermissionDenied, "failed to create review: reviewers' IDs don't match")
@@ -721,22 +1112,28 @@ func (s *AutograderService) CreateReview(ctx context.Context, in *pb.ReviewReque
 }
 
 // UpdateReview updates a submission review
-// Access policy: Teacher of CourseID, Author of the given Review
+// Access policy: Teacher or Teaching assistant of CourseID, Peer reviewer of the given submission, Author of the given Review
 func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("UpdateReview failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("UpdateReview failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update reviews")
+	stored, ok := s.getCourseReview(in.GetCourseID(), in.Review.GetID())
+	if !ok {
+		s.logger.Errorf("UpdateReview failed: review %d not found in course %d", in.Review.GetID(), in.GetCourseID())
+		return nil, status.Error(codes.NotFound, "failed to get review")
+	}
+	// only teachers and teaching assistants can update teacher reviews
+	if !s.isTeacherOrTA(usr.ID, in.GetCourseID()) && !(stored.PeerReview && s.isPeerReviewer(usr.ID, in.GetCourseID(), stored.SubmissionID)) {
+		s.logger.Error("UpdateReview failed: user is not teacher, teaching assistant or peer reviewer")
+		return nil, status.Error(codes.PermissionDenied, "only teachers, teaching assistants and peer reviewers can update reviews")
 	}
-	if !(usr.IsOwner(in.Review.GetReviewerID()) || s.isCourseCreator(in.CourseID, usr.ID)) {
-		s.logger.Errorf("UpdateReview failed: current user's ID: %d, when the original reviewer's ID is %d ", usr.ID, in.Review.ReviewerID)
+	if !(usr.IsOwner(stored.GetReviewerID()) || (s.isTeacher(usr.ID, in.GetCourseID()) && s.isCourseCreator(in.CourseID, usr.ID))) {
+		s.logger.Errorf("UpdateReview failed: current user's ID: %d, when the original reviewer's ID is %d ", usr.ID, stored.ReviewerID)
 		return nil, status.Error(codes.PermissionDenied, "reviews can only be updated by original authors or course creator")
 	}
-	review, err := s.updateReview(in.Review)
+	review, err := s.updateReview(stored, in.Review)
 	if err != nil {
 		s.logger.Errorf("UpdateReview failed for review %+v: %v", in, err)
 		err = status.Error(codes.InvalidArgument, "failed to update review")
@@ -744,19 +1141,163 @@ func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewReque
 	return review, err
 }
 
+// GetPeerReviewSubmissions returns the submissions the current user is assigned to peer review
+// for the given assignment. Peer reviewers are assigned when first requested after the deadline.
+// Access policy: Student or Teacher of CourseID.
+func (s *AutograderService) GetPeerReviewSubmissions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Submissions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviewSubmissions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submissions, err := s.getPeerReviewSubmissions(usr, in)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviewSubmissions failed: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, "failed to get submissions for peer review")
+	}
+	return submissions, nil
+}
+
+// ModeratePeerReview excludes or includes a peer review in the submission's peer score,
+// for instance to moderate outliers. The updated submission is returned.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ModeratePeerReview(ctx context.Context, in *pb.PeerReviewModerationRequest) (*pb.Submission, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ModeratePeerReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.moderatePeerReview(in)
+	if err != nil {
+		s.logger.Errorf("ModeratePeerReview failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to moderate peer review")
+	}
+	return submission, nil
+}
+
+// DistributeReviews distributes the submissions for an assignment evenly among the course's
+// teachers and teaching assistants, or the requested subset of them, and returns their review progress.
+// Access policy: Creator of CourseID.
+func (s *AutograderService) DistributeReviews(ctx context.Context, in *pb.ReviewDistributionRequest) (*pb.ReviewProgress, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DistributeReviews failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	progress, err := s.distributeReviews(in)
+	if err != nil {
+		s.logger.Errorf("DistributeReviews failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to distribute reviews")
+	}
+	return progress, nil
+}
+
+// GetReviewProgress returns the review progress of each reviewer assigned to review submissions for an assignment.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetReviewProgress(ctx context.Context, in *pb.AssignmentRequest) (*pb.ReviewProgress, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetReviewProgress failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	progress, err := s.getReviewProgress(in)
+	if err != nil {
+		s.logger.Errorf("GetReviewProgress failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.NotFound, "failed to get review progress")
+	}
+	return progress, nil
+}
+
+// GetReviewQueue returns the submissions for an assignment that the current user
+// is assigned to review, but has not yet completed a ready review for.
+// Access policy: Teacher or Teaching assistant of CourseID.
+func (s *AutograderService) GetReviewQueue(ctx context.Context, in *pb.AssignmentRequest) (*pb.Submissions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetReviewQueue failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submissions, err := s.getReviewQueue(usr, in)
+	if err != nil {
+		s.logger.Errorf("GetReviewQueue failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.NotFound, "failed to get review queue")
+	}
+	return submissions, nil
+}
+
+// CreateCodeComment adds a comment on a range of lines in a file of the reviewed commit to a review.
+// If requested, the comment is also published as a commit comment in the student's repository.
+// Access policy: Author of the given Review; only Teacher of CourseID can publish comments.
+func (s *AutograderService) CreateCodeComment(ctx context.Context, in *pb.CodeCommentRequest) (*pb.CodeComment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateCodeComment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isReviewer(usr.GetID(), in.GetCourseID(), in.GetComment().GetReviewID()) {
+		s.logger.Error("CreateCodeComment failed: user is not the reviewer")
+		return nil, status.Error(codes.PermissionDenied, "only the reviewer can comment on reviewed code")
+	}
+	var sc scms.SCM
+	if in.GetPublish() {
+		if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
+			s.logger.Error("CreateCodeComment failed: user is not teacher")
+			return nil, status.Error(codes.PermissionDenied, "only teachers can publish code comments")
+		}
+		if _, sc, err = s.getUserAndSCMForCourse(ctx, in.GetCourseID()); err != nil {
+			s.logger.Errorf("CreateCodeComment failed: scm authentication error: %v", err)
+			return nil, ErrInvalidUserInfo
+		}
+	}
+	comment, err := s.createCodeComment(ctx, sc, in)
+	if err != nil {
+		s.logger.Errorf("CreateCodeComment failed for request %+v: %v", in, err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to create code comment")
+	}
+	return comment, nil
+}
+
+// GetCodeComments returns the code comments of a review.
+// Access policy: Teacher or Teaching assistant of CourseID, Author of the given Review,
+// or owner of the reviewed submission.
+func (s *AutograderService) GetCodeComments(ctx context.Context, in *pb.CodeCommentsRequest) (*pb.CodeComments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCodeComments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	review, ok := s.getCourseReview(in.GetCourseID(), in.GetReviewID())
+	if !ok {
+		s.logger.Errorf("GetCodeComments failed: review %d not found in course %d", in.GetReviewID(), in.GetCourseID())
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if !s.isTeacherOrTA(usr.GetID(), in.GetCourseID()) && !usr.IsOwner(review.GetReviewerID()) && !s.isSubmissionOwner(usr, review.GetSubmissionID()) {
+		s.logger.Error("GetCodeComments failed: user is not teacher, teaching assistant, reviewer or submission owner")
+		return nil, status.Error(codes.PermissionDenied, "only teachers, teaching assistants, the reviewer and the submission's owners can get code comments")
+	}
+	comments, err := s.getCodeComments(in)
+	if err != nil {
+		s.logger.Errorf("GetCodeComments failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.NotFound, "failed to get code comments")
+	}
+	return comments, nil
+}
+
 // UpdateSubmissions approves and/or releases all manual reviews for student submission for the given assignment
 // with the given score.
 // Access policy: Creator of CourseID
 func (s *AutograderService) UpdateSubmissions(ctx context.Context, in *pb.UpdateSubmissionsRequest) (*pb.Void, error) {
-	usr, err := s.getCurrentUser(ctx)
+	_, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("UpdateSubmissions failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isCourseCreator(in.CourseID, usr.ID) {
-		s.logger.Error("UpdateSubmissions failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update reviews")
-	}
 
 	if err = s.updateSubmissions(in); err != nil {
 		s.logger.Errorf("UpdateSubmissions failed for request %+v", in)
@@ -765,19 +1306,64 @@ func (s *AutograderService) UpdateSubmissions(ctx context.Context, in *pb.Update
 	return &pb.Void{}, err
 }
 
-// GetReviewers returns names of all active reviewers for a student submission
+// ScheduleRelease schedules the release of reviews and scores for the given assignment,
+// either at a fixed time or a number of days after the deadline. A previous schedule for
+// the assignment is replaced. Students are notified when the release is performed.
+// Access policy: Creator of CourseID
+func (s *AutograderService) ScheduleRelease(ctx context.Context, in *pb.ReleaseSchedule) (*pb.ReleaseSchedule, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ScheduleRelease failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	schedule, err := s.scheduleRelease(in)
+	if err != nil {
+		s.logger.Errorf("ScheduleRelease failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to schedule release")
+	}
+	return schedule, nil
+}
+
+// GetReleaseSchedules returns the release schedules for the given course.
 // Access policy: Teacher of CourseID
+func (s *AutograderService) GetReleaseSchedules(ctx context.Context, in *pb.CourseRequest) (*pb.ReleaseSchedules, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetReleaseSchedules failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	schedules, err := s.getReleaseSchedules(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetReleaseSchedules failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get release schedules")
+	}
+	return schedules, nil
+}
+
+// CancelRelease removes the release schedule for the given assignment.
+// Access policy: Creator of CourseID
+func (s *AutograderService) CancelRelease(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CancelRelease failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.cancelRelease(in.GetCourseID(), in.GetAssignmentID()); err != nil {
+		s.logger.Errorf("CancelRelease failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.NotFound, "failed to cancel release")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetReviewers returns names of all active reviewers for a student submission
+// Access policy: Teacher or Teaching assistant of CourseID
 func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionReviewersRequest) (*pb.Reviewers, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetReviewers failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("GetReviewers failed: user is not course creator")
-		return nil, status.Error(codes.PermissionDenied, "only course creator teacher can request information about reviewers")
-	}
-	reviewers, err := s.getReviewers(in.SubmissionID)
+	reviewers, err := s.getReviewers(usr, in.GetCourseID(), in.GetSubmissionID())
 	if err != nil {
 		s.logger.Errorf("GetReviewers failed: error fetching from database: %v", err)
 		return nil, status.Error(codes.InvalidArgument, "failed to get reviewers")
@@ -785,6 +1371,40 @@ func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionR
 	return &pb.Reviewers{Reviewers: reviewers}, err
 }
 
+// GetReconciliation compares the grades given by the ready teacher reviews of a submission,
+// and returns the reconciled result, including any disagreements between the reviewers.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetReconciliation(ctx context.Context, in *pb.SubmissionReviewersRequest) (*pb.Reconciliation, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetReconciliation failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	reconciliation, err := s.getReconciliation(in.GetCourseID(), in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetReconciliation failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.NotFound, "failed to get review reconciliation")
+	}
+	return reconciliation, nil
+}
+
+// OverrideGrade sets the final grade of a grading criterion for a submission with several reviews,
+// resolving any disagreement between the reviewers. A NONE grade removes the override.
+// Access policy: Creator of CourseID.
+func (s *AutograderService) OverrideGrade(ctx context.Context, in *pb.GradeOverrideRequest) (*pb.Reconciliation, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("OverrideGrade failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	reconciliation, err := s.overrideGrade(usr, in)
+	if err != nil {
+		s.logger.Errorf("OverrideGrade failed for request %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to override grade")
+	}
+	return reconciliation, nil
+}
+
 // GetAssignments returns a list of all assignments for the given course.
 // Access policy: Any User.
 func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Assignments, error) {
@@ -802,15 +1422,11 @@ func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseReq
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
 	courseID := in.GetCourseID()
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, courseID)
+	_, scm, err := s.getUserAndSCMForCourse(ctx, courseID)
 	if err != nil {
 		s.logger.Errorf("UpdateAssignments failed: scm authentication error: %v", err)
 		return nil, err
 	}
-	if !s.isTeacher(usr.ID, courseID) {
-		s.logger.Error("UpdateAssignments failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update course assignments")
-	}
 	err = s.updateAssignments(ctx, scm, courseID)
 	if err != nil {
 		s.logger.Errorf("UpdateAssignments failed: %v", err)
@@ -825,6 +1441,22 @@ func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.Course
 	return &pb.Void{}, nil
 }
 
+// GetAuditLog returns the audit log entries matching the request.
+// Access policy: Admin, Teacher of CourseID.
+func (s *AutograderService) GetAuditLog(ctx context.Context, in *pb.AuditLogRequest) (*pb.AuditLog, error) {
+	_, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAuditLog failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	auditLog, err := s.getAuditLog(in)
+	if err != nil {
+		s.logger.Errorf("GetAuditLog failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get audit log")
+	}
+	return auditLog, nil
+}
+
 // GetProviders returns a list of SCM providers supported by the backend.
 // Access policy: Any User.
 func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.Providers, error) {
@@ -844,10 +1476,6 @@ func (s *AutograderService) GetOrganization(ctx context.Context, in *pb.OrgReque
 		s.logger.Errorf("GetOrganization failed: scm authentication error: %v", err)
 		return nil, err
 	}
-	if !usr.IsAdmin {
-		s.logger.Error("GetOrganization failed: user is not admin")
-		return nil, status.Error(codes.PermissionDenied, "only admin can access organizations")
-	}
 	org, err := s.getOrganization(ctx, scm, in.GetOrgName(), usr.GetLogin())
 	if err != nil {
 		s.logger.Errorf("GetOrganization failed: %v", err)
@@ -889,17 +1517,12 @@ func (s *AutograderService) GetRepositories(ctx context.Context, in *pb.URLReque
 // IsEmptyRepo ensures that group repository is empty and can be deleted
 // Access policy: Teacher of Course ID
 func (s *AutograderService) IsEmptyRepo(ctx context.Context, in *pb.RepositoryRequest) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("IsEmptyRepo failed: scm authentication error: %v", err)
 		return nil, err
 	}
 
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("IsEmptyRepo failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can access repository info")
-	}
-
 	if err := s.isEmptyRepo(ctx, scm, in); err != nil {
 		s.logger.Errorf("IsEmptyRepo failed: %v", err)
 		if contextCanceled(ctx) {
@@ -0,0 +1,216 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
+	"github.com/autograde/quickfeed/notify"
+)
+
+const (
+	// maxChatCommandSize is the maximum size of a slash command request body.
+	maxChatCommandSize = 64 * 1024
+	// defaultReminderHours is used for chat integrations posting deadline reminders without a set number of hours.
+	defaultReminderHours = 24
+	chatHelp             = "Usage: `status` shows your latest submissions for the course's assignments."
+)
+
+// updateChatIntegration creates or updates the given chat integration for the integration's course.
+func (s *AutograderService) updateChatIntegration(integration *pb.ChatIntegration) (*pb.ChatIntegration, error) {
+	if integration.GetID() > 0 {
+		existing, err := s.db.GetChatIntegration(integration.GetID())
+		if err != nil {
+			return nil, err
+		}
+		if existing.GetCourseID() != integration.GetCourseID() {
+			return nil, fmt.Errorf("chat integration %d does not belong to course %d", integration.GetID(), integration.GetCourseID())
+		}
+	}
+	if err := s.db.UpdateChatIntegration(integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// getChatIntegrations returns the chat integrations for the given course.
+func (s *AutograderService) getChatIntegrations(courseID uint64) (*pb.ChatIntegrations, error) {
+	integrations, err := s.db.GetChatIntegrations(&pb.ChatIntegration{CourseID: courseID})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChatIntegrations{Integrations: integrations}, nil
+}
+
+// deleteChatIntegration removes the given chat integration from its course.
+func (s *AutograderService) deleteChatIntegration(request *pb.ChatIntegration) error {
+	integration, err := s.db.GetChatIntegration(request.GetID())
+	if err != nil {
+		return err
+	}
+	if integration.GetCourseID() != request.GetCourseID() {
+		return fmt.Errorf("chat integration %d does not belong to course %d", request.GetID(), request.GetCourseID())
+	}
+	return s.db.DeleteChatIntegration(integration.GetID())
+}
+
+// RemindDeadlines posts reminders to the chat channels configured for deadline reminders, for
+// assignments with deadlines within the channel's reminder period after the given time.
+// Each deadline is only posted once to each channel.
+func (s *AutograderService) RemindDeadlines(now time.Time) error {
+	integrations, err := s.db.GetChatIntegrations(&pb.ChatIntegration{DeadlineReminders: true})
+	if err != nil {
+		return err
+	}
+	for _, integration := range integrations {
+		course, err := s.db.GetCourse(integration.GetCourseID(), false)
+		if err != nil {
+			return err
+		}
+		assignments, err := s.db.GetAssignmentsByCourse(course.GetID(), false)
+		if err != nil {
+			return err
+		}
+		period := time.Duration(integration.GetReminderHours()) * time.Hour
+		if period == 0 {
+			period = defaultReminderHours * time.Hour
+		}
+		for _, assignment := range assignments {
+			sinceDeadline, err := assignment.SinceDeadline(now)
+			if err != nil || sinceDeadline >= 0 || -sinceDeadline > period {
+				continue
+			}
+			created, err := s.db.CreateChatReminder(&pb.ChatReminder{
+				IntegrationID: integration.GetID(),
+				AssignmentID:  assignment.GetID(),
+				Deadline:      assignment.GetDeadline(),
+			})
+			if err != nil {
+				return err
+			}
+			if !created {
+				continue
+			}
+			message, err := notify.Announcement(chat.DeadlineReminder, &notify.Event{Course: course, Assignment: assignment})
+			if err != nil {
+				return err
+			}
+			s.notifier.PostChat(integration, message)
+		}
+	}
+	return nil
+}
+
+// ChatCommand answers slash commands sent from the chat platform of the chat integration given
+// by the id path parameter. Requests that are not signed with the integration's secret are rejected.
+func (s *AutograderService) ChatCommand(c echo.Context) error {
+	integrationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	integration, err := s.db.GetChatIntegration(integrationID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxChatCommandSize))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+	cmd, err := chat.ParseCommand(integration, c.Request().Header, body, time.Now())
+	if err != nil {
+		s.logger.Errorf("ChatCommand failed for chat integration %d: %v", integrationID, err)
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+	var text string
+	if !cmd.Ping {
+		text = s.chatCommand(integration, cmd)
+	}
+	response, err := chat.Response(integration.GetPlatform(), cmd, text)
+	if err != nil {
+		return err
+	}
+	return c.JSONBlob(http.StatusOK, response)
+}
+
+// chatCommand returns the response text to the given slash command.
+func (s *AutograderService) chatCommand(integration *pb.ChatIntegration, cmd *chat.Command) string {
+	switch strings.ToLower(cmd.Text) {
+	case "", "status":
+	default:
+		return chatHelp
+	}
+	identity, err := s.db.GetChatIdentity(integration.GetPlatform(), cmd.ChatUserID)
+	if err != nil {
+		return "Your chat account is not linked to a QuickFeed user. You can link it in QuickFeed."
+	}
+	status, err := s.chatStatus(integration.GetCourseID(), identity.GetUserID())
+	if err != nil {
+		s.logger.Errorf("ChatCommand failed to get status of user %d: %v", identity.GetUserID(), err)
+		return "Failed to get your status from QuickFeed."
+	}
+	return status
+}
+
+// chatStatus returns the latest submissions of the given user and the user's group for the course's assignments.
+func (s *AutograderService) chatStatus(courseID, userID uint64) (string, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return "", err
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil || enrollment.GetStatus() == pb.Enrollment_NONE || enrollment.GetStatus() == pb.Enrollment_PENDING {
+		return fmt.Sprintf("You are not enrolled in %s.", course.GetName()), nil
+	}
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return "", err
+	}
+	submissions, err := s.db.GetLastSubmissions(courseID, &pb.Submission{UserID: userID})
+	if err != nil {
+		return "", err
+	}
+	if enrollment.GetGroupID() > 0 {
+		groupSubmissions, err := s.db.GetLastSubmissions(courseID, &pb.Submission{GroupID: enrollment.GetGroupID()})
+		if err != nil {
+			return "", err
+		}
+		submissions = append(submissions, groupSubmissions...)
+	}
+	latest := make(map[uint64]*pb.Submission)
+	for _, submission := range submissions {
+		latest[submission.GetAssignmentID()] = submission
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].GetOrder() < assignments[j].GetOrder()
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:", course.GetCode())
+	for _, assignment := range assignments {
+		submission, ok := latest[assignment.GetID()]
+		if !ok {
+			fmt.Fprintf(&b, "\n%s: no submission (due %s)", assignment.GetName(), assignment.GetDeadline())
+			continue
+		}
+		if assignment.GetReviewers() > 0 && !submission.GetReleased() {
+			fmt.Fprintf(&b, "\n%s: awaiting review", assignment.GetName())
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s: %d%% %s", assignment.GetName(), submission.GetScore(), submission.GetStatus())
+	}
+	return b.String(), nil
+}
+
+// updateChatIdentity links the given user to the user's account on a chat platform.
+func (s *AutograderService) updateChatIdentity(usr *pb.User, identity *pb.ChatIdentity) error {
+	identity.UserID = usr.GetID()
+	return s.db.UpdateChatIdentity(identity)
+}
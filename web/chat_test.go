@@ -0,0 +1,192 @@
+package web_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chatChannel is a local stand-in for a chat platform's incoming webhook.
+type chatChannel struct {
+	*httptest.Server
+	mu       sync.Mutex
+	messages []string
+}
+
+func newChatChannel(t *testing.T) *chatChannel {
+	t.Helper()
+	// the test server listens on a loopback address
+	pb.AllowWebhookHosts("127.0.0.1")
+	channel := &chatChannel{}
+	channel.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		channel.mu.Lock()
+		channel.messages = append(channel.messages, payload["text"])
+		channel.mu.Unlock()
+	}))
+	t.Cleanup(channel.Close)
+	return channel
+}
+
+func (c *chatChannel) received() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.messages...)
+}
+
+// waitForMessages waits until the channel has received n messages and returns the received messages.
+func (c *chatChannel) waitForMessages(n int) []string {
+	for i := 0; i < 100 && len(c.received()) < n; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	return c.received()
+}
+
+func TestChatIntegration(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:            "Operating Systems",
+		Code:            "DAT320",
+		Provider:        "fake",
+		OrganizationID:  1,
+		CourseCreatorID: teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(12 * time.Hour).Format(pb.TimeLayout)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: deadline}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	later := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Deadline: time.Now().Add(72 * time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateAssignment(later); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateNamedUser(t, db, 2, "Ola Nordmann")
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	channel := newChatChannel(t)
+	integration := &pb.ChatIntegration{
+		CourseID:           course.ID,
+		Platform:           pb.ChatIntegration_SLACK,
+		WebhookURL:         channel.URL,
+		SigningSecret:      "slack-secret",
+		DeadlineReminders:  true,
+		EnrollmentRequests: true,
+		ReminderHours:      24,
+	}
+	if _, err := callWithAccessControl(studentCtx, ags, "UpdateChatIntegration", integration); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateChatIntegration() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	integration, err := ags.UpdateChatIntegration(teacherCtx, integration)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// enrollment requests are announced
+	if _, err := ags.CreateEnrollment(studentCtx, &pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	messages := channel.waitForMessages(1)
+	if len(messages) != 1 || messages[0] != "DAT320: Ola Nordmann requested to enroll" {
+		t.Fatalf("channel received %q, want an enrollment request", messages)
+	}
+
+	// deadline reminders are only posted once, and only for deadlines within the reminder period
+	for i := 0; i < 2; i++ {
+		if err := ags.RemindDeadlines(time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	messages = channel.waitForMessages(2)
+	if len(messages) != 2 || messages[1] != fmt.Sprintf("DAT320: lab1 is due %s", deadline) {
+		t.Fatalf("channel received %q, want one reminder for lab1", messages)
+	}
+
+	// slash commands
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 80}); err != nil {
+		t.Fatal(err)
+	}
+	e := echo.New()
+	e.POST("/chat/:id/command", ags.ChatCommand)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	commandURL := fmt.Sprintf("%s/chat/%d/command", server.URL, integration.ID)
+
+	command := func(secret, userID string) (int, string) {
+		body := []byte(url.Values{"user_id": {userID}, "text": {"status"}}.Encode())
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		fmt.Fprintf(mac, "v0:%s:%s", ts, body)
+		req, err := http.NewRequest(http.MethodPost, commandURL, strings.NewReader(string(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Slack-Request-Timestamp", ts)
+		req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var reply map[string]string
+		_ = json.NewDecoder(resp.Body).Decode(&reply)
+		return resp.StatusCode, reply["text"]
+	}
+
+	if code, _ := command("forged-secret", "U123"); code != http.StatusUnauthorized {
+		t.Errorf("unsigned slash command: got status %d, want %d", code, http.StatusUnauthorized)
+	}
+	if _, text := command("slack-secret", "U123"); !strings.Contains(text, "not linked") {
+		t.Errorf("slash command from unlinked account = %q, want a request to link the account", text)
+	}
+	if _, err := ags.UpdateChatIdentity(studentCtx, &pb.ChatIdentity{Platform: pb.ChatIntegration_SLACK, ChatUserID: "U123"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateChatIdentity(teacherCtx, &pb.ChatIdentity{Platform: pb.ChatIntegration_SLACK, ChatUserID: "U123"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("UpdateChatIdentity() for account linked to another user: got error %v, want %v", err, codes.AlreadyExists)
+	}
+	code, text := command("slack-secret", "U123")
+	if code != http.StatusOK {
+		t.Fatalf("slash command: got status %d, want %d", code, http.StatusOK)
+	}
+	if !strings.Contains(text, "lab1: 80% NONE") || !strings.Contains(text, "lab2: no submission") {
+		t.Errorf("slash command status = %q, want the student's submissions", text)
+	}
+}
@@ -0,0 +1,83 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// createCodeComment creates a comment on a range of lines in a file of the reviewed commit.
+// The comment is anchored to the submission's commit, unless another commit is given.
+// If an SCM client is given, the comment is also published as a commit comment
+// in the student's or group's repository.
+func (s *AutograderService) createCodeComment(ctx context.Context, sc scm.SCM, request *pb.CodeCommentRequest) (*pb.CodeComment, error) {
+	comment := request.GetComment()
+	review, err := s.db.GetReview(&pb.Review{ID: comment.GetReviewID()})
+	if err != nil {
+		return nil, err
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: review.GetSubmissionID()})
+	if err != nil {
+		return nil, err
+	}
+	if comment.GetCommitHash() == "" {
+		comment.CommitHash = submission.GetCommitHash()
+	}
+	if comment.GetCommitHash() == "" {
+		return nil, fmt.Errorf("no commit to comment on for submission %d", submission.GetID())
+	}
+	if comment.GetEndLine() == 0 {
+		comment.EndLine = comment.GetStartLine()
+	}
+	comment.ID = 0
+	comment.Published = false
+	if sc != nil {
+		if err := s.publishCodeComment(ctx, sc, submission, comment); err != nil {
+			return nil, err
+		}
+		comment.Published = true
+	}
+	if err := s.db.CreateCodeComment(comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// publishCodeComment publishes the given code comment as a commit comment
+// in the repository of the student or group that made the submission.
+func (s *AutograderService) publishCodeComment(ctx context.Context, sc scm.SCM, submission *pb.Submission, comment *pb.CodeComment) error {
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: submission.GetAssignmentID()}, false)
+	if err != nil {
+		return err
+	}
+	var repo *pb.Repository
+	if assignment.GetIsGroupLab() {
+		repo, err = s.getGroupRepo(course, submission.GetGroupID())
+	} else {
+		repo, err = s.getUserRepo(course, submission.GetUserID())
+	}
+	if err != nil {
+		return err
+	}
+	return sc.CreateCommitComment(ctx, &scm.CommitCommentOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: path.Base(repo.GetHTMLURL()),
+		CommitHash: comment.GetCommitHash(),
+		Path:       comment.GetPath(),
+		StartLine:  comment.GetStartLine(),
+		EndLine:    comment.GetEndLine(),
+		Body:       comment.GetComment(),
+	})
+}
+
+// getCodeComments returns the code comments of the given review.
+func (s *AutograderService) getCodeComments(request *pb.CodeCommentsRequest) (*pb.CodeComments, error) {
+	comments, err := s.db.GetCodeComments(&pb.CodeComment{ReviewID: request.GetReviewID()})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CodeComments{Comments: comments}, nil
+}
@@ -0,0 +1,132 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCodeComments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	otherStudent := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "test-org",
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, otherStudent, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   1,
+		UserID:         student.ID,
+		HTMLURL:        "https://fake.com/test-org/student-labs",
+		RepoType:       pb.Repository_USER,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, CommitHash: "abc123"}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeGothProvider()
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	review, err := ags.CreateReview(teacherCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: submission.ID, ReviewerID: teacher.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	comment := &pb.CodeComment{ReviewID: review.ID, Path: "lab1/main.go", StartLine: 10, Comment: "Check the error"}
+	if _, err := callWithAccessControl(studentCtx, ags, "CreateCodeComment", &pb.CodeCommentRequest{CourseID: course.ID, Comment: comment}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateCodeComment() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	created, err := ags.CreateCodeComment(teacherCtx, &pb.CodeCommentRequest{CourseID: course.ID, Comment: comment})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetCommitHash() != submission.CommitHash || created.GetEndLine() != 10 || created.GetPublished() {
+		t.Errorf("CreateCodeComment() = %+v, want commit %s, end line %d and unpublished", created, submission.CommitHash, 10)
+	}
+
+	published, err := ags.CreateCodeComment(teacherCtx, &pb.CodeCommentRequest{
+		CourseID: course.ID,
+		Comment:  &pb.CodeComment{ReviewID: review.ID, Path: "lab1/main.go", StartLine: 3, EndLine: 5, Comment: "Use a constant"},
+		Publish:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !published.GetPublished() {
+		t.Error("CreateCodeComment() with publish: Published = false, want true")
+	}
+	fake := fakeProvider.(*scm.FakeSCM)
+	if len(fake.Comments) != 1 {
+		t.Fatalf("published %d commit comments, want %d", len(fake.Comments), 1)
+	}
+	want := scm.CommitCommentOptions{
+		Owner:      "test-org",
+		Repository: "student-labs",
+		CommitHash: "abc123",
+		Path:       "lab1/main.go",
+		StartLine:  3,
+		EndLine:    5,
+		Body:       "Use a constant",
+	}
+	if *fake.Comments[0] != want {
+		t.Errorf("published commit comment %+v, want %+v", *fake.Comments[0], want)
+	}
+
+	request := &pb.CodeCommentsRequest{CourseID: course.ID, ReviewID: review.ID}
+	otherCtx := withUserContext(context.Background(), otherStudent)
+	if _, err := callWithAccessControl(otherCtx, ags, "GetCodeComments", request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCodeComments() by other student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	// the teacher and the student that made the submission can read the comments
+	for _, ctx := range []context.Context{teacherCtx, studentCtx} {
+		comments, err := callWithAccessControl(ctx, ags, "GetCodeComments", request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// comments are ordered by file path and line
+		if got := comments.(*pb.CodeComments).GetComments(); len(got) != 2 || got[0].GetStartLine() != 3 {
+			t.Errorf("GetCodeComments() = %v, want the comments at line 3 and 10", got)
+		}
+	}
+
+	reviewed, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviewed.GetReviews()) != 1 || len(reviewed.GetReviews()[0].GetCodeComments()) != 2 {
+		t.Errorf("submission reviews %v, want one review with two code comments", reviewed.GetReviews())
+	}
+}
@@ -0,0 +1,139 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// getGroupMemberships returns the membership history of the given group.
+func (s *AutograderService) getGroupMemberships(request *pb.GroupRequest) (*pb.GroupMemberships, error) {
+	group, err := s.db.GetGroup(request.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+	if group.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("group %d does not belong to course %d", group.GetID(), request.GetCourseID())
+	}
+	memberships, err := s.db.GetGroupMemberships(group.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GroupMemberships{Memberships: memberships}, nil
+}
+
+// getGroupContributions returns the contributions of each commit author to the given group's repository,
+// as recorded in the commit log at the commit of each of the group's submissions.
+func (s *AutograderService) getGroupContributions(ctx context.Context, request *pb.GroupRequest) (*pb.GroupContributions, error) {
+	group, repos, course, err := s.getCourseGroupRepos(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("group %d has no repository", group.GetID())
+	}
+	// current and former members of the group
+	members := make(map[string]bool)
+	for _, user := range group.GetUsers() {
+		members[user.GetLogin()] = true
+	}
+	memberships, err := s.db.GetGroupMemberships(group.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, membership := range memberships {
+		if login := membership.GetUser().GetLogin(); login != "" {
+			members[login] = true
+		}
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{GroupID: group.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(submissions, func(i, j int) bool {
+		return submissions[i].GetID() < submissions[j].GetID()
+	})
+
+	sc, err := s.scms.GetOrCreateSCMEntry(s.logger.Desugar(), course.GetProvider(), course.GetAccessToken())
+	if err != nil {
+		return nil, err
+	}
+	// commits by ID; commits never change, so the commit logs of all submissions can share them
+	commits := make(map[string]*scm.Commit)
+	// the newest submissions are most likely to include the commits of the older submissions
+	for i := len(submissions) - 1; i >= 0; i-- {
+		hash := submissions[i].GetCommitHash()
+		if _, ok := commits[hash]; ok || hash == "" {
+			continue
+		}
+		commitLog, err := sc.GetCommitLog(ctx, &scm.CommitLogOptions{
+			Owner:      course.GetOrganizationPath(),
+			Repository: path.Base(repos[0].GetHTMLURL()),
+			CommitHash: hash,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, commit := range commitLog {
+			commits[commit.ID] = commit
+		}
+		if len(commitLog) > 0 {
+			// the submitted commit comes first, and may be given by an abbreviated hash
+			commits[hash] = commitLog[0]
+		}
+	}
+
+	contributions := &pb.GroupContributions{}
+	for _, submission := range submissions {
+		if submission.GetCommitHash() == "" {
+			continue
+		}
+		contributions.Submissions = append(contributions.Submissions, &pb.SubmissionContributions{
+			SubmissionID:  submission.GetID(),
+			AssignmentID:  submission.GetAssignmentID(),
+			CommitHash:    submission.GetCommitHash(),
+			Contributions: contributionsAt(commits, submission.GetCommitHash(), members),
+		})
+	}
+	return contributions, nil
+}
+
+// contributionsAt returns the contributions of each author of the commits reachable from the given commit,
+// sorted by the authors' logins. Merge commits are not counted, since they repeat the changes they merge.
+func contributionsAt(commits map[string]*scm.Commit, hash string, members map[string]bool) []*pb.Contribution {
+	authors := make(map[string]*pb.Contribution)
+	seen := make(map[string]bool)
+	queue := []string{hash}
+	for len(queue) > 0 {
+		commit, ok := commits[queue[0]]
+		queue = queue[1:]
+		if !ok || seen[commit.ID] {
+			continue
+		}
+		seen[commit.ID] = true
+		queue = append(queue, commit.Parents...)
+		if len(commit.Parents) > 1 {
+			continue
+		}
+		contribution, ok := authors[commit.Author]
+		if !ok {
+			contribution = &pb.Contribution{Login: commit.Author, Member: members[commit.Author]}
+			authors[commit.Author] = contribution
+		}
+		contribution.Commits++
+		contribution.Additions += uint32(commit.Additions)
+		contribution.Deletions += uint32(commit.Deletions)
+	}
+	var contributions []*pb.Contribution
+	for _, contribution := range authors {
+		contributions = append(contributions, contribution)
+	}
+	sort.Slice(contributions, func(i, j int) bool {
+		return contributions[i].GetLogin() < contributions[j].GetLogin()
+	})
+	return contributions
+}
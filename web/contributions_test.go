@@ -0,0 +1,119 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
+)
+
+func TestGroupContributions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for _, login := range []string{"alice", "bob", "carol"} {
+		student := qtest.CreateFakeUser(t, db, uint64(len(students)+2))
+		student.Login = login
+		if err := db.UpdateUser(student); err != nil {
+			t.Fatal(err)
+		}
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, student)
+	}
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: students[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// bob leaves and carol joins the group
+	if err := db.UpdateGroup(&pb.Group{ID: group.ID, Name: group.Name, CourseID: course.ID, Users: []*pb.User{students[0], students[2]}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		GroupID:        group.ID,
+		RepoType:       pb.Repository_GROUP,
+		HTMLURL:        "https://github.com/qf-org/group",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i := uint32(1); i <= 2; i++ {
+		if err := db.CreateAssignment(&pb.Assignment{CourseID: course.ID, Name: fmt.Sprintf("lab%d", i), Order: i, IsGroupLab: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lab1 := &pb.Submission{AssignmentID: 1, GroupID: group.ID, CommitHash: "c2"}
+	lab2 := &pb.Submission{AssignmentID: 2, GroupID: group.ID, CommitHash: "c5"}
+	for _, submission := range []*pb.Submission{lab1, lab2} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fakeSCM, scms := qtest.FakeProviderMap(t)
+	for _, commit := range []*scm.Commit{
+		{ID: "c1", Author: "alice", Additions: 100},
+		{ID: "c2", Parents: []string{"c1"}, Author: "bob", Additions: 10, Deletions: 5},
+		{ID: "c3", Parents: []string{"c2"}, Author: "alice", Additions: 20, Deletions: 2},
+		{ID: "c4", Parents: []string{"c2"}, Author: "Mallory", Additions: 7},
+		{ID: "c5", Parents: []string{"c3", "c4"}, Author: "alice", Additions: 7},
+	} {
+		fakeSCM.(*scm.FakeSCM).Commits[commit.ID] = commit
+	}
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+
+	memberships, err := ags.GetGroupMemberships(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(memberships.GetMemberships()) != 3 {
+		t.Errorf("got %d memberships, want 3", len(memberships.GetMemberships()))
+	}
+
+	got, err := ags.GetGroupContributions(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.GroupContributions{Submissions: []*pb.SubmissionContributions{
+		{SubmissionID: lab1.ID, AssignmentID: 1, CommitHash: "c2", Contributions: []*pb.Contribution{
+			{Login: "alice", Member: true, Commits: 1, Additions: 100},
+			{Login: "bob", Member: true, Commits: 1, Additions: 10, Deletions: 5},
+		}},
+		// the merge commit c5 is not counted
+		{SubmissionID: lab2.ID, AssignmentID: 2, CommitHash: "c5", Contributions: []*pb.Contribution{
+			{Login: "Mallory", Commits: 1, Additions: 7},
+			{Login: "alice", Member: true, Commits: 2, Additions: 120, Deletions: 2},
+			{Login: "bob", Member: true, Commits: 1, Additions: 10, Deletions: 5},
+		}},
+	}}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(pb.GroupContributions{}, pb.SubmissionContributions{}, pb.Contribution{})); diff != "" {
+		t.Errorf("GetGroupContributions() mismatch (-want +got):\n%s", diff)
+	}
+
+	studentCtx := withUserContext(context.Background(), students[0])
+	if _, err := callWithAccessControl(studentCtx, ags, "GetGroupContributions", &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID}); err == nil {
+		t.Error("GetGroupContributions by student succeeded, want permission denied")
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/chat"
 	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/scm"
 )
 
@@ -79,7 +81,33 @@ func (s *AutograderService) createEnrollment(request *pb.Enrollment) error {
 		CourseID: request.GetCourseID(),
 		Status:   pb.Enrollment_PENDING,
 	}
-	return s.db.CreateEnrollment(&enrollment)
+	if err := s.db.CreateEnrollment(&enrollment); err != nil {
+		return err
+	}
+	s.announceEnrollment(&enrollment)
+	return nil
+}
+
+// announceEnrollment announces the new enrollment request in the course's chat channels and webhooks.
+func (s *AutograderService) announceEnrollment(enrollment *pb.Enrollment) {
+	user, err := s.db.GetUser(enrollment.GetUserID())
+	if err != nil {
+		s.logger.Errorf("Failed to announce enrollment request by user %d: %v", enrollment.GetUserID(), err)
+		return
+	}
+	course, err := s.db.GetCourse(enrollment.GetCourseID(), false)
+	if err != nil {
+		s.logger.Errorf("Failed to announce enrollment request by user %d: %v", enrollment.GetUserID(), err)
+		return
+	}
+	s.notifier.Announce(chat.EnrollmentRequest, &notify.Event{Course: course, User: user, Enrollment: enrollment})
+	s.notifier.Dispatch(notify.EnrollmentChanged, course.GetID(), &pb.Enrollment{
+		ID:       enrollment.GetID(),
+		UserID:   user.GetID(),
+		CourseID: course.GetID(),
+		Status:   enrollment.GetStatus(),
+		User:     user,
+	})
 }
 
 // updateEnrollment changes the status of the given course enrollment.
@@ -95,15 +123,52 @@ func (s *AutograderService) updateEnrollment(ctx context.Context, sc scm.SCM, cu
 
 	switch request.Status {
 	case pb.Enrollment_NONE:
-		return s.rejectEnrollment(ctx, sc, enrollment)
+		err = s.rejectEnrollment(ctx, sc, enrollment)
 
 	case pb.Enrollment_STUDENT:
-		return s.enrollStudent(ctx, sc, enrollment)
+		err = s.enrollStudent(ctx, sc, enrollment)
 
 	case pb.Enrollment_TEACHER:
-		return s.enrollTeacher(ctx, sc, enrollment)
+		err = s.enrollTeacher(ctx, sc, enrollment)
+
+	case pb.Enrollment_TA:
+		err = s.enrollTA(ctx, sc, enrollment)
+
+	default:
+		return fmt.Errorf("unknown enrollment")
+	}
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("unknown enrollment")
+	if enrollment.GetStatus() != request.GetStatus() {
+		s.notifyEnrollment(enrollment, request.GetStatus())
+	}
+	return nil
+}
+
+// notifyEnrollment notifies the enrolled user and the course's webhooks about the new status of the enrollment.
+func (s *AutograderService) notifyEnrollment(enrollment *pb.Enrollment, status pb.Enrollment_UserStatus) {
+	user, err := s.db.GetUser(enrollment.GetUserID())
+	if err != nil {
+		s.logger.Errorf("Failed to notify user %d about enrollment: %v", enrollment.GetUserID(), err)
+		return
+	}
+	course, err := s.db.GetCourse(enrollment.GetCourseID(), false)
+	if err != nil {
+		s.logger.Errorf("Failed to notify user %d about enrollment: %v", enrollment.GetUserID(), err)
+		return
+	}
+	s.notifier.Notify(pb.NotificationOptOut_ENROLLMENT, []*pb.User{user}, &notify.Event{
+		Course:     course,
+		Enrollment: &pb.Enrollment{UserID: user.GetID(), CourseID: course.GetID(), Status: status},
+	})
+	s.notifier.Dispatch(notify.EnrollmentChanged, course.GetID(), &pb.Enrollment{
+		ID:       enrollment.GetID(),
+		UserID:   user.GetID(),
+		CourseID: course.GetID(),
+		Status:   status,
+		User:     user,
+	})
 }
 
 // updateEnrollments enrolls all students with pending enrollments into course
@@ -121,6 +186,52 @@ func (s *AutograderService) updateEnrollments(ctx context.Context, sc scm.SCM, c
 	return nil
 }
 
+// updateSlipDays adjusts the slip days used by the given user for the given assignment,
+// records the adjustment in the slip day ledger, and returns the updated enrollment.
+func (s *AutograderService) updateSlipDays(actor *pb.User, request *pb.SlipDaysRequest) (*pb.Enrollment, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	entry := &pb.SlipDayLedgerEntry{
+		EnrollmentID: enrollment.GetID(),
+		AssignmentID: assignment.GetID(),
+		Change:       request.GetChange(),
+		Reason:       request.GetReason(),
+		ActorID:      actor.GetID(),
+	}
+	if err := s.db.AdjustSlipDays(entry); err != nil {
+		return nil, err
+	}
+	s.logger.Debugf("User %s adjusted slip days of user %d for assignment %d by %d: %s", actor.GetLogin(), request.GetUserID(), assignment.GetID(), request.GetChange(), request.GetReason())
+	enrollment, err = s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	enrollment.SetSlipDays(enrollment.Course)
+	return enrollment, nil
+}
+
+// getSlipDayLedger returns the slip day ledger for the given user's course enrollment.
+func (s *AutograderService) getSlipDayLedger(request *pb.SlipDayLedgerRequest) (*pb.SlipDayLedger, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.db.GetSlipDayLedger(enrollment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SlipDayLedger{Entries: entries}, nil
+}
+
 // getCourse returns a course object for the given course id.
 func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
 	return s.db.GetCourse(courseID, false)
@@ -171,22 +282,47 @@ func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCo
 		}
 	}
 
+	setMembers, err := s.getGroupSetMembers(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+
 	enrolLinks := make([]*pb.EnrollmentLink, 0)
 
 	switch request.Type {
 	case pb.SubmissionsForCourseRequest_GROUP:
-		enrolLinks = append(enrolLinks, s.makeGroupResults(course, assignments)...)
+		enrolLinks = append(enrolLinks, s.makeGroupResults(course, assignments, setMembers)...)
 	case pb.SubmissionsForCourseRequest_INDIVIDUAL:
-		enrolLinks = append(enrolLinks, makeResults(course, assignments, false)...)
+		enrolLinks = append(enrolLinks, makeResults(course, assignments, false, setMembers)...)
 	default:
-		enrolLinks = append(enrolLinks, makeResults(course, assignments, true)...)
+		enrolLinks = append(enrolLinks, makeResults(course, assignments, true, setMembers)...)
 	}
 	return &pb.CourseSubmissions{Course: course, Links: enrolLinks}, nil
 }
 
+// getGroupSetMembers returns the IDs of the members of each of the course's approved groups
+// in a group set, by group ID. The members of the course's default groups are given by their enrollments.
+func (s *AutograderService) getGroupSetMembers(courseID uint64) (map[uint64]map[uint64]bool, error) {
+	groups, err := s.db.GetGroupsByCourse(courseID, pb.Group_APPROVED)
+	if err != nil {
+		return nil, err
+	}
+	setMembers := make(map[uint64]map[uint64]bool)
+	for _, group := range groups {
+		if group.GetGroupSet() == "" {
+			continue
+		}
+		setMembers[group.GetID()] = make(map[uint64]bool)
+		for _, user := range group.GetUsers() {
+			setMembers[group.GetID()][user.GetID()] = true
+		}
+	}
+	return setMembers, nil
+}
+
 // makeResults generates enrollment-assignment-submissions links
 // for all course students and all individual and group assignments.
-func makeResults(course *pb.Course, assignments []*pb.Assignment, addGroups bool) []*pb.EnrollmentLink {
+func makeResults(course *pb.Course, assignments []*pb.Assignment, addGroups bool, setMembers map[uint64]map[uint64]bool) []*pb.EnrollmentLink {
 	enrolLinks := make([]*pb.EnrollmentLink, 0)
 
 	for _, enrol := range course.Enrollments {
@@ -201,7 +337,7 @@ func makeResults(course *pb.Course, assignments []*pb.Assignment, addGroups bool
 			for _, sb := range a.Submissions {
 				if !a.IsGroupLab && sb.GroupID == 0 && sb.UserID == enrol.UserID {
 					subLink.Submission = sb
-				} else if addGroups && a.IsGroupLab && sb.GroupID > 0 && sb.GroupID == enrol.GroupID {
+				} else if addGroups && a.IsGroupLab && sb.GroupID > 0 && (sb.GroupID == enrol.GroupID || setMembers[sb.GroupID][enrol.UserID]) {
 					subLink.Submission = sb
 				}
 			}
@@ -216,13 +352,13 @@ func makeResults(course *pb.Course, assignments []*pb.Assignment, addGroups bool
 
 // makeGroupResults generates enrollment to assignment to submissions links
 // for all course groups and all group assignments
-func (s *AutograderService) makeGroupResults(course *pb.Course, assignments []*pb.Assignment) []*pb.EnrollmentLink {
+func (s *AutograderService) makeGroupResults(course *pb.Course, assignments []*pb.Assignment, setMembers map[uint64]map[uint64]bool) []*pb.EnrollmentLink {
 	enrolLinks := make([]*pb.EnrollmentLink, 0)
 	for _, grp := range course.Groups {
 
 		newLink := &pb.EnrollmentLink{}
 		for _, enrol := range course.Enrollments {
-			if enrol.GroupID > 0 && enrol.GroupID == grp.ID {
+			if enrol.GroupID > 0 && enrol.GroupID == grp.ID || setMembers[grp.ID][enrol.UserID] {
 				newLink.Enrollment = enrol
 			}
 		}
@@ -264,23 +400,84 @@ func (s *AutograderService) updateSubmission(courseID, submissionID uint64, stat
 			return err
 		}
 	}
+	if released {
+		assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+		if err != nil {
+			return err
+		}
+		if score, err = s.reconciledScore(assignment, submission, score); err != nil {
+			return err
+		}
+	}
+	statusChanged := status != submission.GetStatus() && status != pb.Submission_NONE
+	newlyReleased := released && !submission.GetReleased()
 	submission.Status = status
 	submission.Released = released
 	if score > 0 {
 		submission.Score = score
 	}
-	return s.db.UpdateSubmission(submission)
+	if err := s.db.UpdateSubmission(submission); err != nil {
+		return err
+	}
+	if statusChanged || newlyReleased {
+		s.notifySubmission(courseID, submission, statusChanged, newlyReleased)
+	}
+	if statusChanged && status == pb.Submission_APPROVED {
+		s.mergePullRequest(submission)
+	}
+	return nil
+}
+
+// notifySubmission notifies the owners of the given submission about its new status and/or release.
+func (s *AutograderService) notifySubmission(courseID uint64, submission *pb.Submission, statusChanged, released bool) {
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: submission.GetAssignmentID()}, false)
+	if err != nil {
+		s.logger.Errorf("Failed to notify owners of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	event := &notify.Event{Course: course, Assignment: assignment, Submission: submission}
+	if statusChanged {
+		s.notifier.Dispatch(notify.SubmissionStatus, course.GetID(), submission)
+		s.notifier.NotifyOwners(pb.NotificationOptOut_SUBMISSION, event)
+		if submission.GetStatus() == pb.Submission_APPROVED {
+			s.notifier.Announce(chat.Approval, event)
+		}
+	}
+	if released {
+		s.notifier.NotifyOwners(pb.NotificationOptOut_RELEASE, event)
+	}
 }
 
 // updateSubmissions updates status and release state of multiple submissions for the
-// given course and assignment ID for all submissions with score equal or above the provided score
+// given course and assignment ID for all submissions with score equal or above the provided score.
+// The owners of the updated submissions are notified about their new status and/or release.
 func (s *AutograderService) updateSubmissions(request *pb.UpdateSubmissionsRequest) error {
-	if _, _, err := s.getAssignmentWithCourse(&pb.Assignment{
+	assignment, _, err := s.getAssignmentWithCourse(&pb.Assignment{
 		CourseID: request.CourseID,
 		ID:       request.AssignmentID,
-	}, false); err != nil {
+	}, false)
+	if err != nil {
 		return err
 	}
+	if request.Release && assignment.GetReviewers() > 1 {
+		// released scores must be reconciled from the reviews before comparing with the score limit
+		submissions, err := s.getReviewedSubmissions(assignment.GetID())
+		if err != nil {
+			return err
+		}
+		for _, submission := range submissions {
+			score, err := s.reconciledScore(assignment, submission, submission.GetScore())
+			if err != nil {
+				return err
+			}
+			if score != submission.GetScore() {
+				submission.Score = score
+				if err := s.db.UpdateSubmission(submission); err != nil {
+					return err
+				}
+			}
+		}
+	}
 
 	query := &pb.Submission{
 		AssignmentID: request.AssignmentID,
@@ -291,17 +488,55 @@ func (s *AutograderService) updateSubmissions(request *pb.UpdateSubmissionsReque
 		query.Status = pb.Submission_APPROVED
 	}
 
-	return s.db.UpdateSubmissions(request.CourseID, query)
+	before, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return err
+	}
+	if err := s.db.UpdateSubmissions(request.CourseID, query); err != nil {
+		return err
+	}
+	after, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return err
+	}
+	previous := make(map[uint64]*pb.Submission)
+	for _, submission := range before {
+		previous[submission.GetID()] = submission
+	}
+	for _, submission := range after {
+		old := previous[submission.GetID()]
+		statusChanged := submission.GetStatus() != old.GetStatus()
+		released := submission.GetReleased() && !old.GetReleased()
+		if statusChanged || released {
+			s.notifySubmission(request.CourseID, submission, statusChanged, released)
+		}
+		if statusChanged && submission.GetStatus() == pb.Submission_APPROVED {
+			s.mergePullRequest(submission)
+		}
+	}
+	return nil
 }
 
-func (s *AutograderService) getReviewers(submissionID uint64) ([]*pb.User, error) {
+// getReviewers returns the reviewers of the given submission. For blind review assignments,
+// only the course creator sees all reviewers; other teachers only see themselves.
+func (s *AutograderService) getReviewers(usr *pb.User, courseID, submissionID uint64) ([]*pb.User, error) {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
 	if err != nil {
 		return nil, err
 	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetBlindReview() && !s.isCourseCreator(courseID, usr.GetID()) {
+		submission.HideOtherReviewers(usr.GetID())
+	}
 	names := make([]*pb.User, 0)
 	// TODO: make sure to preload reviews here
 	for _, review := range submission.Reviews {
+		if review.ReviewerID == 0 {
+			continue
+		}
 		// ignore possible error, will just add an empty string
 		u, _ := s.db.GetUser(review.ReviewerID)
 		names = append(names, u)
@@ -403,6 +638,12 @@ func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, en
 			return err
 		}
 	}
+	if len(repos) == 0 && enrolled.IsTA() {
+		// teaching assistants have no repository, but are still members of the organization
+		if err := sc.RemoveMember(ctx, &scm.OrgMembershipOptions{Organization: course.GetOrganizationPath(), Username: user.GetLogin()}); err != nil {
+			s.logger.Debug("updateEnrollment: failed to remove teaching assistant from organization: ", err)
+		}
+	}
 	return s.db.RejectEnrollment(user.ID, course.ID)
 }
 
@@ -428,13 +669,14 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 		return err
 	}
 
-	if enrolled.Status == pb.Enrollment_TEACHER {
+	if enrolled.Status == pb.Enrollment_TEACHER || enrolled.Status == pb.Enrollment_TA {
 		err = revokeTeacherStatus(ctx, sc, course.GetOrganizationPath(), user.GetLogin())
 		if err != nil {
 			s.logger.Errorf("Failed to revoke teacher status for user %s and course %s: %v", user.Login, course.Name, err)
 		}
-	} else {
-
+	}
+	if enrolled.Status != pb.Enrollment_TEACHER {
+		// teaching assistants made students may not have a repository
 		s.logger.Debug("Enrolling student: ", user.GetLogin(), " have database repos: ", len(repos))
 		if len(repos) > 0 {
 			// repo already exist, update enrollment in database
@@ -460,6 +702,7 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 		if err := s.db.CreateRepository(&userRepo); err != nil {
 			return err
 		}
+		s.grantTAAccess(ctx, sc, course.GetID(), repo.Owner, &userRepo)
 	}
 
 	return s.db.UpdateEnrollment(userEnrolQuery)
@@ -482,6 +725,49 @@ func (s *AutograderService) enrollTeacher(ctx context.Context, sc scm.SCM, enrol
 	})
 }
 
+// enrollTA makes the given user a teaching assistant of the given course.
+// Teaching assistants are regular members of the course organization and its teachers team,
+// and are given pull access to the course's repositories to view the submitted code.
+func (s *AutograderService) enrollTA(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
+	// course and user are both preloaded, no need to query the database
+	course, user := enrolled.GetCourse(), enrolled.GetUser()
+
+	if _, err := updateReposAndTeams(ctx, sc, course, user.GetLogin(), pb.Enrollment_TA); err != nil {
+		s.logger.Errorf("Failed to update team membership for teaching assistant %s: %v", user.Login, err)
+		return err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return err
+	}
+	if err := grantRepoAccess(ctx, sc, course.GetOrganizationPath(), repos, user.GetLogin()); err != nil {
+		s.logger.Errorf("Failed to grant repository access to teaching assistant %s: %v", user.Login, err)
+		return err
+	}
+	return s.db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TA,
+	})
+}
+
+// grantTAAccess gives the course's teaching assistants pull access to the given new repository.
+// Failures are only logged, since the repository is usable without the teaching assistants' access.
+func (s *AutograderService) grantTAAccess(ctx context.Context, sc scm.SCM, courseID uint64, org string, repo *pb.Repository) {
+	assistants, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_TA)
+	if err != nil {
+		s.logger.Errorf("Failed to get teaching assistants of course %d: %v", courseID, err)
+		return
+	}
+	var logins []string
+	for _, assistant := range assistants {
+		logins = append(logins, assistant.GetUser().GetLogin())
+	}
+	if err := grantRepoAccess(ctx, sc, org, []*pb.Repository{repo}, logins...); err != nil {
+		s.logger.Errorf("Failed to grant teaching assistants access to repository %s: %v", repo.GetHTMLURL(), err)
+	}
+}
+
 // returns all enrollments for the course ID with last activity date and number of approved assignments
 func (s *AutograderService) getEnrollmentsWithActivity(courseID uint64) ([]*pb.Enrollment, error) {
 	allEnrollmentsWithSubmissions, err := s.getAllCourseSubmissions(
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/internal/qtest"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/scm"
@@ -98,6 +100,21 @@ func withUserContext(ctx context.Context, user *pb.User) context.Context {
 	return metadata.NewIncomingContext(ctx, meta)
 }
 
+// callWithAccessControl calls the named service method the way the gRPC server does,
+// through the AccessControl interceptor that enforces the method's access policy.
+func callWithAccessControl(ctx context.Context, ags *web.AutograderService, method string, in proto.Message) (interface{}, error) {
+	for _, m := range pb.AutograderService_ServiceDesc.Methods {
+		if m.MethodName == method {
+			dec := func(v interface{}) error {
+				proto.Merge(v.(proto.Message), in)
+				return nil
+			}
+			return m.Handler(ags, ctx, dec, ags.AccessControl())
+		}
+	}
+	return nil, status.Errorf(codes.Unimplemented, "unknown method %s", method)
+}
+
 func fakeGothProvider() {
 	baseURL := "fake"
 	goth.UseProviders(&auth.FakeProvider{
@@ -629,3 +646,278 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 		t.Error("expected error 'ta cannot be demoted course creator'")
 	}
 }
+
+func TestTeachingAssistant(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+
+	teacher := qtest.CreateFakeUser(t, db, 10)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "path",
+		CourseCreatorID:  teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 11)
+	qtest.EnrollStudent(t, db, student, course)
+	ta := qtest.CreateFakeUser(t, db, 12)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: ta.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	if _, err := fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a teaching assistant cannot make another user teaching assistant
+	taCtx := withUserContext(context.Background(), ta)
+	if _, err := callWithAccessControl(taCtx, ags, "UpdateEnrollment", &pb.Enrollment{UserID: ta.ID, CourseID: course.ID, Status: pb.Enrollment_TA}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateEnrollment by pending user returned %v, want %v", err, codes.PermissionDenied)
+	}
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{UserID: ta.ID, CourseID: course.ID, Status: pb.Enrollment_TA}); err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, ta.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !enrollment.IsTA() {
+		t.Errorf("enrollment status is %v, want %v", enrollment.GetStatus(), pb.Enrollment_TA)
+	}
+
+	// teaching assistants can view, review and rebuild submissions
+	if _, err := ags.GetSubmissionsByCourse(taCtx, &pb.SubmissionsForCourseRequest{CourseID: course.ID}); err != nil {
+		t.Errorf("GetSubmissionsByCourse by teaching assistant failed: %v", err)
+	}
+	if _, err := ags.GetReviewQueue(taCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}); err != nil {
+		t.Errorf("GetReviewQueue by teaching assistant failed: %v", err)
+	}
+	if _, err := ags.RebuildSubmissions(taCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}); err != nil {
+		t.Errorf("RebuildSubmissions by teaching assistant failed: %v", err)
+	}
+
+	// but cannot manage the course
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	for name, call := range map[string]func() error{
+		"UpdateCourse": func() error {
+			_, err := callWithAccessControl(taCtx, ags, "UpdateCourse", course)
+			return err
+		},
+		"UpdateEnrollment": func() error {
+			_, err := callWithAccessControl(taCtx, ags, "UpdateEnrollment", &pb.Enrollment{UserID: student.ID, CourseID: course.ID, Status: pb.Enrollment_NONE})
+			return err
+		},
+		"UpdateSubmission": func() error {
+			_, err := callWithAccessControl(taCtx, ags, "UpdateSubmission", &pb.UpdateSubmissionRequest{CourseID: course.ID, SubmissionID: submission.ID, Status: pb.Submission_APPROVED})
+			return err
+		},
+		"GetGroupsByCourse": func() error {
+			_, err := callWithAccessControl(taCtx, ags, "GetGroupsByCourse", &pb.CourseRequest{CourseID: course.ID})
+			return err
+		},
+		"UpdateAssignments": func() error {
+			_, err := callWithAccessControl(taCtx, ags, "UpdateAssignments", &pb.CourseRequest{CourseID: course.ID})
+			return err
+		},
+	} {
+		if err := call(); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s by teaching assistant returned %v, want %v", name, err, codes.PermissionDenied)
+		}
+	}
+
+	// students cannot rebuild submissions
+	if _, err := callWithAccessControl(withUserContext(context.Background(), student), ags, "RebuildSubmissions", &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RebuildSubmissions by student returned %v, want %v", err, codes.PermissionDenied)
+	}
+}
+
+func TestUpdateSlipDays(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	otherStudent := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{
+		Name:           "Test",
+		Code:           "DAT320",
+		Provider:       "fake",
+		OrganizationID: 1,
+		SlipDays:       5,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, otherStudent, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	enrol, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// slip days used by a test run
+	if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrol.ID, AssignmentID: assignment.ID, AutomaticSlipDays: 3}}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	refund := &pb.SlipDaysRequest{
+		CourseID:     course.ID,
+		UserID:       student.ID,
+		AssignmentID: assignment.ID,
+		Change:       -2,
+		Reason:       "refund after rebuild",
+	}
+	if _, err := callWithAccessControl(studentCtx, ags, "UpdateSlipDays", refund); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateSlipDays() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	updated, err := ags.UpdateSlipDays(teacherCtx, refund)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.SlipDaysRemaining != 4 {
+		t.Errorf("SlipDaysRemaining = %d, want %d", updated.SlipDaysRemaining, 4)
+	}
+
+	// refunding more slip days than used must fail
+	refund.Change = -2
+	if _, err := ags.UpdateSlipDays(teacherCtx, refund); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateSlipDays() with too large refund: got error %v, want %v", err, codes.InvalidArgument)
+	}
+
+	// the manual adjustment must be kept when a new test run updates the slip days
+	enrol, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrol.Course = course
+	lab1 := &pb.Assignment{ID: assignment.ID, CourseID: course.ID, Deadline: "2021-01-01T12:00:00", ScoreLimit: 80}
+	delivered := time.Date(2021, 1, 4, 15, 0, 0, 0, time.UTC) // 4 slip days late
+	if err := enrol.UpdateSlipDays(delivered, lab1, &pb.Submission{AssignmentID: assignment.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
+		t.Fatal(err)
+	}
+
+	wantLedger := []*pb.SlipDayLedgerEntry{
+		{AssignmentID: assignment.ID, Source: pb.SlipDayLedgerEntry_AUTOMATIC, Change: 3, UsedSlipDays: 3},
+		{AssignmentID: assignment.ID, Source: pb.SlipDayLedgerEntry_MANUAL, Change: -2, UsedSlipDays: 1, Reason: "refund after rebuild", ActorID: teacher.ID},
+		{AssignmentID: assignment.ID, Source: pb.SlipDayLedgerEntry_AUTOMATIC, Change: 1, UsedSlipDays: 2},
+	}
+	ledgerRequest := &pb.SlipDayLedgerRequest{CourseID: course.ID, UserID: student.ID}
+	otherCtx := withUserContext(context.Background(), otherStudent)
+	if _, err := callWithAccessControl(otherCtx, ags, "GetSlipDayLedger", ledgerRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSlipDayLedger() by other student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	for _, ctx := range []context.Context{teacherCtx, studentCtx} {
+		ledger, err := ags.GetSlipDayLedger(ctx, ledgerRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(wantLedger, ledger.Entries, cmpopts.IgnoreUnexported(pb.SlipDayLedgerEntry{}), cmpopts.IgnoreFields(pb.SlipDayLedgerEntry{}, "ID", "EnrollmentID", "Date")); diff != "" {
+			t.Errorf("GetSlipDayLedger() mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	// per-assignment slip day usage must include the manual adjustment
+	enrollments, err := ags.GetEnrollmentsByCourse(teacherCtx, &pb.EnrollmentRequest{CourseID: course.ID, Statuses: []pb.Enrollment_UserStatus{pb.Enrollment_STUDENT}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range enrollments.Enrollments {
+		if e.UserID != student.ID {
+			continue
+		}
+		wantUsed := []*pb.UsedSlipDays{{EnrollmentID: e.ID, AssignmentID: assignment.ID, UsedSlipDays: 2, Adjustment: -2, AutomaticSlipDays: 4}}
+		if diff := cmp.Diff(wantUsed, e.UsedSlipDays, cmpopts.IgnoreUnexported(pb.UsedSlipDays{}), cmpopts.IgnoreFields(pb.UsedSlipDays{}, "ID")); diff != "" {
+			t.Errorf("GetEnrollmentsByCourse() UsedSlipDays mismatch (-want +got):\n%s", diff)
+		}
+		if e.SlipDaysRemaining != 3 {
+			t.Errorf("SlipDaysRemaining = %d, want %d", e.SlipDaysRemaining, 3)
+		}
+	}
+
+	// a manual adjustment made while a test run computes slip days must be kept
+	enrol, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrol.Course = course
+	if err := enrol.UpdateSlipDays(delivered, lab1, &pb.Submission{AssignmentID: assignment.ID}); err != nil {
+		t.Fatal(err)
+	}
+	refund.Change = -1
+	if _, err := ags.UpdateSlipDays(teacherCtx, refund); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
+		t.Fatal(err)
+	}
+	enrol, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantUsed := []*pb.UsedSlipDays{{EnrollmentID: enrol.ID, AssignmentID: assignment.ID, UsedSlipDays: 1, Adjustment: -3, AutomaticSlipDays: 4}}
+	if diff := cmp.Diff(wantUsed, enrol.UsedSlipDays, cmpopts.IgnoreUnexported(pb.UsedSlipDays{}), cmpopts.IgnoreFields(pb.UsedSlipDays{}, "ID")); diff != "" {
+		t.Errorf("UsedSlipDays after concurrent adjustment mismatch (-want +got):\n%s", diff)
+	}
+
+	// a concurrent adjustment is applied to the test run's automatic slip days,
+	// also when the test run's slip days were clamped to zero by the previous adjustment
+	enrol.Course = course
+	early := time.Date(2021, 1, 2, 15, 0, 0, 0, time.UTC) // 2 slip days late
+	if err := enrol.UpdateSlipDays(early, lab1, &pb.Submission{AssignmentID: assignment.ID}); err != nil {
+		t.Fatal(err)
+	}
+	refund.Change = 3
+	if _, err := ags.UpdateSlipDays(teacherCtx, refund); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays(enrol.UsedSlipDays); err != nil {
+		t.Fatal(err)
+	}
+	enrol, err = db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantUsed = []*pb.UsedSlipDays{{EnrollmentID: enrol.ID, AssignmentID: assignment.ID, UsedSlipDays: 2, Adjustment: 0, AutomaticSlipDays: 2}}
+	if diff := cmp.Diff(wantUsed, enrol.UsedSlipDays, cmpopts.IgnoreUnexported(pb.UsedSlipDays{}), cmpopts.IgnoreFields(pb.UsedSlipDays{}, "ID")); diff != "" {
+		t.Errorf("UsedSlipDays after clamped test run mismatch (-want +got):\n%s", diff)
+	}
+	ledger, err := ags.GetSlipDayLedger(teacherCtx, ledgerRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLedger = append(wantLedger,
+		&pb.SlipDayLedgerEntry{AssignmentID: assignment.ID, Source: pb.SlipDayLedgerEntry_MANUAL, Change: -1, UsedSlipDays: 1, Reason: "refund after rebuild", ActorID: teacher.ID},
+		&pb.SlipDayLedgerEntry{AssignmentID: assignment.ID, Source: pb.SlipDayLedgerEntry_MANUAL, Change: 3, UsedSlipDays: 4, Reason: "refund after rebuild", ActorID: teacher.ID},
+		&pb.SlipDayLedgerEntry{AssignmentID: assignment.ID, Source: pb.SlipDayLedgerEntry_AUTOMATIC, Change: -2, UsedSlipDays: 2},
+	)
+	if diff := cmp.Diff(wantLedger, ledger.Entries, cmpopts.IgnoreUnexported(pb.SlipDayLedgerEntry{}), cmpopts.IgnoreFields(pb.SlipDayLedgerEntry{}, "ID", "EnrollmentID", "Date")); diff != "" {
+		t.Errorf("GetSlipDayLedger() after clamped test run mismatch (-want +got):\n%s", diff)
+	}
+}
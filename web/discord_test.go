@@ -14,13 +14,13 @@ import (
 )
 
 // the test expects a grpc server already running on port :9090
-// and a valid user ID inside the DISCORD_USER environmental variable.
-// The user has to have an active record in the database and either be an admin
+// and a valid API token inside the DISCORD_TOKEN environmental variable.
+// The token's user has to have an active record in the database and either be an admin
 // or a teacher of the requested course.
 func TestDiscordClient(t *testing.T) {
-	currentUserID := os.Getenv("DISCORD_USER")
-	if currentUserID == "" {
-		t.Skip("This test requires a 'DISCORD_USER' environmental variable with a valid ID of a registered user")
+	token := strings.TrimSpace(os.Getenv("DISCORD_TOKEN"))
+	if token == "" {
+		t.Skip("This test requires a 'DISCORD_TOKEN' environmental variable with a valid API token of a registered user")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -33,8 +33,7 @@ func TestDiscordClient(t *testing.T) {
 
 	client := pb.NewAutograderServiceClient(conn)
 
-	convertedID := strings.TrimSpace(currentUserID)
-	requestMetadata := metadata.New(map[string]string{"user": convertedID})
+	requestMetadata := metadata.New(map[string]string{"authorization": "Bearer " + token})
 	requestContext := metadata.NewOutgoingContext(context.Background(), requestMetadata)
 
 	request := &pb.CourseUserRequest{
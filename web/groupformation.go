@@ -0,0 +1,227 @@
+package web
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/gosimple/slug"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// plannedGroup is a group to be formed, given by its name and the logins of its members.
+type plannedGroup struct {
+	name   string
+	logins []string
+}
+
+// formGroups forms groups of approved students in the given course and group set, either of the requested size
+// from the students without a group in the group set, or as given by the request's CSV groupings.
+// Each group is created and approved, creating its repository and team on the SCM.
+// Groups that cannot be formed are reported with the reason, without stopping the other groups from being formed.
+// In a dry run, the groups that would be formed are reported without creating them.
+func (s *AutograderService) formGroups(ctx context.Context, sc scm.SCM, request *pb.GroupFormationRequest) (*pb.GroupFormation, error) {
+	course, err := s.getCourse(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(request.GetCourseID(), pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(enrollments, func(i, j int) bool {
+		return enrollments[i].GetUserID() < enrollments[j].GetUserID()
+	})
+	courseGroups, err := s.db.GetGroupsByCourse(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	// group names that result in the same SCM team and repository names are taken
+	taken := make(map[string]bool)
+	// students that are already in a group of the group set
+	grouped := make(map[uint64]bool)
+	for _, group := range courseGroups {
+		taken[slug.Make(group.GetName())] = true
+		if group.GetGroupSet() != request.GetGroupSet() {
+			continue
+		}
+		for _, user := range group.GetUsers() {
+			grouped[user.GetID()] = true
+		}
+	}
+
+	var planned []*plannedGroup
+	if strings.TrimSpace(request.GetCsv()) != "" {
+		if planned, err = parseGroupsCSV(request.GetCsv()); err != nil {
+			return nil, err
+		}
+	} else {
+		planned = planGroupsOfSize(int(request.GetGroupSize()), request.GetGroupSet(), enrollments, grouped, taken)
+	}
+
+	formation := checkPlannedGroups(course, request.GetGroupSet(), planned, enrollments, grouped, taken)
+	if request.GetDryRun() {
+		return formation, nil
+	}
+	for _, formed := range formation.GetGroups() {
+		if formed.GetError() != "" {
+			continue
+		}
+		s.formGroup(ctx, sc, formed)
+	}
+	return formation, nil
+}
+
+// formGroup creates and approves the given group, and records the resulting group, or why it failed.
+func (s *AutograderService) formGroup(ctx context.Context, sc scm.SCM, formed *pb.FormedGroup) {
+	group, err := s.createGroup(formed.GetGroup())
+	if err != nil {
+		s.logger.Errorf("FormGroups: failed to create group %s: %v", formed.GetGroup().GetName(), err)
+		formed.Error = status.Convert(err).Message()
+		return
+	}
+	formed.Group = group
+	if err := s.updateGroup(ctx, sc, group); err != nil {
+		// the group is kept, so that the teacher can approve it later
+		s.logger.Errorf("FormGroups: failed to approve group %s: %v", group.GetName(), err)
+		formed.Error = fmt.Sprintf("group created, but not approved: %s", status.Convert(err).Message())
+		return
+	}
+	if group, err = s.db.GetGroup(group.GetID()); err == nil {
+		formed.Group = group
+	}
+}
+
+// parseGroupsCSV returns the groups given by the CSV groupings,
+// with one group per line: the group name followed by the logins of its members.
+// Empty lines and lines starting with # are ignored.
+func parseGroupsCSV(groupings string) ([]*plannedGroup, error) {
+	r := csv.NewReader(strings.NewReader(groupings))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+	var planned []*plannedGroup
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "malformed groupings: %v", err)
+		}
+		group := &plannedGroup{name: strings.TrimSpace(record[0])}
+		for _, login := range record[1:] {
+			if login = strings.TrimSpace(login); login != "" {
+				group.logins = append(group.logins, login)
+			}
+		}
+		planned = append(planned, group)
+	}
+	return planned, nil
+}
+
+// planGroupsOfSize divides the students without a group into groups of the given size.
+// If the students cannot be evenly divided, the remaining students are added to
+// some of the groups, such that no group is smaller than the given size,
+// unless there are fewer students than the given size.
+// The groups are named group-1, group-2, etc., or after the group set, skipping names that are taken.
+func planGroupsOfSize(size int, groupSet string, enrollments []*pb.Enrollment, grouped map[uint64]bool, taken map[string]bool) []*plannedGroup {
+	prefix := "group"
+	if groupSet != "" {
+		prefix = groupSet
+	}
+	var students []string
+	for _, enrollment := range enrollments {
+		if !grouped[enrollment.GetUserID()] {
+			students = append(students, enrollment.GetUser().GetLogin())
+		}
+	}
+	if len(students) == 0 {
+		return nil
+	}
+	numGroups := len(students) / size
+	if numGroups == 0 {
+		numGroups = 1
+	}
+	planned := make([]*plannedGroup, numGroups)
+	next := 1
+	for i := range planned {
+		name := fmt.Sprintf("%s-%d", prefix, next)
+		for taken[slug.Make(name)] {
+			next++
+			name = fmt.Sprintf("%s-%d", prefix, next)
+		}
+		next++
+		planned[i] = &plannedGroup{name: name}
+	}
+	for i, login := range students {
+		group := planned[i%numGroups]
+		group.logins = append(group.logins, login)
+	}
+	return planned
+}
+
+// checkPlannedGroups returns the groups to form for the planned groups, with the reason for each group that cannot be formed:
+// its name is taken, its size is not allowed by the course,
+// or one of its members is not an approved student without a group in the group set.
+func checkPlannedGroups(course *pb.Course, groupSet string, planned []*plannedGroup, enrollments []*pb.Enrollment, grouped map[uint64]bool, taken map[string]bool) *pb.GroupFormation {
+	students := make(map[string]*pb.Enrollment)
+	for _, enrollment := range enrollments {
+		students[enrollment.GetUser().GetLogin()] = enrollment
+	}
+	// students in the groups to form
+	planning := make(map[string]bool)
+
+	formation := &pb.GroupFormation{}
+	for _, p := range planned {
+		group := &pb.Group{Name: p.name, CourseID: course.GetID(), GroupSet: groupSet}
+		formed := &pb.FormedGroup{Group: group}
+		formation.Groups = append(formation.Groups, formed)
+
+		switch {
+		case p.name == "":
+			formed.Error = "missing group name"
+		case taken[slug.Make(p.name)]:
+			formed.Error = "group with this name already exists"
+		case len(p.logins) == 0:
+			formed.Error = "no users in group"
+		}
+		members := make(map[string]bool)
+		for _, login := range p.logins {
+			if formed.Error != "" {
+				break
+			}
+			enrollment, ok := students[login]
+			switch {
+			case !ok:
+				formed.Error = fmt.Sprintf("user %s is not an approved student in this course", login)
+			case grouped[enrollment.GetUserID()] || planning[login]:
+				formed.Error = fmt.Sprintf("user %s is already in another group", login)
+			case members[login]:
+				formed.Error = fmt.Sprintf("user %s is listed more than once", login)
+			default:
+				members[login] = true
+				group.Users = append(group.Users, enrollment.GetUser())
+			}
+		}
+		if formed.Error == "" {
+			if err := checkGroupSize(course, len(group.GetUsers())); err != nil {
+				formed.Error = status.Convert(err).Message()
+			}
+		}
+		if formed.Error != "" {
+			continue
+		}
+		taken[slug.Make(p.name)] = true
+		for login := range members {
+			planning[login] = true
+		}
+	}
+	return formation
+}
@@ -0,0 +1,173 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFormGroups(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	enroll := func(remoteID uint64) *pb.User {
+		t.Helper()
+		student := qtest.CreateFakeUser(t, db, remoteID)
+		student.Login = fmt.Sprintf("student%d", remoteID)
+		if err := db.UpdateUser(student); err != nil {
+			t.Fatal(err)
+		}
+		qtest.EnrollStudent(t, db, student, course)
+		return student
+	}
+	for i := uint64(2); i <= 8; i++ {
+		enroll(i)
+	}
+	pending := qtest.CreateFakeUser(t, db, 20)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: pending.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeGothProvider()
+	fakeSCM, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+
+	// members returns the logins of the members of each formed group, or its error
+	members := func(formation *pb.GroupFormation) map[string][]string {
+		got := make(map[string][]string)
+		for _, formed := range formation.GetGroups() {
+			if formed.GetError() != "" {
+				got[formed.GetGroup().GetName()] = []string{formed.GetError()}
+				continue
+			}
+			for _, user := range formed.GetGroup().GetUsers() {
+				got[formed.GetGroup().GetName()] = append(got[formed.GetGroup().GetName()], user.GetLogin())
+			}
+		}
+		return got
+	}
+
+	// seven students are divided into one group of four and one group of three
+	request := &pb.GroupFormationRequest{CourseID: course.ID, GroupSize: 3, DryRun: true}
+	formation, err := ags.FormGroups(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"group-1": {"student2", "student4", "student6", "student8"},
+		"group-2": {"student3", "student5", "student7"},
+	}
+	if diff := cmp.Diff(want, members(formation)); diff != "" {
+		t.Errorf("FormGroups dry run mismatch (-want +got):\n%s", diff)
+	}
+	if groups, _ := db.GetGroupsByCourse(course.ID); len(groups) != 0 {
+		t.Errorf("dry run created %d groups, want 0", len(groups))
+	}
+
+	request.DryRun = false
+	formation, err = ags.FormGroups(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, members(formation)); diff != "" {
+		t.Errorf("FormGroups mismatch (-want +got):\n%s", diff)
+	}
+	for _, formed := range formation.GetGroups() {
+		if formed.GetGroup().GetStatus() != pb.Group_APPROVED || formed.GetGroup().GetTeamID() == 0 {
+			t.Errorf("group %s has status %v and team %d, want approved group with team",
+				formed.GetGroup().GetName(), formed.GetGroup().GetStatus(), formed.GetGroup().GetTeamID())
+		}
+	}
+	if repos := fakeSCM.(*scm.FakeSCM).Repositories; len(repos) != 2 {
+		t.Errorf("got %d repositories, want 2", len(repos))
+	}
+
+	// only the valid groups are formed from the groupings
+	enroll(9)
+	enroll(10)
+	enroll(11)
+	request = &pb.GroupFormationRequest{CourseID: course.ID, Csv: `# name, members
+team-a, student9, student10
+Group-1, student11
+team-b, student2
+team-c, nobody
+team-d, student11, student11
+team-a, student11
+team-e,
+team-f, student11
+`}
+	formation, err = ags.FormGroups(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[string][]string{
+		"team-a":  {"group with this name already exists"},
+		"Group-1": {"group with this name already exists"},
+		"team-b":  {"user student2 is already in another group"},
+		"team-c":  {"user nobody is not an approved student in this course"},
+		"team-d":  {"user student11 is listed more than once"},
+		"team-e":  {"no users in group"},
+		"team-f":  {"student11"},
+	}
+	got := members(formation)
+	// the first team-a is formed, while the second is rejected
+	if len(formation.GetGroups()) != 8 || formation.GetGroups()[0].GetError() != "" {
+		t.Fatalf("got %d groups with first group error %q, want 8 groups with first group formed",
+			len(formation.GetGroups()), formation.GetGroups()[0].GetError())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FormGroups from groupings mismatch (-want +got):\n%s", diff)
+	}
+	if groups, _ := db.GetGroupsByCourse(course.ID); len(groups) != 4 {
+		t.Errorf("got %d groups, want 4", len(groups))
+	}
+
+	// students in a group of the default groups can be grouped in another group set
+	formation, err = ags.FormGroups(ctx, &pb.GroupFormationRequest{CourseID: course.ID, GroupSize: 20, GroupSet: "projects", DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[string][]string{"projects-1": {}}
+	for i := 2; i <= 11; i++ {
+		want["projects-1"] = append(want["projects-1"], fmt.Sprintf("student%d", i))
+	}
+	if diff := cmp.Diff(want, members(formation)); diff != "" {
+		t.Errorf("FormGroups in group set mismatch (-want +got):\n%s", diff)
+	}
+	if groupSet := formation.GetGroups()[0].GetGroup().GetGroupSet(); groupSet != "projects" {
+		t.Errorf("FormGroups formed group in group set %q, want %q", groupSet, "projects")
+	}
+
+	request.Csv = "team-g, \"student9"
+	if _, err := ags.FormGroups(ctx, request); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("FormGroups with malformed groupings returned %v, want %v", err, codes.InvalidArgument)
+	}
+
+	studentCtx := withUserContext(context.Background(), pending)
+	if _, err := callWithAccessControl(studentCtx, ags, "FormGroups", request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("FormGroups by student returned %v, want %v", err, codes.PermissionDenied)
+	}
+}
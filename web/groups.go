@@ -3,8 +3,10 @@ package web
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/gosimple/slug"
 	"google.golang.org/grpc/codes"
@@ -37,7 +39,15 @@ func (s *AutograderService) getGroups(request *pb.CourseRequest) (*pb.Groups, er
 }
 
 // getGroupByUserAndCourse returns the group of the given user and course.
+// If the request gives a group set, the user's group in that group set is returned.
 func (s *AutograderService) getGroupByUserAndCourse(request *pb.GroupRequest) (*pb.Group, error) {
+	if request.GetGroupSet() != "" {
+		grp, err := s.db.GetGroupInSet(request.GetCourseID(), request.GetUserID(), request.GetGroupSet())
+		if err != nil && err == gorm.ErrRecordNotFound {
+			err = ErrUserNotInGroup
+		}
+		return grp, err
+	}
 	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.CourseID, request.UserID)
 	if err != nil {
 		return nil, err
@@ -70,7 +80,11 @@ func (s *AutograderService) deleteGroup(ctx context.Context, sc scm.SCM, request
 		}
 	}
 
-	return s.db.DeleteGroup(request.GetGroupID())
+	if err := s.db.DeleteGroup(request.GetGroupID()); err != nil {
+		return err
+	}
+	s.notifier.DispatchAction(notify.GroupChanged, notify.Deleted, group.GetCourseID(), group)
+	return nil
 }
 
 // createGroup creates a new group for the given course and users.
@@ -90,7 +104,12 @@ func (s *AutograderService) createGroup(request *pb.Group) (*pb.Group, error) {
 	if err := s.db.CreateGroup(request); err != nil {
 		return nil, err
 	}
-	return s.db.GetGroup(request.ID)
+	group, err := s.db.GetGroup(request.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.notifier.DispatchAction(notify.GroupChanged, notify.Created, group.GetCourseID(), group)
+	return group, nil
 }
 
 // updateGroup updates the group for the given group request.
@@ -106,6 +125,8 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 		return err
 	}
 
+	// a group stays in the group set it was created in
+	request.GroupSet = group.GetGroupSet()
 	// get users of group, check consistency of group request
 	users, err := s.getGroupUsers(request)
 	if err != nil {
@@ -131,6 +152,7 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 		Status:      group.Status,
 		Users:       users,
 		Enrollments: group.Enrollments,
+		GroupSet:    group.GroupSet,
 	}
 
 	if len(repos) == 0 {
@@ -146,6 +168,7 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 		if err := s.db.CreateRepository(repo); err != nil {
 			return err
 		}
+		s.grantTAAccess(ctx, sc, course.GetID(), course.GetOrganizationPath(), repo)
 		newGroup.TeamID = team.ID
 		// when updating a group for an existing team, name changes are not allowed.
 		// this to avoid a mismatch between database group name and SCM team name
@@ -164,13 +187,20 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 
 	// approve and update the group in the database
 	newGroup.Status = pb.Group_APPROVED
-	return s.db.UpdateGroup(newGroup)
+	if err := s.db.UpdateGroup(newGroup); err != nil {
+		return err
+	}
+	if group.GetStatus() != pb.Group_APPROVED {
+		s.notifier.Notify(pb.NotificationOptOut_GROUP, newGroup.GetUsers(), &notify.Event{Course: course, Group: newGroup})
+	}
+	s.notifier.DispatchAction(notify.GroupChanged, notify.Updated, course.GetID(), newGroup)
+	return nil
 }
 
 // getGroupUsers returns the users of the specified group request, and checks
 // that the group's users are enrolled in the course,
 // that the enrollment has been accepted, and
-// that the group's users are not already enrolled in another group.
+// that the group's users are not already enrolled in another group of the group's group set.
 func (s *AutograderService) getGroupUsers(request *pb.Group) ([]*pb.User, error) {
 	if len(request.Users) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "no users in group")
@@ -183,15 +213,24 @@ func (s *AutograderService) getGroupUsers(request *pb.Group) ([]*pb.User, error)
 			return nil, status.Errorf(codes.NotFound, "user not enrolled in this course")
 		case err != nil:
 			return nil, err
-		case enrollment.GroupID > 0 && request.ID == 0:
-			// new group check (request group ID should be 0)
-			return nil, status.Errorf(codes.InvalidArgument, "user already enrolled in another group")
-		case enrollment.GroupID > 0 && enrollment.GroupID != request.ID:
-			// update group check (request group ID should be non-0)
-			return nil, status.Errorf(codes.InvalidArgument, "user already enrolled in another group")
 		case enrollment.Status < pb.Enrollment_STUDENT:
 			return nil, status.Errorf(codes.InvalidArgument, "user not yet accepted for this course")
 		}
+		groupID := enrollment.GroupID
+		if request.GetGroupSet() != "" {
+			groupID = 0
+			grp, err := s.db.GetGroupInSet(request.GetCourseID(), user.GetID(), request.GetGroupSet())
+			switch {
+			case err == nil:
+				groupID = grp.GetID()
+			case err != gorm.ErrRecordNotFound:
+				return nil, err
+			}
+		}
+		// for a new group, the request group ID is 0
+		if groupID > 0 && groupID != request.ID {
+			return nil, status.Errorf(codes.InvalidArgument, "user already enrolled in another group")
+		}
 		userIds = append(userIds, user.ID)
 	}
 
@@ -206,6 +245,36 @@ func (s *AutograderService) getGroupUsers(request *pb.Group) ([]*pb.User, error)
 	return users, nil
 }
 
+// checkGroupRules returns an error if the group's size is outside the course's group size limits,
+// or if a student creates a group after the course's group formation deadline or freeze date.
+// Teachers can form and change groups after the deadline and freeze date.
+func checkGroupRules(course *pb.Course, group *pb.Group, byTeacher bool, now time.Time) error {
+	if err := checkGroupSize(course, len(group.GetUsers())); err != nil {
+		return err
+	}
+	if byTeacher {
+		return nil
+	}
+	switch {
+	case course.GroupsFrozen(now):
+		return status.Error(codes.FailedPrecondition, "groups are frozen for this course; ask a teacher to change your group")
+	case course.GroupFormationClosed(now):
+		return status.Error(codes.FailedPrecondition, "the group formation deadline for this course has passed; ask a teacher to form your group")
+	}
+	return nil
+}
+
+// checkGroupSize returns an error if the course does not allow groups with the given number of members.
+func checkGroupSize(course *pb.Course, size int) error {
+	if course.GroupSizeAllowed(size) {
+		return nil
+	}
+	if size < int(course.GetMinGroupSize()) {
+		return status.Errorf(codes.InvalidArgument, "groups in this course must have at least %d members", course.GetMinGroupSize())
+	}
+	return status.Errorf(codes.InvalidArgument, "groups in this course can have at most %d members", course.GetMaxGroupSize())
+}
+
 // isValidGroupName ensures that SCM team and repository names for the given group
 // will not coincide with one of the existing approved groups
 func (s *AutograderService) isValidGroupName(courseID uint64, groupName string) bool {
@@ -221,11 +290,15 @@ func (s *AutograderService) isValidGroupName(courseID uint64, groupName string)
 
 // getCourseGroupRepos returns the group, the group's repositories and the organization ID
 // for the given course and group specified in the GroupRequest.
+// The group must belong to the given course.
 func (s *AutograderService) getCourseGroupRepos(request *pb.GroupRequest) (*pb.Group, []*pb.Repository, *pb.Course, error) {
 	group, err := s.db.GetGroup(request.GetGroupID())
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if group.GetCourseID() != request.GetCourseID() {
+		return nil, nil, nil, fmt.Errorf("group %d does not belong to course %d", group.GetID(), request.GetCourseID())
+	}
 	course, err := s.db.GetCourse(request.GetCourseID(), false)
 	if err != nil {
 		return nil, nil, nil, err
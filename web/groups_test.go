@@ -3,10 +3,13 @@ package web_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -928,7 +931,7 @@ func TestGetGroups(t *testing.T) {
 	}
 
 	// check that request on non-existent course returns error
-	_, err = ags.GetGroupsByCourse(ctx, &pb.CourseRequest{CourseID: 15})
+	_, err = callWithAccessControl(ctx, ags, "GetGroupsByCourse", &pb.CourseRequest{CourseID: 15})
 	if err == nil {
 		t.Error("expected error; no groups should be returned")
 	}
@@ -945,3 +948,66 @@ func TestGetGroups(t *testing.T) {
 		t.Errorf("mismatch (-wantGroups +gotGroups):\n%s", diff)
 	}
 }
+
+func TestGroupRules(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+		MinGroupSize:     2,
+		MaxGroupSize:     3,
+		GroupDeadline:    time.Now().Add(-time.Hour).Format(pb.TimeLayout),
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	for i := uint64(2); i <= 6; i++ {
+		student := qtest.CreateFakeUser(t, db, i)
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, &pb.User{ID: student.ID})
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	studentCtx := withUserContext(context.Background(), students[0])
+	teacherCtx := withUserContext(context.Background(), teacher)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		users []*pb.User
+		want  codes.Code
+	}{
+		{"StudentAfterDeadline", studentCtx, students[:2], codes.FailedPrecondition},
+		{"TooSmall", teacherCtx, students[:1], codes.InvalidArgument},
+		{"TooLarge", teacherCtx, students[:4], codes.InvalidArgument},
+		{"TeacherAfterDeadline", teacherCtx, students[:3], codes.OK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			group := &pb.Group{Name: test.name, CourseID: course.ID, Users: test.users}
+			if _, err := ags.CreateGroup(test.ctx, group); status.Code(err) != test.want {
+				t.Errorf("CreateGroup() = %v, want %v", err, test.want)
+			}
+		})
+	}
+
+	// once groups are frozen, students cannot create groups, even before the deadline
+	course.GroupDeadline = time.Now().Add(time.Hour).Format(pb.TimeLayout)
+	course.GroupFreezeDate = time.Now().Add(-time.Hour).Format(pb.TimeLayout)
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	group := &pb.Group{Name: "Frozen", CourseID: course.ID, Users: students[3:5]}
+	if _, err := ags.CreateGroup(withUserContext(context.Background(), students[3]), group); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateGroup() after freeze date = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
@@ -1,7 +1,10 @@
 package hooks
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -9,26 +12,32 @@ import (
 	"github.com/autograde/quickfeed/assignments"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
-	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web/auth"
 	"github.com/google/go-github/v35/github"
 	"go.uber.org/zap"
 )
 
 // GitHubWebHook holds references and data for handling webhook events.
 type GitHubWebHook struct {
-	logger *zap.SugaredLogger
-	db     database.Database
-	runner ci.Runner
-	secret string
+	logger   *zap.SugaredLogger
+	db       database.Database
+	runner   ci.Runner
+	notifier *notify.Notifier
+	scms     *auth.Scms
+	secret   string
+	baseURL  string
 }
 
 // NewGitHubWebHook creates a new webhook to handle POST requests from GitHub to the Autograder server.
-func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, secret string) *GitHubWebHook {
-	return &GitHubWebHook{logger: logger, db: db, runner: runner, secret: secret}
+// The base URL of the server is used to link the test results reported to the repositories.
+func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, notifier *notify.Notifier, scms *auth.Scms, secret, baseURL string) *GitHubWebHook {
+	return &GitHubWebHook{logger: logger, db: db, runner: runner, notifier: notifier, scms: scms, secret: secret, baseURL: baseURL}
 }
 
-// Handle take POST requests from GitHub, representing Push events
+// Handle take POST requests from GitHub, representing Push and Pull Request events
 // associated with course repositories, which then triggers various
 // actions on the Autograder backend.
 func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
@@ -48,72 +57,224 @@ func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
 	case *github.PushEvent:
 		wh.logger.Debug(log.IndentJson(e))
 		wh.handlePush(e)
+	case *github.PullRequestEvent:
+		wh.logger.Debug(log.IndentJson(e))
+		wh.handlePullRequest(e)
 	default:
 		wh.logger.Debugf("Ignored event type %s", github.WebHookType(r))
 	}
 }
 
+const (
+	tagRefPrefix    = "refs/tags/"
+	submitTagSuffix = "-submit"
+)
+
+// submitKeyword matches the commit message keyword [submit <assignment>].
+var submitKeyword = regexp.MustCompile(`(?i)\[submit\s+([^\]\s]+)\s*\]`)
+
 func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	wh.logger.Debugf("Received push event for branch reference: %s (user's default branch: %s)",
 		payload.GetRef(), payload.GetRepo().GetDefaultBranch())
+	if tag := strings.TrimPrefix(payload.GetRef(), tagRefPrefix); tag != payload.GetRef() {
+		wh.handleTag(payload, tag)
+		return
+	}
 	if !strings.HasSuffix(payload.GetRef(), payload.GetRepo().GetDefaultBranch()) {
 		wh.logger.Debugf("Ignoring push event for non-default branch: %s", payload.GetRef())
 		return
 	}
 
-	repo, err := wh.db.GetRepositoryByRemoteID(uint64(payload.GetRepo().GetID()))
+	repo, course, err := wh.getRepoAndCourse(payload.GetRepo().GetID())
 	if err != nil {
-		wh.logger.Errorf("Failed to get repository by remote ID %d from database: %v", payload.GetRepo().GetID(), err)
+		wh.logger.Error(err)
 		return
 	}
 	wh.logger.Debugf("Received push event for repository %v", repo)
 
-	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
-	if err != nil {
-		wh.logger.Errorf("Failed to get course from database: %v", err)
-		return
-	}
-	wh.logger.Debugf("For course(%d)=%v", course.GetID(), course.GetName())
-
 	switch {
 	case repo.IsTestsRepo():
 		// the push event is for the 'tests' repo, which means that we
 		// should update the course data (assignments) in the database
-		assignments.UpdateFromTestsRepo(wh.logger, wh.db, repo, course)
+		assignments.UpdateFromTestsRepo(wh.logger, wh.db, wh.notifier, repo, course)
 
-	case repo.IsUserRepo():
-		wh.logger.Debugf("Processing push event for user repo %s", payload.GetRepo().GetName())
-		wh.updateLastActivityDate(repo.UserID, course.ID)
-		assignments := wh.extractAssignments(payload, course)
-		for _, assignment := range assignments {
-			if !assignment.IsGroupLab {
-				// only run non-group assignments
-				wh.runAssignmentTests(assignment, repo, course, payload)
-			} else {
-				wh.logger.Debugf("Ignoring assignment: %s, pushed to user repo: %s", assignment.GetName(), payload.GetRepo().GetName())
-			}
+	case course.GetSubmissionMode() == pb.Course_PULL_REQUEST:
+		// pushes, including merged pull requests, are not submissions in this mode
+		wh.logger.Debugf("Ignoring push event for repo %s: course %s uses pull request submissions", payload.GetRepo().GetName(), course.GetName())
+
+	case course.GetSubmissionTrigger() == pb.Course_TAG:
+		wh.logger.Debugf("Ignoring push event for repo %s: course %s uses submission tags", payload.GetRepo().GetName(), course.GetName())
+
+	case course.GetSubmissionTrigger() == pb.Course_COMMIT_MESSAGE && (repo.IsUserRepo() || repo.IsGroupRepo()):
+		for commitID, modifiedAssignments := range extractSubmitKeywords(payload.Commits) {
+			wh.submit(repo, course, wh.getAssignments(course, modifiedAssignments), &submittedCommit{
+				commitID: commitID,
+				sender:   payload.GetSender().GetLogin(),
+			})
 		}
 
-	case repo.IsGroupRepo():
-		wh.logger.Debugf("Processing push event for group repo %s", payload.GetRepo().GetName())
-		jobOwner, _, err := wh.db.GetUserByCourse(course, payload.GetSender().GetLogin())
+	case repo.IsUserRepo(), repo.IsGroupRepo():
+		wh.submit(repo, course, wh.extractAssignments(payload, course), &submittedCommit{
+			commitID: payload.GetHeadCommit().GetID(),
+			sender:   payload.GetSender().GetLogin(),
+		})
+
+	default:
+		wh.logger.Debug("Nothing to do for this push event")
+	}
+}
+
+// handleTag tests the assignment named by a pushed <assignment>-submit tag at the tagged commit,
+// for courses where students submit by pushing tags.
+func (wh GitHubWebHook) handleTag(payload *github.PushEvent, tag string) {
+	if payload.GetDeleted() {
+		wh.logger.Debugf("Ignoring deleted tag: %s", tag)
+		return
+	}
+	name := strings.TrimSuffix(tag, submitTagSuffix)
+	if name == tag || name == "" {
+		wh.logger.Debugf("Ignoring tag that is not a submission: %s", tag)
+		return
+	}
+
+	repo, course, err := wh.getRepoAndCourse(payload.GetRepo().GetID())
+	if err != nil {
+		wh.logger.Error(err)
+		return
+	}
+	if course.GetSubmissionMode() != pb.Course_PUSH || course.GetSubmissionTrigger() != pb.Course_TAG {
+		wh.logger.Debugf("Ignoring tag %s for repo %s: course %s does not use submission tags", tag, payload.GetRepo().GetName(), course.GetName())
+		return
+	}
+	if !repo.IsUserRepo() && !repo.IsGroupRepo() {
+		wh.logger.Debug("Nothing to do for this tag")
+		return
+	}
+	wh.submit(repo, course, wh.getAssignments(course, map[string]bool{name: true}), &submittedCommit{
+		commitID: payload.GetHeadCommit().GetID(),
+		sender:   payload.GetSender().GetLogin(),
+	})
+}
+
+// handlePullRequest tests the assignments changed by a pull request against the default branch
+// of a user or group repository, for courses where students submit by opening pull requests.
+// The tests are run when the pull request is opened, reopened, or synchronized with new commits.
+func (wh GitHubWebHook) handlePullRequest(payload *github.PullRequestEvent) {
+	pullRequest := payload.GetPullRequest()
+	wh.logger.Debugf("Received pull request event %s for pull request %d against %s (user's default branch: %s)",
+		payload.GetAction(), payload.GetNumber(), pullRequest.GetBase().GetRef(), payload.GetRepo().GetDefaultBranch())
+	switch payload.GetAction() {
+	case "opened", "reopened", "synchronize":
+	default:
+		wh.logger.Debugf("Ignoring pull request event %s", payload.GetAction())
+		return
+	}
+	if pullRequest.GetBase().GetRef() != payload.GetRepo().GetDefaultBranch() {
+		wh.logger.Debugf("Ignoring pull request against non-default branch: %s", pullRequest.GetBase().GetRef())
+		return
+	}
+	if pullRequest.GetHead().GetRepo().GetID() != payload.GetRepo().GetID() {
+		// only pull requests between the branches of a student's or group's own repository are submissions
+		wh.logger.Debugf("Ignoring pull request from another repository: %s", pullRequest.GetHead().GetRepo().GetFullName())
+		return
+	}
+
+	repo, course, err := wh.getRepoAndCourse(payload.GetRepo().GetID())
+	if err != nil {
+		wh.logger.Error(err)
+		return
+	}
+	if course.GetSubmissionMode() != pb.Course_PULL_REQUEST {
+		wh.logger.Debugf("Ignoring pull request event for repo %s: course %s uses push submissions", payload.GetRepo().GetName(), course.GetName())
+		return
+	}
+	if !repo.IsUserRepo() && !repo.IsGroupRepo() {
+		wh.logger.Debug("Nothing to do for this pull request event")
+		return
+	}
+
+	sc, err := wh.scms.GetOrCreateSCMEntry(wh.logger.Desugar(), course.GetProvider(), course.GetAccessToken())
+	if err != nil {
+		wh.logger.Errorf("Failed to create SCM Client: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pb.MaxWait)
+	defer cancel()
+	files, err := sc.GetPullRequestFiles(ctx, &scm.PullRequestOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: payload.GetRepo().GetName(),
+		Number:     uint64(payload.GetNumber()),
+	})
+	if err != nil {
+		wh.logger.Errorf("Failed to get files of pull request %d: %v", payload.GetNumber(), err)
+		return
+	}
+	modifiedAssignments := make(map[string]bool)
+	extractChanges(files, modifiedAssignments)
+	wh.submit(repo, course, wh.getAssignments(course, modifiedAssignments), &submittedCommit{
+		commitID:    pullRequest.GetHead().GetSHA(),
+		sender:      payload.GetSender().GetLogin(),
+		pullRequest: uint64(payload.GetNumber()),
+	})
+}
+
+// getRepoAndCourse returns the repository with the given remote ID and the course it belongs to.
+func (wh GitHubWebHook) getRepoAndCourse(remoteID int64) (*pb.Repository, *pb.Course, error) {
+	repo, err := wh.db.GetRepositoryByRemoteID(uint64(remoteID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get repository by remote ID %d from database: %w", remoteID, err)
+	}
+	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get course from database: %w", err)
+	}
+	wh.logger.Debugf("For course(%d)=%v", course.GetID(), course.GetName())
+	return repo, course, nil
+}
+
+// submittedCommit describes the commit submitted by a push or a pull request.
+type submittedCommit struct {
+	commitID    string
+	sender      string // login of the user that pushed the commit or opened the pull request
+	pullRequest uint64 // number of the pull request, if submitted by a pull request
+}
+
+// submit runs the tests for the given assignments submitted to a user or group repository.
+// Only individual assignments are tested for user repositories,
+// and only group assignments of the group's group set are tested for group repositories.
+func (wh GitHubWebHook) submit(repo *pb.Repository, course *pb.Course, assignments []*pb.Assignment, commit *submittedCommit) {
+	var groupSet string
+	if repo.IsGroupRepo() {
+		wh.logger.Debugf("Processing submission to group repo %s", repo.GetHTMLURL())
+		group, err := wh.db.GetGroup(repo.GetGroupID())
+		if err != nil {
+			wh.logger.Errorf("Failed to find group %d for repo %s: %v", repo.GetGroupID(), repo.GetHTMLURL(), err)
+			return
+		}
+		groupSet = group.GetGroupSet()
+		jobOwner, _, err := wh.db.GetUserByCourse(course, commit.sender)
 		if err != nil {
-			wh.logger.Errorf("Failed to find user %s in course %s: %v", payload.GetSender().GetLogin(), course.GetName(), err)
+			wh.logger.Errorf("Failed to find user %s in course %s: %v", commit.sender, course.GetName(), err)
 			return
 		}
 		wh.updateLastActivityDate(jobOwner.ID, course.ID)
-		assignments := wh.extractAssignments(payload, course)
-		for _, assignment := range assignments {
-			if assignment.IsGroupLab {
-				// only run group assignments
-				wh.runAssignmentTests(assignment, repo, course, payload)
-			} else {
-				wh.logger.Debugf("Ignoring assignment: %s, pushed to group repo: %s", assignment.GetName(), payload.GetRepo().GetName())
-			}
+	} else {
+		wh.logger.Debugf("Processing submission to user repo %s", repo.GetHTMLURL())
+		wh.updateLastActivityDate(repo.UserID, course.ID)
+	}
+	for _, assignment := range assignments {
+		if assignment.IsGroupLab != repo.IsGroupRepo() {
+			// only run group assignments for group repos, and individual assignments for user repos
+			wh.logger.Debugf("Ignoring assignment: %s, submitted to repo: %s", assignment.GetName(), repo.GetHTMLURL())
+			continue
 		}
-
-	default:
-		wh.logger.Debug("Nothing to do for this push event")
+		if assignment.GetGroupSet() != groupSet {
+			// only run group assignments for the repos of the groups in the assignment's group set
+			wh.logger.Debugf("Ignoring assignment: %s of group set %q, submitted to repo: %s of group set %q",
+				assignment.GetName(), assignment.GetGroupSet(), repo.GetHTMLURL(), groupSet)
+			continue
+		}
+		wh.runAssignmentTests(assignment, repo, course, commit)
 	}
 }
 
@@ -128,6 +289,11 @@ func (wh GitHubWebHook) extractAssignments(payload *github.PushEvent, course *pb
 		extractChanges(commit.Removed, modifiedAssignments)
 	}
 
+	return wh.getAssignments(course, modifiedAssignments)
+}
+
+// getAssignments returns the course's assignments with the given names.
+func (wh GitHubWebHook) getAssignments(course *pb.Course, modifiedAssignments map[string]bool) []*pb.Assignment {
 	var assignments []*pb.Assignment
 	for name := range modifiedAssignments {
 		// get assignment based on course id and assignment name
@@ -141,6 +307,27 @@ func (wh GitHubWebHook) extractAssignments(payload *github.PushEvent, course *pb
 	return assignments
 }
 
+// extractSubmitKeywords returns the assignments named by [submit <assignment>] keywords
+// in the messages of the pushed commits, keyed by the commit to test. If several commits
+// name the same assignment, only the most recent of them is tested.
+func extractSubmitKeywords(commits []*github.HeadCommit) map[string]map[string]bool {
+	submitted := make(map[string]string)
+	for _, commit := range commits {
+		// commits are ordered from oldest to newest
+		for _, match := range submitKeyword.FindAllStringSubmatch(commit.GetMessage(), -1) {
+			submitted[match[1]] = commit.GetID()
+		}
+	}
+	commitAssignments := make(map[string]map[string]bool)
+	for name, commitID := range submitted {
+		if commitAssignments[commitID] == nil {
+			commitAssignments[commitID] = make(map[string]bool)
+		}
+		commitAssignments[commitID][name] = true
+	}
+	return commitAssignments
+}
+
 func extractChanges(changes []string, modifiedAssignments map[string]bool) {
 	for _, changedFile := range changes {
 		index := strings.Index(changedFile, "/")
@@ -158,38 +345,35 @@ func extractChanges(changes []string, modifiedAssignments map[string]bool) {
 	}
 }
 
-// runAssignmentTests runs the tests for the given assignment pushed to repo.
-func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, payload *github.PushEvent) {
+// runAssignmentTests runs the tests for the given assignment submitted to repo.
+func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, commit *submittedCommit) {
 	runData := &ci.RunData{
-		Course:     course,
-		Assignment: assignment,
-		Repo:       repo,
-		CommitID:   payload.GetHeadCommit().GetID(),
-		JobOwner:   payload.GetSender().GetLogin(),
+		Course:      course,
+		Assignment:  assignment,
+		Repo:        repo,
+		CommitID:    commit.commitID,
+		JobOwner:    commit.sender,
+		BaseURL:     wh.baseURL,
+		PullRequest: commit.pullRequest,
 	}
 	if assignment.GradedManually() {
 		wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.Name, course.Name)
 		wh.recordSubmissionWithoutTests(runData)
 		return
 	}
-	ci.RunTests(wh.logger, wh.db, wh.runner, runData)
+	if sc, err := wh.scms.GetOrCreateSCMEntry(wh.logger.Desugar(), course.GetProvider(), course.GetAccessToken()); err != nil {
+		// the tests are still run, but the results are not reported to the repository
+		wh.logger.Errorf("Failed to create SCM Client: %v", err)
+	} else {
+		runData.SCM = sc
+	}
+	ci.RunTests(wh.logger, wh.db, wh.runner, wh.notifier, runData)
 }
 
 // recordSubmissionWithoutTests saves a new submission without running any tests
 // for a manually graded assignment.
 func (wh GitHubWebHook) recordSubmissionWithoutTests(data *ci.RunData) {
-	newSubmission := &pb.Submission{
-		AssignmentID: data.Assignment.ID,
-		BuildInfo: &score.BuildInfo{
-			BuildDate: time.Now().Format(pb.TimeLayout),
-			BuildLog:  "No automated tests for this assignment",
-			ExecTime:  1,
-		},
-		CommitHash: data.CommitID,
-		UserID:     data.Repo.UserID,
-		GroupID:    data.Repo.GroupID,
-	}
-	if err := wh.db.CreateSubmission(newSubmission); err != nil {
+	if err := ci.RecordWithoutTests(wh.db, data); err != nil {
 		wh.logger.Errorf("Failed to save submission for user %s, assignment %d: %v", data.JobOwner, data.Assignment.ID, err)
 		return
 	}
@@ -1,16 +1,27 @@
 package hooks
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
 	logq "github.com/autograde/quickfeed/log"
 	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web/auth"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v35/github"
+	"go.uber.org/zap"
 )
 
 const (
@@ -69,7 +80,7 @@ func TestGitHubWebHook(t *testing.T) {
 	// TODO(meling) db is nil; will cause handling of push event to panic; will need a database with content for this to work fully.
 	var db database.Database
 	var runner ci.Runner
-	webhook := NewGitHubWebHook(logger, db, runner, secret)
+	webhook := NewGitHubWebHook(logger, db, runner, nil, auth.NewScms(), secret, "")
 
 	log.Println("starting webhook server")
 	http.HandleFunc("/webhook", webhook.Handle)
@@ -99,3 +110,289 @@ func TestExtractChanges(t *testing.T) {
 		t.Fatalf("content mismatch (-want +got):\n%s", diff)
 	}
 }
+
+// deliver signs the event payload and hands it to the webhook, as GitHub would.
+func deliver(t *testing.T, webhook *GitHubWebHook, eventType string, event interface{}) {
+	t.Helper()
+	body, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	req := httptest.NewRequest(http.MethodPost, "/hook/github/events", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", eventType)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	webhook.Handle(httptest.NewRecorder(), req)
+}
+
+func TestPullRequestSubmission(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+		SubmissionMode:   pb.Course_PULL_REQUEST,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed assignments are submitted without running tests
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://github.com/qf-org/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeSCM, scms := qtest.FakeProviderMap(t)
+	fakeSCM.(*scm.FakeSCM).PullRequestFiles[3] = []string{"lab1/main.go", "README.md"}
+	webhook := NewGitHubWebHook(zap.NewNop().Sugar(), db, &ci.Local{}, nil, scms, secret, "")
+
+	repo := &github.Repository{ID: github.Int64(42), Name: github.String("student-labs"), DefaultBranch: github.String("main")}
+	pullRequest := func(action, sha string) *github.PullRequestEvent {
+		return &github.PullRequestEvent{
+			Action: github.String(action),
+			Number: github.Int(3),
+			PullRequest: &github.PullRequest{
+				Head: &github.PullRequestBranch{SHA: github.String(sha), Repo: repo},
+				Base: &github.PullRequestBranch{Ref: github.String("main"), Repo: repo},
+			},
+			Repo:   repo,
+			Sender: &github.User{Login: github.String("student")},
+		}
+	}
+	// latest returns the latest submission and the number of submissions
+	latest := func() (*pb.Submission, int) {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(submissions) == 0 {
+			t.Fatal("no submissions")
+		}
+		return submissions[len(submissions)-1], len(submissions)
+	}
+
+	deliver(t, webhook, "pull_request", pullRequest("opened", "abc"))
+	if got, _ := latest(); got.GetCommitHash() != "abc" || got.GetPullRequest() != 3 {
+		t.Errorf("submission of opened pull request has commit %s and pull request %d, want commit %s and pull request %d",
+			got.GetCommitHash(), got.GetPullRequest(), "abc", 3)
+	}
+	deliver(t, webhook, "pull_request", pullRequest("synchronize", "def"))
+	got, submitted := latest()
+	if got.GetCommitHash() != "def" {
+		t.Errorf("submission of synchronized pull request has commit %s, want %s", got.GetCommitHash(), "def")
+	}
+
+	// closing the pull request, and pushing to the default branch, are not submissions
+	deliver(t, webhook, "pull_request", pullRequest("closed", "ghi"))
+	deliver(t, webhook, "push", &github.PushEvent{
+		Ref:        github.String("refs/heads/main"),
+		HeadCommit: &github.HeadCommit{ID: github.String("jkl")},
+		Commits:    []*github.HeadCommit{{Modified: []string{"lab1/main.go"}}},
+		Repo:       &github.PushEventRepository{ID: github.Int64(42), Name: github.String("student-labs"), DefaultBranch: github.String("main")},
+		Sender:     &github.User{Login: github.String("student")},
+	})
+	if got, n := latest(); n != submitted || got.GetCommitHash() != "def" {
+		t.Errorf("got %d submissions with latest commit %s after closed pull request and push, want %d with latest commit %s",
+			n, got.GetCommitHash(), submitted, "def")
+	}
+}
+
+func TestExtractSubmitKeywords(t *testing.T) {
+	commits := []*github.HeadCommit{
+		{ID: github.String("a1"), Message: github.String("Fix fibonacci [submit lab2]")},
+		{ID: github.String("b2"), Message: github.String("Work on lab3")},
+		{ID: github.String("c3"), Message: github.String("[Submit lab3] [submit lab2 ]\n\nAlso resubmit lab2")},
+		{ID: github.String("d4"), Message: github.String("submit lab4 [submit] [submit paxos]")},
+	}
+	want := map[string]map[string]bool{
+		"c3": {"lab2": true, "lab3": true},
+		"d4": {"paxos": true},
+	}
+	got := extractSubmitKeywords(commits)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("content mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTagSubmission(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:              "Test",
+		Code:              "DAT320",
+		Provider:          "fake",
+		OrganizationID:    1,
+		OrganizationPath:  "qf-org",
+		CourseCreatorID:   teacher.ID,
+		SubmissionTrigger: pb.Course_TAG,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed assignments are submitted without running tests
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://github.com/qf-org/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	webhook := NewGitHubWebHook(zap.NewNop().Sugar(), db, &ci.Local{}, nil, scms, secret, "")
+	push := func(ref, headCommit string) *github.PushEvent {
+		return &github.PushEvent{
+			Ref:        github.String(ref),
+			HeadCommit: &github.HeadCommit{ID: github.String(headCommit)},
+			Commits:    []*github.HeadCommit{{Modified: []string{"lab1/main.go"}}},
+			Repo:       &github.PushEventRepository{ID: github.Int64(42), Name: github.String("student-labs"), DefaultBranch: github.String("main")},
+			Sender:     &github.User{Login: github.String("student")},
+		}
+	}
+	submissions := func() []*pb.Submission {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return submissions
+	}
+
+	// pushes to the default branch, and tags that do not name an assignment, are not submissions
+	deliver(t, webhook, "push", push("refs/heads/main", "abc"))
+	deliver(t, webhook, "push", push("refs/tags/v1.0", "abc"))
+	deliver(t, webhook, "push", push("refs/tags/lab1", "abc"))
+	if got := submissions(); len(got) != 0 {
+		t.Fatalf("got %d submissions before pushing a submission tag, want 0", len(got))
+	}
+
+	deliver(t, webhook, "push", push("refs/tags/lab1-submit", "def"))
+	got := submissions()
+	if len(got) != 1 || got[0].GetCommitHash() != "def" {
+		t.Fatalf("got %d submissions after pushing a submission tag, want 1 with commit %s", len(got), "def")
+	}
+
+	// deleting the tag is not a submission
+	deleted := push("refs/tags/lab1-submit", "")
+	deleted.Deleted = github.Bool(true)
+	deliver(t, webhook, "push", deleted)
+	if got := submissions(); len(got) != 1 {
+		t.Errorf("got %d submissions after deleting a submission tag, want 1", len(got))
+	}
+}
+
+func TestGroupSetSubmission(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed assignments are submitted without running tests
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1, IsGroupLab: true}
+	project := &pb.Assignment{CourseID: course.ID, Name: "project", Order: 2, Reviewers: 1, IsGroupLab: true, GroupSet: "projects"}
+	for _, assignment := range []*pb.Assignment{lab, project} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	student.Login = "student"
+	if err := db.UpdateUser(student); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	labGroup := &pb.Group{Name: "lab-group", CourseID: course.ID, Users: []*pb.User{student}}
+	projectGroup := &pb.Group{Name: "project-group", CourseID: course.ID, GroupSet: "projects", Users: []*pb.User{student}}
+	for i, group := range []*pb.Group{labGroup, projectGroup} {
+		if err := db.CreateGroup(group); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: course.OrganizationID,
+			RepositoryID:   uint64(42 + i),
+			GroupID:        group.ID,
+			RepoType:       pb.Repository_GROUP,
+			HTMLURL:        "https://github.com/qf-org/" + group.Name,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	webhook := NewGitHubWebHook(zap.NewNop().Sugar(), db, &ci.Local{}, nil, scms, secret, "")
+	push := func(repoID int64, headCommit string) *github.PushEvent {
+		return &github.PushEvent{
+			Ref:        github.String("refs/heads/main"),
+			HeadCommit: &github.HeadCommit{ID: github.String(headCommit)},
+			Commits:    []*github.HeadCommit{{Modified: []string{"lab1/main.go", "project/main.go"}}},
+			Repo:       &github.PushEventRepository{ID: github.Int64(repoID), DefaultBranch: github.String("main")},
+			Sender:     &github.User{Login: github.String("student")},
+		}
+	}
+	submissions := func(assignment *pb.Assignment) []*pb.Submission {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return submissions
+	}
+
+	// each group repository only submits the assignments of its group's group set
+	deliver(t, webhook, "push", push(42, "abc"))
+	deliver(t, webhook, "push", push(43, "def"))
+	for _, test := range []struct {
+		assignment *pb.Assignment
+		group      *pb.Group
+		commit     string
+	}{
+		{lab, labGroup, "abc"},
+		{project, projectGroup, "def"},
+	} {
+		got := submissions(test.assignment)
+		if len(got) != 1 || got[0].GetGroupID() != test.group.ID || got[0].GetCommitHash() != test.commit {
+			t.Errorf("got submissions %v for assignment %s, want one submission by group %s with commit %s",
+				got, test.assignment.Name, test.group.Name, test.commit)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package web
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+)
+
+// SetNotificationSender sets the sender used to deliver notifications to users, e.g., by email.
+// It must be called before the web server is started.
+func (s *AutograderService) SetNotificationSender(sender notify.Sender) {
+	s.notifier = notify.NewNotifier(s.logger, s.db, sender)
+}
+
+// getNotificationSettings returns the notification categories that the given user has opted out of.
+func (s *AutograderService) getNotificationSettings(userID uint64) (*pb.NotificationSettings, error) {
+	optOuts, err := s.db.GetNotificationOptOuts(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.NotificationSettings{OptOuts: optOuts}, nil
+}
+
+// updateNotificationSettings replaces the notification categories that the given user has opted out of.
+func (s *AutograderService) updateNotificationSettings(userID uint64, settings *pb.NotificationSettings) (*pb.NotificationSettings, error) {
+	if err := s.db.UpdateNotificationOptOuts(userID, settings.GetOptOuts()); err != nil {
+		return nil, err
+	}
+	return s.getNotificationSettings(userID)
+}
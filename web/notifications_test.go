@@ -0,0 +1,152 @@
+package web_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+type sentNotification struct {
+	to      *pb.User
+	subject string
+	body    string
+}
+
+// recordingSender records the notifications instead of delivering them.
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []sentNotification
+}
+
+func (s *recordingSender) Send(to *pb.User, subject, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, sentNotification{to: to, subject: subject, body: body})
+	return nil
+}
+
+// waitForSent waits until n notifications have been sent in the background and returns the sent notifications.
+func (s *recordingSender) waitForSent(n int) []sentNotification {
+	for i := 0; i < 100; i++ {
+		s.mu.Lock()
+		sent := append([]sentNotification(nil), s.sent...)
+		s.mu.Unlock()
+		if len(sent) >= n {
+			return sent
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]sentNotification(nil), s.sent...)
+}
+
+func TestNotificationOptOut(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:            "Test",
+		Code:            "DAT320",
+		Provider:        "fake",
+		OrganizationID:  1,
+		CourseCreatorID: teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	sender := &recordingSender{}
+	ags.SetNotificationSender(sender)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	settings, err := ags.UpdateNotificationSettings(studentCtx, &pb.NotificationSettings{
+		OptOuts: []pb.NotificationOptOut_Category{pb.NotificationOptOut_SUBMISSION, pb.NotificationOptOut_BUILD},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settings.GetOptOuts()) != 2 {
+		t.Fatalf("UpdateNotificationSettings() returned %d opt-outs, want %d", len(settings.GetOptOuts()), 2)
+	}
+	settings, err = ags.GetNotificationSettings(studentCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settings.GetOptOuts()) != 2 {
+		t.Errorf("GetNotificationSettings() returned %d opt-outs, want %d", len(settings.GetOptOuts()), 2)
+	}
+
+	// approving and releasing the submission only notifies about the release
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.ID,
+		SubmissionID: submission.ID,
+		Status:       pb.Submission_APPROVED,
+		Released:     true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sent := sender.waitForSent(1)
+	if len(sent) != 1 {
+		t.Fatalf("UpdateSubmission() sent %d notifications, want %d", len(sent), 1)
+	}
+	if got, want := sent[0].subject, "DAT320: feedback for lab1 released"; got != want {
+		t.Errorf("notification subject = %q, want %q", got, want)
+	}
+	if sent[0].to.GetID() != student.ID {
+		t.Errorf("notification sent to user %d, want %d", sent[0].to.GetID(), student.ID)
+	}
+
+	// updating a released submission does not notify again
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.ID,
+		SubmissionID: submission.ID,
+		Status:       pb.Submission_APPROVED,
+		Released:     true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if sent = sender.waitForSent(1); len(sent) != 1 {
+		t.Errorf("UpdateSubmission() without changes sent %d notifications, want %d", len(sent), 1)
+	}
+
+	// opting in again
+	if _, err := ags.UpdateNotificationSettings(studentCtx, &pb.NotificationSettings{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.ID,
+		SubmissionID: submission.ID,
+		Status:       pb.Submission_REVISION,
+		Released:     true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if sent = sender.waitForSent(2); len(sent) != 2 {
+		t.Fatalf("UpdateSubmission() after opting in sent %d notifications, want %d", len(sent), 2)
+	}
+	if got, want := sent[1].subject, "DAT320: lab1 REVISION"; got != want {
+		t.Errorf("notification subject = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,158 @@
+package web
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// assignPeerReviewers assigns anonymous student reviewers to the submissions
+// for the given assignment, once the deadline has passed. Only submissions with
+// fewer peer reviewers than required are assigned new reviewers, such that
+// late submissions are assigned reviewers on later calls.
+func (s *AutograderService) assignPeerReviewers(assignment *pb.Assignment) error {
+	sinceDeadline, err := assignment.SinceDeadline(time.Now())
+	if err != nil {
+		return err
+	}
+	if sinceDeadline <= 0 {
+		return fmt.Errorf("peer reviews for assignment %s are not available before the deadline", assignment.GetName())
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return err
+	}
+	authors, err := s.submissionAuthors(submissions)
+	if err != nil {
+		return err
+	}
+	return s.db.AssignPeerReviewers(assignment.GetID(), func(assigned []*pb.PeerReviewer) []*pb.PeerReviewer {
+		peerReviewers := pb.AssignPeerReviewers(assignment.GetID(), assignment.GetPeerReviewers(), authors, assigned)
+		if len(peerReviewers) > 0 {
+			s.logger.Debugf("Assigning %d peer reviews for assignment %s", len(peerReviewers), assignment.GetName())
+		}
+		return peerReviewers
+	})
+}
+
+// submissionAuthors returns the user IDs of the authors of each of the given submissions, keyed by submission ID.
+// The authors of a group submission are the members of the group.
+func (s *AutograderService) submissionAuthors(submissions []*pb.Submission) (map[uint64][]uint64, error) {
+	authors := make(map[uint64][]uint64)
+	for _, submission := range submissions {
+		if submission.GetGroupID() > 0 {
+			group, err := s.db.GetGroup(submission.GetGroupID())
+			if err != nil {
+				return nil, err
+			}
+			for _, enrollment := range group.GetEnrollments() {
+				authors[submission.GetID()] = append(authors[submission.GetID()], enrollment.GetUserID())
+			}
+		} else {
+			authors[submission.GetID()] = []uint64{submission.GetUserID()}
+		}
+	}
+	return authors, nil
+}
+
+// getPeerReviewSubmissions returns the submissions that the given user is assigned to peer review
+// for the given assignment. The submissions do not reveal their authors, and only include
+// the user's own review.
+func (s *AutograderService) getPeerReviewSubmissions(usr *pb.User, request *pb.AssignmentRequest) (*pb.Submissions, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetPeerReviewers() == 0 {
+		return nil, fmt.Errorf("peer review is not enabled for assignment %s", assignment.GetName())
+	}
+	if err := s.assignPeerReviewers(assignment); err != nil {
+		return nil, err
+	}
+	peerReviewers, err := s.db.GetPeerReviewers(&pb.PeerReviewer{AssignmentID: assignment.GetID(), ReviewerID: usr.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	submissions := make([]*pb.Submission, 0, len(peerReviewers))
+	for _, peerReviewer := range peerReviewers {
+		submission, err := s.db.GetSubmission(&pb.Submission{ID: peerReviewer.GetSubmissionID()})
+		if err != nil {
+			return nil, err
+		}
+		anonymous := &pb.Submission{
+			ID:           submission.GetID(),
+			AssignmentID: submission.GetAssignmentID(),
+			CommitHash:   submission.GetCommitHash(),
+		}
+		for _, review := range submission.PeerReviews() {
+			if review.GetReviewerID() == usr.GetID() {
+				anonymous.Reviews = append(anonymous.Reviews, review)
+			}
+		}
+		submissions = append(submissions, anonymous)
+	}
+	return &pb.Submissions{Submissions: submissions}, nil
+}
+
+// checkPeerReview returns an error if the given user cannot create a new peer review for the given submission.
+func checkPeerReview(assignment *pb.Assignment, submission *pb.Submission, reviewerID uint64) error {
+	sinceDeadline, err := assignment.SinceDeadline(time.Now())
+	if err != nil {
+		return err
+	}
+	if sinceDeadline <= 0 {
+		return fmt.Errorf("cannot peer review submission %d before the deadline of assignment %s", submission.GetID(), assignment.GetName())
+	}
+	for _, review := range submission.PeerReviews() {
+		if review.GetReviewerID() == reviewerID {
+			return fmt.Errorf("user %d has already peer reviewed submission %d", reviewerID, submission.GetID())
+		}
+	}
+	return nil
+}
+
+// updatePeerScore recomputes the peer score and outlier flags for the given submission.
+func (s *AutograderService) updatePeerScore(submissionID uint64) (*pb.Submission, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	submission.UpdatePeerScore()
+	for _, review := range submission.PeerReviews() {
+		if err := s.db.UpdatePeerReviewFlags(review); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.db.UpdateSubmission(submission); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// moderatePeerReview excludes or includes the given peer review in the submission's peer score.
+func (s *AutograderService) moderatePeerReview(request *pb.PeerReviewModerationRequest) (*pb.Submission, error) {
+	review, err := s.db.GetReview(&pb.Review{ID: request.GetReviewID()})
+	if err != nil {
+		return nil, err
+	}
+	if !review.GetPeerReview() {
+		return nil, fmt.Errorf("review %d is not a peer review", review.GetID())
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: review.GetSubmissionID()})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("review %d does not belong to course %d", review.GetID(), request.GetCourseID())
+	}
+	review.Excluded = request.GetExcluded()
+	if err := s.db.UpdatePeerReviewFlags(review); err != nil {
+		return nil, err
+	}
+	return s.updatePeerScore(submission.GetID())
+}
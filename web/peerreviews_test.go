@@ -0,0 +1,305 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPeerReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:           "Test",
+		Code:           "DAT320",
+		Provider:       "fake",
+		OrganizationID: 1,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	students := make([]*pb.User, 3)
+	for i := range students {
+		students[i] = qtest.CreateFakeUser(t, db, uint64(i+2))
+		qtest.EnrollStudent(t, db, students[i], course)
+	}
+	assignment := &pb.Assignment{
+		CourseID:      course.ID,
+		Name:          "lab1",
+		Order:         1,
+		Deadline:      "2021-01-01T12:00:00",
+		PeerReviewers: 1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	upcoming := &pb.Assignment{
+		CourseID:      course.ID,
+		Name:          "lab2",
+		Order:         2,
+		Deadline:      "2099-01-01T12:00:00",
+		PeerReviewers: 1,
+	}
+	if err := db.CreateAssignment(upcoming); err != nil {
+		t.Fatal(err)
+	}
+	for _, student := range students {
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, CommitHash: "abc"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: upcoming.ID, UserID: student.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), students[0])
+
+	if _, err := ags.GetPeerReviewSubmissions(studentCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: upcoming.ID}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetPeerReviewSubmissions() before deadline: got error %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	submissions, err := ags.GetPeerReviewSubmissions(studentCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions.GetSubmissions()) != 1 {
+		t.Fatalf("GetPeerReviewSubmissions() returned %d submissions, want %d", len(submissions.GetSubmissions()), 1)
+	}
+	peerSubmission := submissions.GetSubmissions()[0]
+	if peerSubmission.GetUserID() != 0 || peerSubmission.GetGroupID() != 0 {
+		t.Errorf("GetPeerReviewSubmissions() revealed the author of submission %d", peerSubmission.GetID())
+	}
+	stored, err := db.GetSubmission(&pb.Submission{ID: peerSubmission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.GetUserID() == students[0].ID {
+		t.Errorf("student %d assigned to review own submission %d", students[0].ID, stored.GetID())
+	}
+
+	// the student can only review the assigned submission
+	var otherSubmission *pb.Submission
+	allSubmissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range allSubmissions {
+		if s.GetID() != peerSubmission.GetID() {
+			otherSubmission = s
+			break
+		}
+	}
+	if _, err := ags.CreateReview(studentCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: otherSubmission.ID, ReviewerID: students[0].ID, Score: 80, Ready: true},
+	}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateReview() for unassigned submission: got error %v, want %v", err, codes.PermissionDenied)
+	}
+
+	review, err := ags.CreateReview(studentCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: peerSubmission.ID, ReviewerID: students[0].ID, Score: 80, Ready: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !review.GetPeerReview() {
+		t.Errorf("CreateReview() by student: PeerReview = false, want true")
+	}
+	if _, err := ags.CreateReview(studentCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: peerSubmission.ID, ReviewerID: students[0].ID, Score: 10, Ready: true},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateReview() twice by the same peer reviewer: got error %v, want %v", err, codes.InvalidArgument)
+	}
+	reviewed, err := db.GetSubmission(&pb.Submission{ID: peerSubmission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reviewed.GetPeerScore() != 80 {
+		t.Errorf("PeerScore = %d, want %d", reviewed.GetPeerScore(), 80)
+	}
+
+	// peer reviewers are hidden from the author
+	authorCtx := withUserContext(context.Background(), &pb.User{ID: reviewed.GetUserID()})
+	authorSubmissions, err := ags.GetSubmissions(authorCtx, &pb.SubmissionRequest{CourseID: course.ID, UserID: reviewed.GetUserID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range authorSubmissions.GetSubmissions() {
+		for _, r := range s.GetReviews() {
+			if r.GetReviewerID() != 0 {
+				t.Errorf("GetSubmissions() revealed peer reviewer %d to the author", r.GetReviewerID())
+			}
+		}
+	}
+
+	moderation := &pb.PeerReviewModerationRequest{CourseID: course.ID, ReviewID: review.ID, Excluded: true}
+	if _, err := callWithAccessControl(studentCtx, ags, "ModeratePeerReview", moderation); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ModeratePeerReview() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	moderated, err := ags.ModeratePeerReview(teacherCtx, moderation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moderated.GetPeerScore() != 0 {
+		t.Errorf("PeerScore after excluding the only peer review = %d, want %d", moderated.GetPeerScore(), 0)
+	}
+
+	// a late submission is assigned a peer reviewer on a later call, without reassigning the others
+	before, err := db.GetPeerReviewers(&pb.PeerReviewer{AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	late := qtest.CreateFakeUser(t, db, 5)
+	qtest.EnrollStudent(t, db, late, course)
+	lateSubmission := &pb.Submission{AssignmentID: assignment.ID, UserID: late.ID, CommitHash: "def"}
+	if err := db.CreateSubmission(lateSubmission); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := ags.GetPeerReviewSubmissions(studentCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	after, err := db.GetPeerReviewers(&pb.PeerReviewer{AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after) != len(before)+1 {
+		t.Fatalf("GetPeerReviewSubmissions() after late submission: have %d peer reviewers, want %d", len(after), len(before)+1)
+	}
+	for i, pr := range before {
+		if after[i].GetSubmissionID() != pr.GetSubmissionID() || after[i].GetReviewerID() != pr.GetReviewerID() {
+			t.Errorf("peer reviewer %d for submission %d was reassigned to %d", pr.GetReviewerID(), pr.GetSubmissionID(), after[i].GetReviewerID())
+		}
+	}
+	if pr := after[len(after)-1]; pr.GetSubmissionID() != lateSubmission.ID || pr.GetReviewerID() == late.ID {
+		t.Errorf("late submission %d: have peer reviewer %d for submission %d", lateSubmission.ID, pr.GetReviewerID(), pr.GetSubmissionID())
+	}
+}
+
+func TestPeerReviewerUpdateReview(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320", Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	students := make([]*pb.User, 2)
+	for i := range students {
+		students[i] = qtest.CreateFakeUser(t, db, uint64(i+2))
+		qtest.EnrollStudent(t, db, students[i], course)
+	}
+	assignment := &pb.Assignment{
+		CourseID:      course.ID,
+		Name:          "lab1",
+		Order:         1,
+		Deadline:      "2021-01-01T12:00:00",
+		Reviewers:     1,
+		PeerReviewers: 1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	for _, student := range students {
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, CommitHash: "abc"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), students[0])
+
+	submissions, err := ags.GetPeerReviewSubmissions(studentCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions.GetSubmissions()) != 1 {
+		t.Fatalf("GetPeerReviewSubmissions() returned %d submissions, want %d", len(submissions.GetSubmissions()), 1)
+	}
+	submissionID := submissions.GetSubmissions()[0].GetID()
+
+	rubric := func() []*pb.GradingBenchmark {
+		return []*pb.GradingBenchmark{{
+			AssignmentID: assignment.ID,
+			Heading:      "Code quality",
+			Criteria:     []*pb.GradingCriterion{{Description: "Readable", Points: 10, Grade: pb.GradingCriterion_PASSED}},
+		}}
+	}
+	teacherReview, err := ags.CreateReview(teacherCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: submissionID, ReviewerID: teacher.ID, Feedback: "teacher", GradingBenchmarks: rubric()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peerReview, err := ags.CreateReview(studentCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: submissionID, ReviewerID: students[0].ID, Feedback: "peer", GradingBenchmarks: rubric()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the peer reviewer claims to be the author of the teacher's review
+	if _, err := ags.UpdateReview(studentCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{ID: teacherReview.ID, SubmissionID: submissionID, ReviewerID: students[0].ID, Feedback: "overwritten", Score: 100},
+	}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateReview() of teacher review by peer reviewer: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	stored, err := db.GetReview(&pb.Review{ID: teacherReview.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.GetFeedback() != "teacher" || stored.GetReviewerID() != teacher.ID {
+		t.Errorf("teacher review changed by peer reviewer: feedback %q, reviewer %d", stored.GetFeedback(), stored.GetReviewerID())
+	}
+
+	// the peer reviewer grades the criteria of the teacher's review through the peer review
+	foreign := stored.GetGradingBenchmarks()[0]
+	foreign.Criteria[0].Grade = pb.GradingCriterion_FAILED
+	foreign.Comment = "overwritten"
+	if _, err := ags.UpdateReview(studentCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{ID: peerReview.ID, SubmissionID: submissionID, Feedback: "peer", GradingBenchmarks: []*pb.GradingBenchmark{foreign}},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateReview() with another review's benchmarks: got error %v, want %v", err, codes.InvalidArgument)
+	}
+	stored, err = db.GetReview(&pb.Review{ID: teacherReview.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bm := stored.GetGradingBenchmarks()[0]; bm.GetComment() != "" || bm.GetCriteria()[0].GetGrade() != pb.GradingCriterion_PASSED {
+		t.Errorf("teacher review grading changed by peer reviewer: %+v", bm)
+	}
+
+	// the peer reviewer can update the peer review, but not change its reviewer
+	peerReview.Feedback = "updated"
+	peerReview.ReviewerID = teacher.ID
+	peerReview.GradingBenchmarks[0].Criteria[0].Grade = pb.GradingCriterion_FAILED
+	updated, err := ags.UpdateReview(studentCtx, &pb.ReviewRequest{CourseID: course.ID, Review: peerReview})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetReviewerID() != students[0].ID || updated.GetFeedback() != "updated" {
+		t.Errorf("UpdateReview() of peer review = %+v, want reviewer %d and updated feedback", updated, students[0].ID)
+	}
+}
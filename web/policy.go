@@ -0,0 +1,465 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// role is a set of roles that a user may hold with respect to the resource of a request.
+type role uint
+
+const (
+	// roleAny is held by every authenticated user.
+	roleAny role = 1 << iota
+	// roleAdmin is held by administrators.
+	roleAdmin
+	// roleStudent is held by students enrolled in the resource's course.
+	roleStudent
+	// roleTA is held by teaching assistants of the resource's course.
+	roleTA
+	// roleTeacher is held by teachers of the resource's course.
+	roleTeacher
+	// roleCreator is held by the creator of the resource's course.
+	roleCreator
+	// roleOwner is held by the user that the request concerns.
+	roleOwner
+	// roleGroupMember is held by members of the group that the request concerns.
+	roleGroupMember
+)
+
+// roleEnrolled is held by every user enrolled in the resource's course.
+const roleEnrolled = roleStudent | roleTA | roleTeacher
+
+// resource describes what a request acts on.
+type resource struct {
+	courseID uint64
+	userID   uint64
+	group    *pb.Group
+}
+
+// resourceFunc extracts the resource from a request.
+type resourceFunc func(s *AutograderService, in interface{}) (*resource, error)
+
+// policy states which roles may call a method and how to find the resource
+// that the roles are checked against. A user holding any of the roles is granted access.
+//
+// The policy is a coarse-grained check enforced before the method is called;
+// the methods themselves may enforce finer rules, e.g., that only the reviewer
+// of a review may update it.
+type policy struct {
+	roles    role
+	resource resourceFunc
+}
+
+// policies maps each AutograderService method to its access policy.
+// Methods without a policy are denied.
+var policies = map[string]policy{
+	"GetUser":                    {roleAny, noResource},
+	"GetUsers":                   {roleAdmin, noResource},
+	"GetUserByCourse":            {roleAdmin | roleTeacher, courseUserResource},
+	"UpdateUser":                 {roleAdmin | roleOwner, userResource},
+	"IsAuthorizedTeacher":        {roleAny, noResource},
+	"GetNotificationSettings":    {roleAny, noResource},
+	"UpdateNotificationSettings": {roleAny, noResource},
+	"UpdateChatIdentity":         {roleAny, noResource},
+	"CreateAPIToken":             {roleAny, noResource},
+	"GetAPITokens":               {roleAny, noResource},
+	"RevokeAPIToken":             {roleAny, noResource},
+	"GetSessions":                {roleAdmin, noResource},
+	"RevokeSessions":             {roleAdmin, noResource},
+	"GetGroup":                   {roleGroupMember | roleTeacher, groupResource},
+	"GetGroupByUserAndCourse":    {roleEnrolled, groupResource},
+	"GetGroupsByCourse":          {roleTeacher, courseResource},
+	"CreateGroup":                {roleEnrolled, courseResource},
+	"UpdateGroup":                {roleTeacher, groupResource},
+	"DeleteGroup":                {roleTeacher, groupResource},
+	"FormGroups":                 {roleTeacher, courseResource},
+	"GetGroupMemberships":        {roleTeacher, courseResource},
+	"GetGroupContributions":      {roleTeacher, courseResource},
+	"GetCourse":                  {roleAny, noResource},
+	"GetCourses":                 {roleAny, noResource},
+	"GetCoursesByUser":           {roleAny, noResource},
+	"CreateCourse":               {roleAdmin, noResource},
+	"UpdateCourse":               {roleTeacher, courseIDResource},
+	"UpdateCourseVisibility":     {roleOwner, courseResource},
+	"UpdateChatIntegration":      {roleCreator, courseResource},
+	"GetChatIntegrations":        {roleTeacher, courseResource},
+	"DeleteChatIntegration":      {roleCreator, courseResource},
+	"UpdateWebhook":              {roleCreator, courseResource},
+	"GetWebhooks":                {roleTeacher, courseResource},
+	"DeleteWebhook":              {roleCreator, courseResource},
+	"GetWebhookDeliveries":       {roleTeacher, courseResource},
+	"GetAssignments":             {roleAny, noResource},
+	"UpdateAssignments":          {roleTeacher, courseResource},
+	"GetEnrollmentsByUser":       {roleAdmin | roleOwner, courseResource},
+	"GetEnrollmentsByCourse":     {roleEnrolled, courseResource},
+	"CreateEnrollment":           {roleAny, noResource},
+	"UpdateEnrollment":           {roleTeacher, courseResource},
+	"UpdateEnrollments":          {roleTeacher, courseResource},
+	"UpdateSlipDays":             {roleTeacher, courseResource},
+	"GetSlipDayLedger":           {roleTeacher | roleOwner, courseResource},
+	"GetSubmissions":             {roleEnrolled, courseResource},
+	"GetSubmissionsByCourse":     {roleEnrolled, courseResource},
+	"UpdateSubmission":           {roleTeacher, submissionResource},
+	"UpdateSubmissions":          {roleCreator, courseResource},
+	"ScheduleRelease":            {roleCreator, courseResource},
+	"GetReleaseSchedules":        {roleTeacher, courseResource},
+	"CancelRelease":              {roleCreator, courseResource},
+	"RebuildSubmission":          {roleTeacher | roleTA, assignmentResource},
+	"RebuildSubmissions":         {roleTeacher | roleTA, courseResource},
+	"SubmitCommit":               {roleEnrolled, courseResource},
+	"CreateBenchmark":            {roleTeacher, benchmarkResource},
+	"UpdateBenchmark":            {roleTeacher, benchmarkResource},
+	"DeleteBenchmark":            {roleTeacher, benchmarkResource},
+	"CreateCriterion":            {roleTeacher, criterionResource},
+	"UpdateCriterion":            {roleTeacher, criterionResource},
+	"DeleteCriterion":            {roleTeacher, criterionResource},
+	"CreateReview":               {roleEnrolled, submissionResource},
+	"UpdateReview":               {roleEnrolled, courseResource},
+	"GetReviewers":               {roleTeacher | roleTA, submissionResource},
+	"GetReconciliation":          {roleTeacher, submissionResource},
+	"OverrideGrade":              {roleCreator, submissionResource},
+	"GetPeerReviewSubmissions":   {roleEnrolled, courseResource},
+	"ModeratePeerReview":         {roleTeacher, courseResource},
+	"DistributeReviews":          {roleCreator, courseResource},
+	"GetReviewProgress":          {roleTeacher, courseResource},
+	"GetReviewQueue":             {roleTeacher | roleTA, courseResource},
+	"CreateCodeComment":          {roleEnrolled, courseResource},
+	"GetCodeComments":            {roleEnrolled, courseResource},
+	"LoadCriteria":               {roleTeacher, courseResource},
+	"CreateRubricTemplate":       {roleTeacher, courseResource},
+	"GetRubricTemplates":         {roleTeacher, courseResource},
+	"ApplyRubricTemplate":        {roleTeacher, courseResource},
+	"GetAuditLog":                {roleAdmin | roleTeacher, courseResource},
+	"GetProviders":               {roleAny, noResource},
+	"GetOrganization":            {roleAdmin, noResource},
+	"GetRepositories":            {roleAny, noResource},
+	"IsEmptyRepo":                {roleTeacher, courseResource},
+}
+
+// AccessControl returns a unary server interceptor that enforces the access policy
+// of each AutograderService method. It must be chained after auth.UserVerifier.
+func (s *AutograderService) AccessControl() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/"+pb.AutograderService_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		usr, err := s.getCurrentUser(ctx)
+		if err != nil {
+			s.logger.Errorf("%s failed: authentication error: %v", method, err)
+			return nil, ErrInvalidUserInfo
+		}
+		if err := s.authorize(usr, method, req); err != nil {
+			s.logger.Errorf("%s failed: user %s: %v", method, usr.GetLogin(), err)
+			return nil, status.Errorf(codes.PermissionDenied, "access denied for %s", method)
+		}
+		token, err := s.getCurrentToken(ctx)
+		if err != nil {
+			s.logger.Errorf("%s failed: API token error: %v", method, err)
+			return nil, ErrInvalidUserInfo
+		}
+		if token != nil {
+			if err := s.authorizeToken(token, method, req); err != nil {
+				s.logger.Errorf("%s failed: user %s: API token %d: %v", method, usr.GetLogin(), token.GetID(), err)
+				return nil, status.Errorf(codes.PermissionDenied, "access denied for %s: outside the API token's scope", method)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authorize returns an error if the given user is not allowed to call the given method with the given request.
+func (s *AutograderService) authorize(usr *pb.User, method string, in interface{}) error {
+	p, ok := policies[method]
+	if !ok {
+		return errors.New("no access policy for method")
+	}
+	if p.roles&roleAny != 0 {
+		return nil
+	}
+	if p.roles&roleAdmin != 0 && usr.GetIsAdmin() {
+		return nil
+	}
+	res, err := p.resource(s, in)
+	if err != nil {
+		return err
+	}
+	if s.hasRole(usr, p.roles, res) {
+		return nil
+	}
+	return errors.New("user has none of the required roles")
+}
+
+// authorizeToken returns an error if the given API token's scope does not allow
+// calling the given method with the given request. Read-only tokens can only call
+// methods that get information, and tokens limited to a course can only call methods
+// on that course and methods that get information not tied to a course.
+// API tokens cannot be used to create new API tokens.
+func (s *AutograderService) authorizeToken(token *pb.APIToken, method string, in interface{}) error {
+	if method == "CreateAPIToken" {
+		return errors.New("API tokens cannot create API tokens")
+	}
+	if token.GetReadOnly() && !isReadOnly(method) {
+		return errors.New("read-only API token")
+	}
+	if token.GetCourseID() == 0 {
+		return nil
+	}
+	res, err := policies[method].resource(s, in)
+	if err != nil {
+		return err
+	}
+	if res.courseID == token.GetCourseID() || res.courseID == 0 && isReadOnly(method) {
+		return nil
+	}
+	return errors.New("API token is limited to another course")
+}
+
+// readOnly holds the methods that only get information, without side effects.
+// Read-only API tokens may only call these methods. Note that GetPeerReviewSubmissions
+// is not read-only, since it assigns peer reviewers to the submissions.
+var readOnly = map[string]bool{
+	"GetUser":                 true,
+	"GetUsers":                true,
+	"GetUserByCourse":         true,
+	"IsAuthorizedTeacher":     true,
+	"GetNotificationSettings": true,
+	"GetAPITokens":            true,
+	"GetSessions":             true,
+	"GetGroup":                true,
+	"GetGroupByUserAndCourse": true,
+	"GetGroupsByCourse":       true,
+	"GetGroupMemberships":     true,
+	"GetGroupContributions":   true,
+	"GetCourse":               true,
+	"GetCourses":              true,
+	"GetCoursesByUser":        true,
+	"GetChatIntegrations":     true,
+	"GetWebhooks":             true,
+	"GetWebhookDeliveries":    true,
+	"GetAssignments":          true,
+	"GetEnrollmentsByUser":    true,
+	"GetEnrollmentsByCourse":  true,
+	"GetSlipDayLedger":        true,
+	"GetSubmissions":          true,
+	"GetSubmissionsByCourse":  true,
+	"GetReleaseSchedules":     true,
+	"GetReviewers":            true,
+	"GetReconciliation":       true,
+	"GetReviewProgress":       true,
+	"GetReviewQueue":          true,
+	"GetCodeComments":         true,
+	"GetRubricTemplates":      true,
+	"GetAuditLog":             true,
+	"GetProviders":            true,
+	"GetOrganization":         true,
+	"GetRepositories":         true,
+	"IsEmptyRepo":             true,
+}
+
+// isReadOnly returns true if the given method only gets information.
+func isReadOnly(method string) bool {
+	return readOnly[method]
+}
+
+// hasRole returns true if the given user holds any of the given roles for the given resource.
+func (s *AutograderService) hasRole(usr *pb.User, roles role, res *resource) bool {
+	if roles&roleOwner != 0 && res.userID != 0 && usr.IsOwner(res.userID) {
+		return true
+	}
+	if roles&roleGroupMember != 0 && res.group.Contains(usr) {
+		return true
+	}
+	if res.courseID == 0 {
+		return false
+	}
+	if roles&roleCreator != 0 && s.isCourseCreator(res.courseID, usr.GetID()) {
+		return true
+	}
+	if roles&roleEnrolled == 0 {
+		return false
+	}
+	return s.hasCourseAccess(usr.GetID(), res.courseID, func(e *pb.Enrollment) bool {
+		switch e.GetStatus() {
+		case pb.Enrollment_STUDENT:
+			return roles&roleStudent != 0
+		case pb.Enrollment_TA:
+			return roles&roleTA != 0
+		case pb.Enrollment_TEACHER:
+			return roles&roleTeacher != 0
+		}
+		return false
+	})
+}
+
+// noResource is used for methods that are not tied to a course.
+func noResource(_ *AutograderService, _ interface{}) (*resource, error) {
+	return &resource{}, nil
+}
+
+// courseResource returns the course and user IDs given in the request.
+func courseResource(_ *AutograderService, in interface{}) (*resource, error) {
+	res := &resource{}
+	if r, ok := in.(interface{ GetCourseID() uint64 }); ok {
+		res.courseID = r.GetCourseID()
+	}
+	if r, ok := in.(interface{ GetUserID() uint64 }); ok {
+		res.userID = r.GetUserID()
+	}
+	return res, nil
+}
+
+// courseIDResource returns the course given by the request.
+func courseIDResource(_ *AutograderService, in interface{}) (*resource, error) {
+	course, ok := in.(*pb.Course)
+	if !ok {
+		return nil, errors.New("request is not a course")
+	}
+	return &resource{courseID: course.GetID()}, nil
+}
+
+// userResource returns the user given by the request.
+func userResource(_ *AutograderService, in interface{}) (*resource, error) {
+	user, ok := in.(*pb.User)
+	if !ok {
+		return nil, errors.New("request is not a user")
+	}
+	return &resource{userID: user.GetID()}, nil
+}
+
+// courseUserResource returns the course given by the request's course code and year.
+func courseUserResource(s *AutograderService, in interface{}) (*resource, error) {
+	request, ok := in.(*pb.CourseUserRequest)
+	if !ok {
+		return nil, errors.New("request is not a course user request")
+	}
+	_, course, err := s.db.GetUserByCourse(&pb.Course{Code: request.GetCourseCode(), Year: request.GetCourseYear()}, request.GetUserLogin())
+	if err != nil {
+		return nil, err
+	}
+	return &resource{courseID: course.GetID()}, nil
+}
+
+// groupResource returns the group given by the request and its course.
+// The course given in the request, if any, must be the group's course.
+// If the request has no group ID, the course given in the request is returned.
+func groupResource(s *AutograderService, in interface{}) (*resource, error) {
+	var groupID uint64
+	switch r := in.(type) {
+	case *pb.Group:
+		groupID = r.GetID()
+	case interface{ GetGroupID() uint64 }:
+		groupID = r.GetGroupID()
+	}
+	if groupID == 0 {
+		return courseResource(s, in)
+	}
+	group, err := s.db.GetGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRequestCourse(in, group.GetCourseID()); err != nil {
+		return nil, err
+	}
+	return &resource{courseID: group.GetCourseID(), group: group}, nil
+}
+
+// assignmentResource returns the course of the assignment given by the request.
+func assignmentResource(s *AutograderService, in interface{}) (*resource, error) {
+	r, ok := in.(interface{ GetAssignmentID() uint64 })
+	if !ok {
+		return nil, errors.New("request has no assignment")
+	}
+	return s.assignmentCourse(r.GetAssignmentID())
+}
+
+// submissionResource returns the course of the submission given by the request.
+// The course given in the request, if any, must be the submission's course.
+func submissionResource(s *AutograderService, in interface{}) (*resource, error) {
+	var submissionID uint64
+	switch r := in.(type) {
+	case *pb.ReviewRequest:
+		submissionID = r.GetReview().GetSubmissionID()
+	case *pb.GradeOverrideRequest:
+		submissionID = r.GetOverride().GetSubmissionID()
+	case interface{ GetSubmissionID() uint64 }:
+		submissionID = r.GetSubmissionID()
+	}
+	if submissionID == 0 {
+		return nil, errors.New("request has no submission")
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.assignmentCourse(submission.GetAssignmentID())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRequestCourse(in, res.courseID); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// checkRequestCourse returns an error if the request gives a course other than the given course.
+func checkRequestCourse(in interface{}, courseID uint64) error {
+	if r, ok := in.(interface{ GetCourseID() uint64 }); ok && r.GetCourseID() != courseID {
+		return fmt.Errorf("request for course %d concerns course %d", r.GetCourseID(), courseID)
+	}
+	return nil
+}
+
+// benchmarkResource returns the course of the benchmark given by the request.
+// For existing benchmarks, the stored benchmark's assignment is used.
+func benchmarkResource(s *AutograderService, in interface{}) (*resource, error) {
+	benchmark, ok := in.(*pb.GradingBenchmark)
+	if !ok {
+		return nil, errors.New("request is not a benchmark")
+	}
+	if benchmark.GetID() > 0 {
+		stored, err := s.db.GetBenchmark(benchmark.GetID())
+		if err != nil {
+			return nil, err
+		}
+		benchmark = stored
+	}
+	return s.assignmentCourse(benchmark.GetAssignmentID())
+}
+
+// criterionResource returns the course of the criterion given by the request.
+// For existing criteria, the stored criterion's benchmark is used.
+func criterionResource(s *AutograderService, in interface{}) (*resource, error) {
+	criterion, ok := in.(*pb.GradingCriterion)
+	if !ok {
+		return nil, errors.New("request is not a criterion")
+	}
+	if criterion.GetID() > 0 {
+		stored, err := s.db.GetCriterion(criterion.GetID())
+		if err != nil {
+			return nil, err
+		}
+		criterion = stored
+	}
+	return benchmarkResource(s, &pb.GradingBenchmark{ID: criterion.GetBenchmarkID()})
+}
+
+// assignmentCourse returns the course of the given assignment.
+func (s *AutograderService) assignmentCourse(assignmentID uint64) (*resource, error) {
+	if assignmentID == 0 {
+		return nil, errors.New("request has no assignment")
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	return &resource{courseID: assignment.GetCourseID()}, nil
+}
@@ -0,0 +1,168 @@
+package web
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPolicyForEveryMethod(t *testing.T) {
+	methods := make(map[string]bool)
+	for _, method := range pb.AutograderService_ServiceDesc.Methods {
+		methods[method.MethodName] = true
+		p, ok := policies[method.MethodName]
+		if !ok {
+			t.Errorf("no access policy for %s", method.MethodName)
+			continue
+		}
+		if p.roles == 0 || p.resource == nil {
+			t.Errorf("incomplete access policy for %s: %+v", method.MethodName, p)
+		}
+	}
+	for name := range policies {
+		if !methods[name] {
+			t.Errorf("access policy for unknown method %s", name)
+		}
+	}
+	for name := range readOnly {
+		if !methods[name] {
+			t.Errorf("unknown method %s listed as read-only", name)
+		}
+	}
+}
+
+func TestAccessControl(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	teacher := qtest.CreateFakeUser(t, db, 2)
+	student := qtest.CreateFakeUser(t, db, 3)
+	otherTeacher := qtest.CreateFakeUser(t, db, 4)
+	// only admins can create courses
+	for _, user := range []*pb.User{admin, teacher, otherTeacher} {
+		if err := db.UpdateUser(&pb.User{ID: user.ID, IsAdmin: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	course := &pb.Course{Code: "DAT100", Provider: "fake", OrganizationID: 1, CourseCreatorID: teacher.ID}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	otherCourse := &pb.Course{Code: "DAT200", Provider: "fake", OrganizationID: 2, CourseCreatorID: otherTeacher.ID}
+	if err := db.CreateCourse(otherTeacher.ID, otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	otherAssignment := &pb.Assignment{CourseID: otherCourse.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(otherAssignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	otherSubmission := &pb.Submission{AssignmentID: otherAssignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(otherSubmission); err != nil {
+		t.Fatal(err)
+	}
+	group := &pb.Group{CourseID: course.ID, Name: "group", Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	benchmark := &pb.GradingBenchmark{AssignmentID: assignment.ID, Heading: "Code quality"}
+	if err := db.CreateBenchmark(benchmark); err != nil {
+		t.Fatal(err)
+	}
+
+	ags := NewAutograderService(zap.NewNop(), db, nil, BaseHookOptions{}, &ci.Local{})
+	interceptor := ags.AccessControl()
+
+	tests := []struct {
+		name    string
+		user    *pb.User
+		method  string
+		request interface{}
+		allowed bool
+	}{
+		{"admin gets users", admin, "GetUsers", &pb.Void{}, true},
+		{"student gets users", student, "GetUsers", &pb.Void{}, false},
+		{"student gets own user", student, "GetUser", &pb.Void{}, true},
+		{"student updates own user", student, "UpdateUser", &pb.User{ID: student.ID}, true},
+		{"student updates other user", student, "UpdateUser", &pb.User{ID: teacher.ID}, false},
+		{"teacher updates enrollments", teacher, "UpdateEnrollments", &pb.CourseRequest{CourseID: course.ID}, true},
+		{"student updates enrollments", student, "UpdateEnrollments", &pb.CourseRequest{CourseID: course.ID}, false},
+		{"other teacher updates enrollments", otherTeacher, "UpdateEnrollments", &pb.CourseRequest{CourseID: course.ID}, false},
+		{"student gets enrollments", student, "GetEnrollmentsByCourse", &pb.EnrollmentRequest{CourseID: course.ID}, true},
+		{"other teacher gets enrollments", otherTeacher, "GetEnrollmentsByCourse", &pb.EnrollmentRequest{CourseID: course.ID}, false},
+		{"creator schedules release", teacher, "ScheduleRelease", &pb.ReleaseSchedule{CourseID: course.ID}, true},
+		{"teacher creates benchmark", teacher, "CreateBenchmark", &pb.GradingBenchmark{AssignmentID: assignment.ID}, true},
+		{"student creates benchmark", student, "CreateBenchmark", &pb.GradingBenchmark{AssignmentID: assignment.ID}, false},
+		{"teacher deletes benchmark", teacher, "DeleteBenchmark", &pb.GradingBenchmark{ID: benchmark.ID}, true},
+		// the stored benchmark's assignment decides the course, not the assignment in the request
+		{"other teacher deletes benchmark", otherTeacher, "DeleteBenchmark", &pb.GradingBenchmark{ID: benchmark.ID, AssignmentID: otherAssignment.ID}, false},
+		{"teacher creates criterion", teacher, "CreateCriterion", &pb.GradingCriterion{BenchmarkID: benchmark.ID}, true},
+		{"other teacher creates criterion", otherTeacher, "CreateCriterion", &pb.GradingCriterion{BenchmarkID: benchmark.ID}, false},
+		{"teacher rebuilds submission", teacher, "RebuildSubmission", &pb.RebuildRequest{AssignmentID: assignment.ID}, true},
+		{"student rebuilds submission", student, "RebuildSubmission", &pb.RebuildRequest{AssignmentID: assignment.ID}, false},
+		{"teacher updates submission", teacher, "UpdateSubmission", &pb.UpdateSubmissionRequest{CourseID: course.ID, SubmissionID: submission.ID}, true},
+		// the stored submission's assignment decides the course, which must match the course in the request
+		{"teacher updates other course's submission", teacher, "UpdateSubmission", &pb.UpdateSubmissionRequest{CourseID: course.ID, SubmissionID: otherSubmission.ID}, false},
+		{"teacher creates review", teacher, "CreateReview", &pb.ReviewRequest{CourseID: course.ID, Review: &pb.Review{SubmissionID: submission.ID}}, true},
+		{"teacher creates review of other course's submission", teacher, "CreateReview", &pb.ReviewRequest{CourseID: course.ID, Review: &pb.Review{SubmissionID: otherSubmission.ID}}, false},
+		{"teacher gets reviewers", teacher, "GetReviewers", &pb.SubmissionReviewersRequest{CourseID: course.ID, SubmissionID: submission.ID}, true},
+		{"teacher gets reviewers of other course's submission", teacher, "GetReviewers", &pb.SubmissionReviewersRequest{CourseID: course.ID, SubmissionID: otherSubmission.ID}, false},
+		{"teacher gets reconciliation of other course's submission", teacher, "GetReconciliation", &pb.SubmissionReviewersRequest{CourseID: course.ID, SubmissionID: otherSubmission.ID}, false},
+		{"creator overrides grade of other course's submission", teacher, "OverrideGrade", &pb.GradeOverrideRequest{CourseID: course.ID, Override: &pb.GradeOverride{SubmissionID: otherSubmission.ID}}, false},
+		{"teacher updates group", teacher, "UpdateGroup", &pb.Group{ID: group.ID, CourseID: course.ID}, true},
+		// the stored group decides the course, which must match the course in the request
+		{"teacher updates group in other course", teacher, "UpdateGroup", &pb.Group{ID: group.ID, CourseID: otherCourse.ID}, false},
+		{"teacher deletes group in other course", teacher, "DeleteGroup", &pb.GroupRequest{GroupID: group.ID, CourseID: otherCourse.ID}, false},
+		{"unknown method", admin, "NoSuchMethod", &pb.Void{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return &pb.Void{}, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.AutograderService_ServiceDesc.ServiceName + "/" + tt.method}
+			_, err := interceptor(userContext(tt.user), tt.request, info, handler)
+			if tt.allowed {
+				if err != nil || !called {
+					t.Errorf("%s: expected access, got error: %v", tt.method, err)
+				}
+				return
+			}
+			if status.Code(err) != codes.PermissionDenied || called {
+				t.Errorf("%s: expected permission denied, got error: %v", tt.method, err)
+			}
+		})
+	}
+
+	// requests without a user are rejected before any policy is checked
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.AutograderService_ServiceDesc.ServiceName + "/GetUser"}
+	if _, err := interceptor(context.Background(), &pb.Void{}, info, nil); err != ErrInvalidUserInfo {
+		t.Errorf("expected %v, got %v", ErrInvalidUserInfo, err)
+	}
+}
+
+func userContext(user *pb.User) context.Context {
+	meta := metadata.New(map[string]string{"user": strconv.FormatUint(user.GetID(), 10)})
+	return metadata.NewIncomingContext(context.Background(), meta)
+}
@@ -0,0 +1,49 @@
+package web
+
+import (
+	"context"
+	"path"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// mergePullRequest merges the pull request that the given approved submission was submitted with,
+// if the course uses pull request submissions. Failures are logged, but do not revoke the approval.
+func (s *AutograderService) mergePullRequest(submission *pb.Submission) {
+	if submission.GetPullRequest() == 0 {
+		return
+	}
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: submission.GetAssignmentID()}, false)
+	if err != nil {
+		s.logger.Errorf("Failed to merge pull request of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	if course.GetSubmissionMode() != pb.Course_PULL_REQUEST {
+		return
+	}
+	var repo *pb.Repository
+	if assignment.GetIsGroupLab() {
+		repo, err = s.getGroupRepo(course, submission.GetGroupID())
+	} else {
+		repo, err = s.getUserRepo(course, submission.GetUserID())
+	}
+	if err != nil {
+		s.logger.Errorf("Failed to merge pull request of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	sc, err := s.scms.GetOrCreateSCMEntry(s.logger.Desugar(), course.GetProvider(), course.GetAccessToken())
+	if err != nil {
+		s.logger.Errorf("Failed to merge pull request of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pb.MaxWait)
+	defer cancel()
+	if err := sc.MergePullRequest(ctx, &scm.PullRequestOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: path.Base(repo.GetHTMLURL()),
+		Number:     submission.GetPullRequest(),
+	}); err != nil {
+		s.logger.Errorf("Failed to merge pull request %d of submission %d: %v", submission.GetPullRequest(), submission.GetID(), err)
+	}
+}
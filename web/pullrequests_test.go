@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+func TestApprovalMergesPullRequest(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+		SubmissionMode:   pb.Course_PULL_REQUEST,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://github.com/qf-org/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	submitted := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 80, PullRequest: 5}
+	if err := db.CreateSubmission(submitted); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeSCM, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+
+	request := &pb.UpdateSubmissionRequest{SubmissionID: submitted.ID, CourseID: course.ID, Status: pb.Submission_REVISION}
+	if _, err := ags.UpdateSubmission(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	merged := fakeSCM.(*scm.FakeSCM).MergedPullRequests
+	if len(merged) != 0 {
+		t.Fatalf("merged %d pull requests for a submission needing revision, want %d", len(merged), 0)
+	}
+
+	request.Status = pb.Submission_APPROVED
+	if _, err := ags.UpdateSubmission(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	merged = fakeSCM.(*scm.FakeSCM).MergedPullRequests
+	want := &scm.PullRequestOptions{Owner: "qf-org", Repository: "student-labs", Number: 5}
+	if len(merged) != 1 || *merged[0] != *want {
+		t.Fatalf("merged pull requests %+v, want %+v", merged, want)
+	}
+
+	// approving an already approved submission does not merge the pull request again
+	if _, err := ags.UpdateSubmission(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	if merged = fakeSCM.(*scm.FakeSCM).MergedPullRequests; len(merged) != 1 {
+		t.Errorf("merged %d pull requests after approving again, want %d", len(merged), 1)
+	}
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"fmt"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -25,6 +26,9 @@ func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest) (*pb.S
 	if assignment.IsGroupLab {
 		s.logger.Debugf("Rebuilding submission %d for group(%d): %s, assignment: %+v, repo: %s",
 			submission.GetID(), submission.GetGroupID(), name, assignment, repo.GetHTMLURL())
+		if err := s.checkGroupSet(assignment, submission.GetGroupID()); err != nil {
+			return nil, err
+		}
 		repo, err = s.getGroupRepo(course, submission.GetGroupID())
 	} else {
 		s.logger.Debugf("Rebuilding submission %d for user(%d): %s, assignment: %+v, repo: %s",
@@ -36,14 +40,22 @@ func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest) (*pb.S
 	}
 
 	runData := &ci.RunData{
-		Course:     course,
-		Assignment: assignment,
-		Repo:       repo,
-		CommitID:   submission.GetCommitHash(),
-		JobOwner:   slug.Make(name),
-		Rebuild:    true,
+		Course:      course,
+		Assignment:  assignment,
+		Repo:        repo,
+		CommitID:    submission.GetCommitHash(),
+		JobOwner:    slug.Make(name),
+		Rebuild:     true,
+		BaseURL:     s.bh.BaseURL,
+		PullRequest: submission.GetPullRequest(),
 	}
-	ci.RunTests(s.logger, s.db, s.runner, runData)
+	if sc, err := s.scms.GetOrCreateSCMEntry(s.logger.Desugar(), course.GetProvider(), course.GetAccessToken()); err != nil {
+		// the submission is still rebuilt, but the results are not reported to the repository
+		s.logger.Errorf("Failed to create SCM Client: %v", err)
+	} else {
+		runData.SCM = sc
+	}
+	ci.RunTests(s.logger, s.db, s.runner, s.notifier, runData)
 	return s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 }
 
@@ -81,3 +93,15 @@ func (s *AutograderService) lookupName(submission *pb.Submission) string {
 	user, _ := s.db.GetUser(submission.GetUserID())
 	return user.GetLogin()
 }
+
+// checkGroupSet returns an error if the given group is not in the given group assignment's group set.
+func (s *AutograderService) checkGroupSet(assignment *pb.Assignment, groupID uint64) error {
+	group, err := s.db.GetGroup(groupID)
+	if err != nil {
+		return err
+	}
+	if group.GetGroupSet() != assignment.GetGroupSet() {
+		return fmt.Errorf("group %s is not in the group set %q of assignment %s", group.GetName(), assignment.GetGroupSet(), assignment.GetName())
+	}
+	return nil
+}
@@ -122,14 +122,14 @@ func TestRebuildSubmissions(t *testing.T) {
 	// make sure wrong course ID returns error
 	var request pb.AssignmentRequest
 	request.CourseID = 15
-	if _, err = ags.RebuildSubmissions(ctx, &request); err == nil {
+	if _, err = callWithAccessControl(ctx, ags, "RebuildSubmissions", &request); err == nil {
 		t.Fatal("Expected error: record not found")
 	}
 
 	// make sure wrong assignment ID returns error
 	request.CourseID = course.ID
 	request.AssignmentID = 1337
-	if _, err = ags.RebuildSubmissions(ctx, &request); err == nil {
+	if _, err = callWithAccessControl(ctx, ags, "RebuildSubmissions", &request); err == nil {
 		t.Fatal("Expected error: record not found")
 	}
 
@@ -147,7 +147,7 @@ func TestRebuildSubmissions(t *testing.T) {
 
 	// check access control
 	ctx = withUserContext(ctx, student1)
-	if _, err = ags.RebuildSubmissions(ctx, &request); err == nil {
+	if _, err = callWithAccessControl(ctx, ags, "RebuildSubmissions", &request); err == nil {
 		t.Fatal("Expected error: authentication failed")
 	}
 }
@@ -0,0 +1,93 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// getReconciliation returns the reconciled result of the ready teacher reviews of the given submission.
+func (s *AutograderService) getReconciliation(courseID, submissionID uint64) (*pb.Reconciliation, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != courseID {
+		return nil, fmt.Errorf("submission %d does not belong to course %d", submissionID, courseID)
+	}
+	return s.reconcile(submission)
+}
+
+// reconcile returns the reconciled result of the ready teacher reviews of the given submission,
+// including the lead teacher's grade overrides.
+func (s *AutograderService) reconcile(submission *pb.Submission) (*pb.Reconciliation, error) {
+	overrides, err := s.db.GetGradeOverrides(submission.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return submission.Reconcile(overrides), nil
+}
+
+// overrideGrade sets the final grade of a criterion of the given submission, chosen by the given lead teacher.
+func (s *AutograderService) overrideGrade(usr *pb.User, request *pb.GradeOverrideRequest) (*pb.Reconciliation, error) {
+	override := request.GetOverride()
+	reconciliation, err := s.getReconciliation(request.GetCourseID(), override.GetSubmissionID())
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, cr := range reconciliation.GetCriteria() {
+		if cr.GetHeading() == override.GetHeading() && cr.GetDescription() == override.GetDescription() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("criterion %q in %q is not graded by any ready review of submission %d",
+			override.GetDescription(), override.GetHeading(), override.GetSubmissionID())
+	}
+	override.TeacherID = usr.GetID()
+	if err := s.db.UpdateGradeOverride(override); err != nil {
+		return nil, err
+	}
+	return s.getReconciliation(request.GetCourseID(), override.GetSubmissionID())
+}
+
+// reconciledScore returns the score to release for the given submission. For assignments with
+// several reviewers, this is the reconciled score of the submission's ready reviews. An error is
+// returned if the reviews have unresolved disagreements. Otherwise, the given score is returned.
+func (s *AutograderService) reconciledScore(assignment *pb.Assignment, submission *pb.Submission, score uint32) (uint32, error) {
+	if assignment.GetReviewers() < 2 {
+		return score, nil
+	}
+	reconciliation, err := s.reconcile(submission)
+	if err != nil {
+		return 0, err
+	}
+	if len(reconciliation.GetReviewIDs()) == 0 {
+		return score, nil
+	}
+	if !reconciliation.GetResolved() {
+		return 0, fmt.Errorf("submission %d has unresolved review disagreements", submission.GetID())
+	}
+	return reconciliation.GetScore(), nil
+}
+
+// hideBlindReviewers hides the identity of the teacher reviewers of blind review assignments
+// from the given user, except for the user's own reviews. The course creator sees all reviewers.
+func (s *AutograderService) hideBlindReviewers(usr *pb.User, courseID uint64, submissions *pb.CourseSubmissions) {
+	if s.isCourseCreator(courseID, usr.GetID()) {
+		return
+	}
+	for _, link := range submissions.GetLinks() {
+		for _, submissionLink := range link.GetSubmissions() {
+			if submissionLink.GetAssignment().GetBlindReview() {
+				submissionLink.GetSubmission().HideOtherReviewers(usr.GetID())
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReconcileReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	assistant := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{
+		Name:            "Test",
+		Code:            "DAT320",
+		Provider:        "fake",
+		OrganizationID:  1,
+		CourseCreatorID: teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: assistant.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: assistant.ID, CourseID: course.ID, Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 2, BlindReview: true}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	for _, review := range []struct {
+		reviewerID uint64
+		tested     pb.GradingCriterion_Grade
+	}{
+		{teacher.ID, pb.GradingCriterion_PASSED},
+		{assistant.ID, pb.GradingCriterion_FAILED},
+	} {
+		if err := db.CreateReview(&pb.Review{
+			SubmissionID: submission.ID,
+			ReviewerID:   review.reviewerID,
+			Ready:        true,
+			GradingBenchmarks: []*pb.GradingBenchmark{{
+				Heading: "Code",
+				Criteria: []*pb.GradingCriterion{
+					{Description: "Compiles", Grade: pb.GradingCriterion_PASSED},
+					{Description: "Tested", Grade: review.tested},
+				},
+			}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	assistantCtx := withUserContext(context.Background(), assistant)
+
+	// with blind reviews, only the course creator sees the other reviewers
+	reviewers, err := ags.GetReviewers(assistantCtx, &pb.SubmissionReviewersRequest{CourseID: course.ID, SubmissionID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviewers.GetReviewers()) != 1 || reviewers.GetReviewers()[0].GetID() != assistant.ID {
+		t.Errorf("GetReviewers() by assistant for blind review returned %v, want only the assistant", reviewers.GetReviewers())
+	}
+	reviewers, err = ags.GetReviewers(teacherCtx, &pb.SubmissionReviewersRequest{CourseID: course.ID, SubmissionID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviewers.GetReviewers()) != 2 {
+		t.Errorf("GetReviewers() by course creator returned %d reviewers, want %d", len(reviewers.GetReviewers()), 2)
+	}
+
+	reconciliation, err := ags.GetReconciliation(assistantCtx, &pb.SubmissionReviewersRequest{CourseID: course.ID, SubmissionID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reconciliation.GetResolved() {
+		t.Error("GetReconciliation() of disagreeing reviews: Resolved = true, want false")
+	}
+	var disagreements int
+	for _, cr := range reconciliation.GetCriteria() {
+		if cr.GetDisagreement() {
+			disagreements++
+		}
+	}
+	if disagreements != 1 {
+		t.Errorf("GetReconciliation() found %d disagreements, want %d", disagreements, 1)
+	}
+
+	release := &pb.UpdateSubmissionRequest{CourseID: course.ID, SubmissionID: submission.ID, Status: pb.Submission_APPROVED, Released: true}
+	if _, err := ags.UpdateSubmission(teacherCtx, release); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateSubmission() releasing unresolved reviews: got error %v, want %v", err, codes.InvalidArgument)
+	}
+
+	override := &pb.GradeOverrideRequest{
+		CourseID: course.ID,
+		Override: &pb.GradeOverride{SubmissionID: submission.ID, Heading: "Code", Description: "Tested", Grade: pb.GradingCriterion_FAILED},
+	}
+	if _, err := callWithAccessControl(assistantCtx, ags, "OverrideGrade", override); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("OverrideGrade() by assistant: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	reconciliation, err = ags.OverrideGrade(teacherCtx, override)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reconciliation.GetResolved() || reconciliation.GetScore() != 50 {
+		t.Errorf("OverrideGrade() = (resolved %t, score %d), want (resolved %t, score %d)", reconciliation.GetResolved(), reconciliation.GetScore(), true, 50)
+	}
+
+	if _, err := ags.UpdateSubmission(teacherCtx, release); err != nil {
+		t.Fatal(err)
+	}
+	released, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !released.GetReleased() || released.GetScore() != 50 {
+		t.Errorf("released submission = (released %t, score %d), want (released %t, score %d)", released.GetReleased(), released.GetScore(), true, 50)
+	}
+}
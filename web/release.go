@@ -0,0 +1,119 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// scheduleRelease creates or replaces the release schedule for an assignment.
+func (s *AutograderService) scheduleRelease(schedule *pb.ReleaseSchedule) (*pb.ReleaseSchedule, error) {
+	assignment, _, err := s.getAssignmentWithCourse(&pb.Assignment{
+		CourseID: schedule.GetCourseID(),
+		ID:       schedule.GetAssignmentID(),
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := schedule.ReleaseAt(assignment, time.Local); err != nil {
+		return nil, fmt.Errorf("failed to compute release time for assignment %s: %w", assignment.GetName(), err)
+	}
+	schedule.ReleasedDate = ""
+	if err := s.db.UpdateReleaseSchedule(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// getReleaseSchedules returns the release schedules for the given course.
+func (s *AutograderService) getReleaseSchedules(courseID uint64) (*pb.ReleaseSchedules, error) {
+	schedules, err := s.db.GetReleaseSchedules(courseID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseSchedules{Schedules: schedules}, nil
+}
+
+// cancelRelease removes the release schedule for the given assignment.
+func (s *AutograderService) cancelRelease(courseID, assignmentID uint64) error {
+	if _, _, err := s.getAssignmentWithCourse(&pb.Assignment{CourseID: courseID, ID: assignmentID}, false); err != nil {
+		return err
+	}
+	return s.db.DeleteReleaseSchedule(assignmentID)
+}
+
+// RunScheduler performs the scheduled releases of reviews and scores, posts deadline
+// reminders to chat channels and retries failed webhook deliveries at the given interval,
+// until the context is canceled.
+func (s *AutograderService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.ReleaseDue(now); err != nil {
+				s.logger.Errorf("Scheduled release failed: %v", err)
+			}
+			if err := s.RemindDeadlines(now); err != nil {
+				s.logger.Errorf("Deadline reminders failed: %v", err)
+			}
+			s.notifier.RetryDeliveries(now)
+		}
+	}
+}
+
+// ReleaseDue performs all scheduled releases whose release time has passed at the given time.
+// Schedules that fail to release, e.g., due to unresolved review disagreements,
+// are retried the next time ReleaseDue is called.
+func (s *AutograderService) ReleaseDue(now time.Time) error {
+	schedules, err := s.db.GetPendingReleases()
+	if err != nil {
+		return err
+	}
+	var failed int
+	for _, schedule := range schedules {
+		assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: schedule.GetAssignmentID()}, false)
+		if err != nil {
+			s.logger.Errorf("Scheduled release for assignment %d failed: %v", schedule.GetAssignmentID(), err)
+			failed++
+			continue
+		}
+		due, err := schedule.IsDue(assignment, now)
+		if err != nil {
+			s.logger.Errorf("Scheduled release for assignment %s failed: %v", assignment.GetName(), err)
+			failed++
+			continue
+		}
+		if !due {
+			continue
+		}
+		if err := s.release(schedule, assignment, course, now); err != nil {
+			s.logger.Errorf("Scheduled release for assignment %s failed: %v", assignment.GetName(), err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d scheduled releases failed", failed, len(schedules))
+	}
+	return nil
+}
+
+// release releases the reviews and scores of the given assignment according to the schedule.
+// The students whose feedback was released are notified by updateSubmissions.
+func (s *AutograderService) release(schedule *pb.ReleaseSchedule, assignment *pb.Assignment, course *pb.Course, now time.Time) error {
+	if err := s.updateSubmissions(&pb.UpdateSubmissionsRequest{
+		CourseID:     course.GetID(),
+		AssignmentID: assignment.GetID(),
+		ScoreLimit:   schedule.GetScoreLimit(),
+		Release:      true,
+		Approve:      schedule.GetApprove(),
+	}); err != nil {
+		return err
+	}
+	schedule.ReleasedDate = now.Format(pb.TimeLayout)
+	return s.db.UpdateReleaseSchedule(schedule)
+}
@@ -0,0 +1,130 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduledRelease(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:            "Test",
+		Code:            "DAT320",
+		Provider:        "fake",
+		OrganizationID:  1,
+		CourseCreatorID: teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: "2021-03-01T12:00:00", Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	students := make([]*pb.User, 2)
+	for i := range students {
+		students[i] = qtest.CreateFakeUser(t, db, uint64(i+2))
+		qtest.EnrollStudent(t, db, students[i], course)
+	}
+	passing := &pb.Submission{AssignmentID: assignment.ID, UserID: students[0].ID, Score: 80}
+	failing := &pb.Submission{AssignmentID: assignment.ID, UserID: students[1].ID, Score: 40}
+	for _, submission := range []*pb.Submission{passing, failing} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	sender := &recordingSender{}
+	ags.SetNotificationSender(sender)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), students[0])
+
+	schedule := &pb.ReleaseSchedule{CourseID: course.ID, AssignmentID: assignment.ID, DaysAfterDeadline: 2, ScoreLimit: 50, Approve: true}
+	if _, err := callWithAccessControl(studentCtx, ags, "ScheduleRelease", schedule); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ScheduleRelease() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.ScheduleRelease(teacherCtx, schedule); err != nil {
+		t.Fatal(err)
+	}
+	schedules, err := ags.GetReleaseSchedules(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules.GetSchedules()) != 1 || schedules.GetSchedules()[0].GetDaysAfterDeadline() != 2 {
+		t.Fatalf("GetReleaseSchedules() = %v, want the scheduled release", schedules.GetSchedules())
+	}
+
+	beforeRelease := time.Date(2021, 3, 3, 11, 0, 0, 0, time.Local)
+	if err := ags.ReleaseDue(beforeRelease); err != nil {
+		t.Fatal(err)
+	}
+	checkReleased(t, db, passing.ID, false)
+	if sent := sender.waitForSent(0); len(sent) != 0 {
+		t.Errorf("ReleaseDue() before release time sent %d notifications, want %d", len(sent), 0)
+	}
+
+	afterRelease := time.Date(2021, 3, 3, 12, 0, 0, 0, time.Local)
+	if err := ags.ReleaseDue(afterRelease); err != nil {
+		t.Fatal(err)
+	}
+	checkReleased(t, db, passing.ID, true)
+	checkReleased(t, db, failing.ID, false)
+	// the passing student is notified about both the approval and the release
+	sent := sender.waitForSent(2)
+	if len(sent) != 2 {
+		t.Fatalf("ReleaseDue() sent %d notifications, want %d", len(sent), 2)
+	}
+	for _, sent := range sent {
+		if sent.to.GetID() != students[0].ID {
+			t.Errorf("ReleaseDue() notified user %d, want user %d", sent.to.GetID(), students[0].ID)
+		}
+	}
+
+	// the release is only performed once
+	if err := ags.ReleaseDue(afterRelease.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if sent = sender.waitForSent(2); len(sent) != 2 {
+		t.Errorf("ReleaseDue() after release sent %d notifications, want %d", len(sent), 2)
+	}
+
+	if _, err := ags.CancelRelease(teacherCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}); err != nil {
+		t.Fatal(err)
+	}
+	schedules, err = ags.GetReleaseSchedules(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules.GetSchedules()) != 0 {
+		t.Errorf("GetReleaseSchedules() after CancelRelease() returned %d schedules, want %d", len(schedules.GetSchedules()), 0)
+	}
+}
+
+func checkReleased(t *testing.T, db database.Database, submissionID uint64, want bool) {
+	t.Helper()
+	submission, err := db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetReleased() != want {
+		t.Errorf("submission %d: Released = %t, want %t", submissionID, submission.GetReleased(), want)
+	}
+	if want && submission.GetStatus() != pb.Submission_APPROVED {
+		t.Errorf("submission %d: Status = %v, want %v", submissionID, submission.GetStatus(), pb.Submission_APPROVED)
+	}
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// distributeReviews distributes the submissions for the given assignment evenly among
+// the requested reviewers, or all the course's teachers and teaching assistants if none are requested.
+// Existing review tasks for the assignment are replaced, except for teachers that
+// have already reviewed a submission, who keep their review task for that submission.
+func (s *AutograderService) distributeReviews(request *pb.ReviewDistributionRequest) (*pb.ReviewProgress, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetReviewers() == 0 {
+		return nil, fmt.Errorf("assignment %s is not manually reviewed", assignment.GetName())
+	}
+	reviewerIDs := request.GetReviewerIDs()
+	if len(reviewerIDs) == 0 {
+		teachers, err := s.db.GetEnrollmentsByCourse(request.GetCourseID(), pb.Enrollment_TEACHER, pb.Enrollment_TA)
+		if err != nil {
+			return nil, err
+		}
+		for _, teacher := range teachers {
+			reviewerIDs = append(reviewerIDs, teacher.GetUserID())
+		}
+	}
+	for _, reviewerID := range reviewerIDs {
+		if !s.isTeacherOrTA(reviewerID, request.GetCourseID()) {
+			return nil, fmt.Errorf("user %d is not a teacher or teaching assistant of course %d", reviewerID, request.GetCourseID())
+		}
+	}
+	submissions, err := s.getReviewedSubmissions(assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	var excluded map[uint64][]uint64
+	if request.GetExcludeOwnGroup() {
+		if excluded, err = s.submissionAuthors(submissions); err != nil {
+			return nil, err
+		}
+	}
+	tasks := pb.DistributeReviewTasks(assignment.GetID(), assignment.GetReviewers(), reviewerIDs, submissions, excluded)
+	s.logger.Debugf("Distributing %d review tasks for assignment %s among %d reviewers", len(tasks), assignment.GetName(), len(reviewerIDs))
+	if err := s.db.UpdateReviewTasks(assignment.GetID(), tasks); err != nil {
+		return nil, err
+	}
+	return pb.NewReviewProgress(tasks, submissions), nil
+}
+
+// getReviewProgress returns the review progress of each reviewer with review tasks for the given assignment.
+func (s *AutograderService) getReviewProgress(request *pb.AssignmentRequest) (*pb.ReviewProgress, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.db.GetReviewTasks(&pb.ReviewTask{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.getReviewedSubmissions(assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewReviewProgress(tasks, submissions), nil
+}
+
+// getReviewQueue returns the submissions for the given assignment that the given user
+// is assigned to review, but has not yet completed a ready review for.
+func (s *AutograderService) getReviewQueue(usr *pb.User, request *pb.AssignmentRequest) (*pb.Submissions, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.db.GetReviewTasks(&pb.ReviewTask{AssignmentID: assignment.GetID(), ReviewerID: usr.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	submissions := make([]*pb.Submission, 0, len(tasks))
+	for _, task := range tasks {
+		submission, err := s.db.GetSubmission(&pb.Submission{ID: task.GetSubmissionID()})
+		if err != nil {
+			return nil, err
+		}
+		if !submission.ReviewBy(usr.GetID()).GetReady() {
+			submissions = append(submissions, submission)
+		}
+	}
+	return &pb.Submissions{Submissions: submissions}, nil
+}
+
+// getReviewedSubmissions returns the submissions for the given assignment, including their reviews.
+func (s *AutograderService) getReviewedSubmissions(assignmentID uint64) ([]*pb.Submission, error) {
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	for i, submission := range submissions {
+		if submissions[i], err = s.db.GetSubmission(&pb.Submission{ID: submission.GetID()}); err != nil {
+			return nil, err
+		}
+	}
+	return submissions, nil
+}
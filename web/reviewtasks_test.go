@@ -0,0 +1,125 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDistributeReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	assistant := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{
+		Name:            "Test",
+		Code:            "DAT320",
+		Provider:        "fake",
+		OrganizationID:  1,
+		CourseCreatorID: teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: assistant.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: assistant.ID, CourseID: course.ID, Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	autoGraded := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	if err := db.CreateAssignment(autoGraded); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		student := qtest.CreateFakeUser(t, db, uint64(i+3))
+		qtest.EnrollStudent(t, db, student, course)
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	assistantCtx := withUserContext(context.Background(), assistant)
+
+	request := &pb.ReviewDistributionRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+	if _, err := callWithAccessControl(assistantCtx, ags, "DistributeReviews", request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DistributeReviews() by assistant: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.DistributeReviews(teacherCtx, &pb.ReviewDistributionRequest{CourseID: course.ID, AssignmentID: autoGraded.ID}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("DistributeReviews() for assignment without reviewers: got error %v, want %v", err, codes.InvalidArgument)
+	}
+	progress, err := ags.DistributeReviews(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(progress.GetReviewers()) != 2 {
+		t.Fatalf("DistributeReviews() returned progress for %d reviewers, want %d", len(progress.GetReviewers()), 2)
+	}
+	for _, reviewer := range progress.GetReviewers() {
+		if reviewer.GetAssigned() != 2 {
+			t.Errorf("reviewer %d assigned %d submissions, want %d", reviewer.GetReviewerID(), reviewer.GetAssigned(), 2)
+		}
+	}
+
+	queue, err := ags.GetReviewQueue(assistantCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.GetSubmissions()) != 2 {
+		t.Fatalf("GetReviewQueue() returned %d submissions, want %d", len(queue.GetSubmissions()), 2)
+	}
+	if _, err := ags.CreateReview(assistantCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: queue.GetSubmissions()[0].GetID(), ReviewerID: assistant.ID, Ready: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	queue, err = ags.GetReviewQueue(assistantCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.GetSubmissions()) != 1 {
+		t.Errorf("GetReviewQueue() after review returned %d submissions, want %d", len(queue.GetSubmissions()), 1)
+	}
+
+	progress, err = ags.GetReviewProgress(assistantCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, reviewer := range progress.GetReviewers() {
+		wantReady := uint32(0)
+		if reviewer.GetReviewerID() == assistant.ID {
+			wantReady = 1
+		}
+		if reviewer.GetReady() != wantReady {
+			t.Errorf("reviewer %d has %d ready reviews, want %d", reviewer.GetReviewerID(), reviewer.GetReady(), wantReady)
+		}
+	}
+
+	// redistributing keeps the assistant's started review
+	progress, err = ags.DistributeReviews(teacherCtx, &pb.ReviewDistributionRequest{CourseID: course.ID, AssignmentID: assignment.ID, ReviewerIDs: []uint64{teacher.ID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[uint64]uint32{teacher.ID: 3, assistant.ID: 1}
+	for _, reviewer := range progress.GetReviewers() {
+		if reviewer.GetAssigned() != want[reviewer.GetReviewerID()] {
+			t.Errorf("reviewer %d assigned %d submissions, want %d", reviewer.GetReviewerID(), reviewer.GetAssigned(), want[reviewer.GetReviewerID()])
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+)
+
+// createRubricTemplate creates a new version of the given rubric template, made by the given user.
+func (s *AutograderService) createRubricTemplate(usr *pb.User, template *pb.RubricTemplate) (*pb.RubricTemplate, error) {
+	template.CreatorID = usr.GetID()
+	if err := s.db.CreateRubricTemplate(template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// getRubricTemplates returns the rubric templates available to the given course.
+func (s *AutograderService) getRubricTemplates(courseID uint64) (*pb.RubricTemplates, error) {
+	templates, err := s.db.GetRubricTemplates(courseID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RubricTemplates{Templates: templates}, nil
+}
+
+// applyRubricTemplate updates the grading benchmarks of the given assignment,
+// and of its existing reviews, to match the given rubric template.
+func (s *AutograderService) applyRubricTemplate(request *pb.RubricTemplateRequest) ([]*pb.GradingBenchmark, error) {
+	template, err := s.db.GetRubricTemplate(request.GetTemplateID())
+	if err != nil {
+		return nil, err
+	}
+	if template.GetCourseID() != request.GetCourseID() && !template.GetShared() {
+		return nil, fmt.Errorf("rubric template %d is not available to course %d", template.GetID(), request.GetCourseID())
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	return s.updateRubric(assignment, template.GetBenchmarks(), template.GetID())
+}
+
+// updateRubric updates the grading benchmarks of the given assignment, and of its existing reviews,
+// to match the given rubric, and records the rubric template the rubric was loaded from, if any.
+// Unchanged benchmarks and criteria are kept, such that reviews keep their grades and comments
+// for these criteria. Reviews with ungraded criteria after the update are no longer ready.
+// The update is made in a single transaction, and is rejected if reviews of the assignment
+// have been released. The assignment's updated benchmarks are returned.
+func (s *AutograderService) updateRubric(assignment *pb.Assignment, rubric []*pb.GradingBenchmark, templateID uint64) ([]*pb.GradingBenchmark, error) {
+	var benchmarks []*pb.GradingBenchmark
+	err := s.db.UpdateRubric(func(tx database.Database) error {
+		// a copy of the service whose database operations are part of the transaction
+		txService := *s
+		txService.db = tx
+		var err error
+		if benchmarks, err = txService.mergeRubric(assignment, rubric); err != nil {
+			return err
+		}
+		return tx.UpdateAssignmentRubric(assignment.GetID(), templateID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return benchmarks, nil
+}
+
+// mergeRubric merges the given rubric with the grading benchmarks of the given assignment and of its reviews.
+func (s *AutograderService) mergeRubric(assignment *pb.Assignment, rubric []*pb.GradingBenchmark) ([]*pb.GradingBenchmark, error) {
+	submissions, err := s.getReviewedSubmissions(assignment.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, submission := range submissions {
+		// released scores are computed from the rubric the submission was reviewed with
+		if submission.GetReleased() && len(submission.TeacherReviews()) > 0 {
+			return nil, fmt.Errorf("rubric of assignment %s cannot be changed: review of submission %d is released", assignment.GetName(), submission.GetID())
+		}
+	}
+
+	var existing []*pb.GradingBenchmark
+	for _, bm := range assignment.GetGradingBenchmarks() {
+		// skip benchmarks copied to reviews
+		if bm.GetReviewID() == 0 {
+			existing = append(existing, bm)
+		}
+	}
+	benchmarks, err := s.mergeBenchmarks(existing, rubric, assignment.GetID(), 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, submission := range submissions {
+		for _, review := range submission.GetReviews() {
+			if review.GradingBenchmarks, err = s.mergeBenchmarks(review.GetGradingBenchmarks(), rubric, 0, review.GetID()); err != nil {
+				return nil, err
+			}
+			review.ComputeScore()
+			if !review.Graded() {
+				review.Ready = false
+			}
+			if err := s.db.UpdateReview(review); err != nil {
+				return nil, err
+			}
+		}
+		if len(submission.PeerReviews()) > 0 {
+			if _, err := s.updatePeerScore(submission.GetID()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return benchmarks, nil
+}
+
+// mergeBenchmarks merges the given existing benchmarks of an assignment or a review with the given rubric,
+// and stores the changes. New benchmarks are added to the given assignment or review.
+func (s *AutograderService) mergeBenchmarks(existing, rubric []*pb.GradingBenchmark, assignmentID, reviewID uint64) ([]*pb.GradingBenchmark, error) {
+	merged, removed, removedCriteria := pb.MergeBenchmarks(existing, rubric)
+	for _, bm := range removed {
+		if err := s.db.DeleteBenchmark(bm); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range removedCriteria {
+		if err := s.db.DeleteCriterion(c); err != nil {
+			return nil, err
+		}
+	}
+	for _, bm := range merged {
+		if bm.GetID() == 0 {
+			// creates the benchmark with all its criteria
+			bm.AssignmentID = assignmentID
+			bm.ReviewID = reviewID
+			if err := s.db.CreateBenchmark(bm); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := s.db.UpdateBenchmark(bm); err != nil {
+			return nil, err
+		}
+		for _, c := range bm.GetCriteria() {
+			if c.GetID() == 0 {
+				c.BenchmarkID = bm.GetID()
+				if err := s.db.CreateCriterion(c); err != nil {
+					return nil, err
+				}
+			} else if err := s.db.UpdateCriterion(c); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,194 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRubricTemplates(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{Name: "Test", Code: "DAT320", Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	otherCourse := &pb.Course{Name: "Other", Code: "DAT520", Provider: "fake", OrganizationID: 2}
+	if err := db.CreateCourse(teacher.ID, otherCourse); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	rubric := &pb.RubricTemplate{
+		CourseID: course.ID,
+		Name:     "Lab rubric",
+		Benchmarks: []*pb.GradingBenchmark{
+			{
+				Heading: "Code",
+				Criteria: []*pb.GradingCriterion{
+					{Description: "Compiles", Points: 50},
+					{Description: "Has tests", Points: 50},
+				},
+			},
+		},
+	}
+	if _, err := callWithAccessControl(studentCtx, ags, "CreateRubricTemplate", rubric); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateRubricTemplate() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	v1, err := ags.CreateRubricTemplate(teacherCtx, rubric)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1.GetVersion() != 1 || v1.GetCreatorID() != teacher.ID {
+		t.Errorf("CreateRubricTemplate() = version %d by %d, want version %d by %d", v1.GetVersion(), v1.GetCreatorID(), 1, teacher.ID)
+	}
+
+	benchmarks, err := ags.ApplyRubricTemplate(teacherCtx, &pb.RubricTemplateRequest{CourseID: course.ID, AssignmentID: assignment.ID, TemplateID: v1.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the teacher grades the submission with a copy of the assignment's benchmarks
+	for _, bm := range benchmarks.GetBenchmarks() {
+		for _, c := range bm.GetCriteria() {
+			c.Grade = pb.GradingCriterion_PASSED
+		}
+	}
+	review, err := ags.CreateReview(teacherCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review: &pb.Review{
+			SubmissionID:      submission.ID,
+			ReviewerID:        teacher.ID,
+			Ready:             true,
+			GradingBenchmarks: benchmarks.GetBenchmarks(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.GetScore() != 100 {
+		t.Fatalf("CreateReview() score = %d, want %d", review.GetScore(), 100)
+	}
+
+	// version 2 keeps "Compiles", drops "Has tests" and adds "Is documented"
+	rubric = &pb.RubricTemplate{
+		CourseID: course.ID,
+		Name:     "Lab rubric",
+		Shared:   true,
+		Benchmarks: []*pb.GradingBenchmark{
+			{
+				Heading: "Code",
+				Criteria: []*pb.GradingCriterion{
+					{Description: "Compiles", Points: 60},
+					{Description: "Is documented", Points: 40},
+				},
+			},
+		},
+	}
+	v2, err := ags.CreateRubricTemplate(teacherCtx, rubric)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v2.GetVersion() != 2 {
+		t.Errorf("CreateRubricTemplate() version = %d, want %d", v2.GetVersion(), 2)
+	}
+	if _, err := ags.ApplyRubricTemplate(teacherCtx, &pb.RubricTemplateRequest{CourseID: course.ID, AssignmentID: assignment.ID, TemplateID: v2.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetRubricTemplateID() != v2.ID {
+		t.Errorf("RubricTemplateID = %d, want %d", updated.GetRubricTemplateID(), v2.ID)
+	}
+	reviewed, err := db.GetReview(&pb.Review{ID: review.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reviewed.GetReady() {
+		t.Error("review with ungraded criteria is still ready")
+	}
+	if reviewed.GetScore() != 60 {
+		t.Errorf("review score = %d, want %d", reviewed.GetScore(), 60)
+	}
+	wantGrades := map[string]pb.GradingCriterion_Grade{
+		"Compiles":      pb.GradingCriterion_PASSED,
+		"Is documented": pb.GradingCriterion_NONE,
+	}
+	gotGrades := make(map[string]pb.GradingCriterion_Grade)
+	for _, bm := range reviewed.GetGradingBenchmarks() {
+		for _, c := range bm.GetCriteria() {
+			gotGrades[c.GetDescription()] = c.GetGrade()
+		}
+	}
+	if len(gotGrades) != len(wantGrades) {
+		t.Errorf("review criteria = %v, want %v", gotGrades, wantGrades)
+	}
+	for description, grade := range wantGrades {
+		if gotGrades[description] != grade {
+			t.Errorf("grade for %q = %v, want %v", description, gotGrades[description], grade)
+		}
+	}
+
+	// the rubric cannot be changed once the review is released, since the released score would change
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{SubmissionID: submission.ID, CourseID: course.ID, Released: true, Score: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.ApplyRubricTemplate(teacherCtx, &pb.RubricTemplateRequest{CourseID: course.ID, AssignmentID: assignment.ID, TemplateID: v1.ID}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ApplyRubricTemplate() after release: got error %v, want %v", err, codes.InvalidArgument)
+	}
+	updated, err = db.GetAssignment(&pb.Assignment{ID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetRubricTemplateID() != v2.ID {
+		t.Errorf("RubricTemplateID after rejected ApplyRubricTemplate() = %d, want %d", updated.GetRubricTemplateID(), v2.ID)
+	}
+	for _, bm := range updated.GetGradingBenchmarks() {
+		for _, c := range bm.GetCriteria() {
+			if c.GetDescription() == "Has tests" {
+				t.Errorf("rejected ApplyRubricTemplate() added criterion %q", c.GetDescription())
+			}
+		}
+	}
+
+	// the other course can only use the shared version of the template
+	templates, err := ags.GetRubricTemplates(teacherCtx, &pb.CourseRequest{CourseID: otherCourse.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates.GetTemplates()) != 1 || templates.GetTemplates()[0].GetID() != v2.ID {
+		t.Errorf("GetRubricTemplates() for other course = %v, want only version 2", templates.GetTemplates())
+	}
+	otherAssignment := &pb.Assignment{CourseID: otherCourse.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(otherAssignment); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.ApplyRubricTemplate(teacherCtx, &pb.RubricTemplateRequest{CourseID: otherCourse.ID, AssignmentID: otherAssignment.ID, TemplateID: v1.ID}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ApplyRubricTemplate() with unshared template: got error %v, want %v", err, codes.InvalidArgument)
+	}
+}
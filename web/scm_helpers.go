@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/scm"
@@ -138,8 +139,8 @@ func addUserToStudentsTeam(ctx context.Context, sc scm.SCM, organizationPath str
 	return nil
 }
 
-// add user to the organization's "teachers" team, and remove user from "students" team.
-func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath string, userName string) error {
+// add user to the organization's "teachers" team with the given team role, and remove user from "students" team.
+func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath string, userName string, role string) error {
 	studentsTeam := &scm.TeamMembershipOptions{
 		Organization: organizationPath,
 		Username:     userName,
@@ -153,7 +154,7 @@ func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath
 		Organization: organizationPath,
 		Username:     userName,
 		TeamName:     scm.TeachersTeam,
-		Role:         scm.TeamMaintainer,
+		Role:         role,
 	}
 	if err := sc.AddTeamMember(ctx, teachersTeam); err != nil {
 		return err
@@ -192,11 +193,37 @@ func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, log
 		if err = sc.UpdateOrgMembership(ctx, orgUpdate); err != nil {
 			return nil, fmt.Errorf("UpdateReposAndTeams: failed to update org membership for %s: %w", login, err)
 		}
-		err = promoteUserToTeachersTeam(ctx, sc, org.GetPath(), login)
+		err = promoteUserToTeachersTeam(ctx, sc, org.GetPath(), login, scm.TeamMaintainer)
+
+	case pb.Enrollment_TA:
+		// teaching assistants are regular organization members, and cannot manage the teachers team;
+		// a teacher made teaching assistant is no longer organization owner
+		orgUpdate := &scm.OrgMembershipOptions{
+			Organization: org.Path,
+			Username:     login,
+			Role:         scm.OrgMember,
+		}
+		if err = sc.UpdateOrgMembership(ctx, orgUpdate); err != nil {
+			return nil, fmt.Errorf("UpdateReposAndTeams: failed to update org membership for %s: %w", login, err)
+		}
+		err = promoteUserToTeachersTeam(ctx, sc, org.GetPath(), login, scm.TeamMember)
 	}
 	return nil, err
 }
 
+// grantRepoAccess gives the given users pull access to the given course repositories,
+// such that teaching assistants can view the submitted code without being organization owners.
+func grantRepoAccess(ctx context.Context, sc scm.SCM, org string, repos []*pb.Repository, logins ...string) error {
+	for _, repo := range repos {
+		for _, login := range logins {
+			if err := sc.UpdateRepoAccess(ctx, &scm.Repository{Owner: org, Path: path.Base(repo.GetHTMLURL())}, login, scm.RepoPull); err != nil {
+				return fmt.Errorf("grantRepoAccess: failed to update repo access to repo %s for user %s: %w", repo.GetHTMLURL(), login, err)
+			}
+		}
+	}
+	return nil
+}
+
 func grantAccessToCourseRepos(ctx context.Context, sc scm.SCM, org, login string) error {
 	commonRepos := []string{pb.InfoRepo, pb.AssignmentRepo}
 
@@ -0,0 +1,33 @@
+package web
+
+import (
+	"errors"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// getSessions returns the sessions of the given user.
+func (s *AutograderService) getSessions(userID uint64) (*pb.Sessions, error) {
+	sessions, err := s.db.GetSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+	userSessions := &pb.Sessions{Sessions: sessions}
+	userSessions.RemoveHash()
+	return userSessions, nil
+}
+
+// revokeSessions deletes the requested session of the user, or all the user's sessions if no session is given.
+func (s *AutograderService) revokeSessions(request *pb.SessionRequest) error {
+	if request.GetUserID() == 0 {
+		return errors.New("missing user ID")
+	}
+	if request.GetSessionID() == 0 {
+		return s.db.DeleteSessions(&pb.Session{UserID: request.GetUserID()})
+	}
+	session, err := s.db.GetSession(&pb.Session{ID: request.GetSessionID(), UserID: request.GetUserID()})
+	if err != nil {
+		return err
+	}
+	return s.db.DeleteSessions(&pb.Session{ID: session.GetID()})
+}
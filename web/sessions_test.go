@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSessions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	for _, cookie := range []string{"session=laptop", "session=phone", "session=tablet"} {
+		if err := auth.Add(db, cookie, student.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := auth.Add(db, "session=admin", admin.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	adminCtx := withUserContext(context.Background(), admin)
+	studentCtx := withUserContext(context.Background(), student)
+
+	if _, err := callWithAccessControl(studentCtx, ags, "GetSessions", &pb.UserRequest{UserID: student.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSessions() by student: expected permission denied, got %v", err)
+	}
+	if _, err := callWithAccessControl(studentCtx, ags, "RevokeSessions", &pb.SessionRequest{UserID: admin.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RevokeSessions() by student: expected permission denied, got %v", err)
+	}
+
+	sessions, err := ags.GetSessions(adminCtx, &pb.UserRequest{UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions.GetSessions()) != 3 {
+		t.Fatalf("GetSessions() returned %d sessions, want 3", len(sessions.GetSessions()))
+	}
+	for _, session := range sessions.GetSessions() {
+		if session.GetHash() != "" || session.GetUserID() != student.ID || session.GetExpiryDate() == "" {
+			t.Errorf("GetSessions() returned unexpected session: %+v", session)
+		}
+	}
+
+	// sessions can only be revoked for the user they belong to
+	laptop := sessions.GetSessions()[0]
+	if _, err := ags.RevokeSessions(adminCtx, &pb.SessionRequest{UserID: admin.ID, SessionID: laptop.GetID()}); status.Code(err) != codes.NotFound {
+		t.Errorf("RevokeSessions() with wrong user: expected not found, got %v", err)
+	}
+	if _, err := ags.RevokeSessions(adminCtx, &pb.SessionRequest{UserID: student.ID, SessionID: laptop.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	if got := auth.Get(db, "session=laptop"); got != 0 {
+		t.Errorf("revoked session is still valid for user %d", got)
+	}
+	if got := auth.Get(db, "session=phone"); got != student.ID {
+		t.Errorf("Get(session=phone) = %d, want %d", got, student.ID)
+	}
+
+	if _, err := ags.RevokeSessions(adminCtx, &pb.SessionRequest{UserID: student.ID}); err != nil {
+		t.Fatal(err)
+	}
+	sessions, err = ags.GetSessions(adminCtx, &pb.UserRequest{UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions.GetSessions()) != 0 {
+		t.Errorf("GetSessions() after revoking all sessions returned %d sessions, want 0", len(sessions.GetSessions()))
+	}
+	if got := auth.Get(db, "session=admin"); got != admin.ID {
+		t.Errorf("Get(session=admin) = %d, want %d", got, admin.ID)
+	}
+}
@@ -0,0 +1,67 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/gosimple/slug"
+)
+
+// submitCommit tests the given commit of the user's or the user's group's repository
+// for the given assignment, and returns the resulting submission.
+// For group assignments, the user's group in the assignment's group set is used.
+func (s *AutograderService) submitCommit(user *pb.User, request *pb.SubmitRequest) (*pb.Submission, error) {
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: request.GetAssignmentID()}, false)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+
+	var repo *pb.Repository
+	if assignment.GetIsGroupLab() {
+		group, err := s.getGroupByUserAndCourse(&pb.GroupRequest{UserID: user.GetID(), CourseID: course.GetID(), GroupSet: assignment.GetGroupSet()})
+		if err != nil {
+			return nil, err
+		}
+		repo, err = s.getGroupRepo(course, group.GetID())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		repo, err = s.getUserRepo(course, user.GetID())
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.logger.Debugf("Submitting commit %s of repo %s for assignment %s", request.GetCommitHash(), repo.GetHTMLURL(), assignment.GetName())
+
+	runData := &ci.RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       repo,
+		CommitID:   request.GetCommitHash(),
+		JobOwner:   slug.Make(user.GetLogin()),
+		BaseURL:    s.bh.BaseURL,
+	}
+	if assignment.GradedManually() {
+		if err := ci.RecordWithoutTests(s.db, runData); err != nil {
+			return nil, err
+		}
+	} else {
+		if sc, err := s.scms.GetOrCreateSCMEntry(s.logger.Desugar(), course.GetProvider(), course.GetAccessToken()); err != nil {
+			// the tests are still run, but the results are not reported to the repository
+			s.logger.Errorf("Failed to create SCM Client: %v", err)
+		} else {
+			runData.SCM = sc
+		}
+		ci.RunTests(s.logger, s.db, s.runner, s.notifier, runData)
+	}
+	return s.db.GetSubmission(&pb.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       repo.GetUserID(),
+		GroupID:      repo.GetGroupID(),
+	})
+}
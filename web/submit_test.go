@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubmitCommit(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:             "Test",
+		Code:             "DAT320",
+		Provider:         "fake",
+		OrganizationID:   1,
+		OrganizationPath: "qf-org",
+		CourseCreatorID:  teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed assignments are submitted without running tests
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab1); err != nil {
+		t.Fatal(err)
+	}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Reviewers: 1, IsGroupLab: true}
+	if err := db.CreateAssignment(lab2); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://github.com/qf-org/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	outsider := qtest.CreateFakeUser(t, db, 3)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	const commitHash = "e7a3f5b0d4c2a1f9e8d7c6b5a4f3e2d1c0b9a8f7"
+	request := &pb.SubmitRequest{CourseID: course.ID, AssignmentID: lab1.ID, CommitHash: commitHash}
+	if _, err := callWithAccessControl(withUserContext(context.Background(), outsider), ags, "SubmitCommit", request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SubmitCommit by user not enrolled in the course returned %v, want %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), student)
+	submission, err := ags.SubmitCommit(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetCommitHash() != commitHash || submission.GetUserID() != student.ID {
+		t.Errorf("got submission of commit %s by user %d, want commit %s by user %d",
+			submission.GetCommitHash(), submission.GetUserID(), commitHash, student.ID)
+	}
+
+	// group assignments can only be submitted by group members
+	request.AssignmentID = lab2.ID
+	if _, err := ags.SubmitCommit(ctx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SubmitCommit of group assignment by user without group returned %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	for _, hash := range []string{"", "e7a3f5", "e7a3f5b; rm -rf /", "main", commitHash + "0"} {
+		request := &pb.SubmitRequest{CourseID: course.ID, AssignmentID: lab1.ID, CommitHash: hash}
+		if request.IsValid() {
+			t.Errorf("SubmitRequest with commit hash %q is valid, want invalid", hash)
+		}
+	}
+}
@@ -50,7 +50,7 @@ func TestGetSelf(t *testing.T) {
 	}
 
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
-	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier())
+	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier(db))
 	s := grpc.NewServer(opt)
 	pb.RegisterAutograderServiceServer(s, ags)
 
@@ -97,7 +97,9 @@ func TestGetSelf(t *testing.T) {
 		}
 
 		token := w.Result().Header.Get(auth.OutgoingCookie)
-		auth.Add(token, user.id)
+		if err := auth.Add(db, token, user.id); err != nil {
+			t.Fatal(err)
+		}
 
 		if user.metadata {
 			meta := metadata.MD{}
@@ -137,7 +139,7 @@ func TestGetUsers(t *testing.T) {
 	admin := qtest.CreateFakeUser(t, db, 1)
 	user2 := qtest.CreateFakeUser(t, db, 2)
 	ctx := withUserContext(context.Background(), user2)
-	_, err = ags.GetUsers(ctx, &pb.Void{})
+	_, err = callWithAccessControl(ctx, ags, "GetUsers", &pb.Void{})
 	if err == nil {
 		t.Fatal("expected 'rpc error: code = PermissionDenied desc = only admin can access other users'")
 	}
@@ -435,7 +437,7 @@ func TestUpdateUserFailures(t *testing.T) {
 		AvatarURL: "www.hello.com",
 	}
 	// current user u (non-admin) is in the ctx and tries to change adminUser
-	_, err := ags.UpdateUser(ctx, nameChangeRequest)
+	_, err := callWithAccessControl(ctx, ags, "UpdateUser", nameChangeRequest)
 	if err == nil {
 		t.Fatal(err)
 	}
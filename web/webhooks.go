@@ -0,0 +1,76 @@
+package web
+
+import (
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// webhookLogSize is the number of recent deliveries shown in a webhook's delivery log.
+const webhookLogSize = 100
+
+// updateWebhook creates or updates the given webhook for the webhook's course.
+// The secret of an existing webhook is kept unless a new secret is given.
+// The returned webhook does not reveal the secret.
+func (s *AutograderService) updateWebhook(webhook *pb.Webhook) (*pb.Webhook, error) {
+	if webhook.GetID() > 0 {
+		existing, err := s.getCourseWebhook(webhook.GetCourseID(), webhook.GetID())
+		if err != nil {
+			return nil, err
+		}
+		if webhook.GetSecret() == "" {
+			webhook.Secret = existing.GetSecret()
+		}
+	}
+	if err := s.db.UpdateWebhook(webhook); err != nil {
+		return nil, err
+	}
+	webhook.Secret = ""
+	return webhook, nil
+}
+
+// getWebhooks returns the webhooks of the given course, without their secrets.
+func (s *AutograderService) getWebhooks(courseID uint64) (*pb.Webhooks, error) {
+	webhooks, err := s.db.GetWebhooks(&pb.Webhook{CourseID: courseID})
+	if err != nil {
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+	return &pb.Webhooks{Webhooks: webhooks}, nil
+}
+
+// deleteWebhook removes the given webhook from its course.
+func (s *AutograderService) deleteWebhook(request *pb.WebhookRequest) error {
+	webhook, err := s.getCourseWebhook(request.GetCourseID(), request.GetWebhookID())
+	if err != nil {
+		return err
+	}
+	return s.db.DeleteWebhook(webhook.GetID())
+}
+
+// getWebhookDeliveries returns the most recent deliveries to the given webhook, newest first.
+func (s *AutograderService) getWebhookDeliveries(request *pb.WebhookRequest) (*pb.WebhookDeliveries, error) {
+	webhook, err := s.getCourseWebhook(request.GetCourseID(), request.GetWebhookID())
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := s.db.GetWebhookDeliveries(webhook.GetID(), webhookLogSize)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WebhookDeliveries{Deliveries: deliveries}, nil
+}
+
+// getCourseWebhook returns the webhook with the given ID, if it belongs to the given course.
+func (s *AutograderService) getCourseWebhook(courseID, webhookID uint64) (*pb.Webhook, error) {
+	webhook, err := s.db.GetWebhook(webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.GetCourseID() != courseID {
+		return nil, fmt.Errorf("webhook %d does not belong to course %d", webhookID, courseID)
+	}
+	return webhook, nil
+}
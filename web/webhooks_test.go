@@ -0,0 +1,135 @@
+package web_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWebhooks(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{
+		Name:            "Operating Systems",
+		Code:            "DAT320",
+		Provider:        "fake",
+		OrganizationID:  1,
+		CourseCreatorID: teacher.ID,
+	}
+	if err := db.CreateCourse(teacher.ID, course); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+
+	var mu sync.Mutex
+	var events []string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(notify.SignatureHeader) != notify.Sign("hush", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		events = append(events, r.Header.Get(notify.EventHeader))
+		mu.Unlock()
+	}))
+	defer receiver.Close()
+	// the stand-in listens on the loopback address
+	pb.AllowWebhookHosts("127.0.0.1")
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	webhook := &pb.Webhook{CourseID: course.ID, URL: receiver.URL, Secret: "hush", EnrollmentChanged: true, Active: true}
+	if _, err := callWithAccessControl(studentCtx, ags, "UpdateWebhook", webhook); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateWebhook() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	webhook, err := ags.UpdateWebhook(teacherCtx, webhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.GetSecret() != "" {
+		t.Error("UpdateWebhook() revealed the webhook's secret")
+	}
+	// updating the webhook without a secret keeps the existing secret
+	webhook.GroupChanged = true
+	if _, err := ags.UpdateWebhook(teacherCtx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	webhooks, err := ags.GetWebhooks(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks.GetWebhooks()) != 1 || !webhooks.GetWebhooks()[0].GetGroupChanged() || webhooks.GetWebhooks()[0].GetSecret() != "" {
+		t.Fatalf("GetWebhooks() = %v, want the updated webhook without its secret", webhooks.GetWebhooks())
+	}
+
+	if _, err := ags.CreateEnrollment(studentCtx, &pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	request := &pb.WebhookRequest{CourseID: course.ID, WebhookID: webhook.ID}
+	if _, err := callWithAccessControl(studentCtx, ags, "GetWebhookDeliveries", request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetWebhookDeliveries() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	var deliveries *pb.WebhookDeliveries
+	for i := 0; i < 100; i++ {
+		if deliveries, err = ags.GetWebhookDeliveries(teacherCtx, request); err != nil {
+			t.Fatal(err)
+		}
+		if len(deliveries.GetDeliveries()) == 1 && deliveries.GetDeliveries()[0].GetAttempts() > 0 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if len(deliveries.GetDeliveries()) != 1 || !deliveries.GetDeliveries()[0].GetDelivered() {
+		t.Fatalf("GetWebhookDeliveries() = %v, want a delivered enrollment event", deliveries.GetDeliveries())
+	}
+	mu.Lock()
+	if len(events) != 1 || events[0] != notify.EnrollmentChanged {
+		t.Errorf("received events %v, want %v", events, []string{notify.EnrollmentChanged})
+	}
+	mu.Unlock()
+
+	// webhooks cannot be accessed through another course
+	other := &pb.Course{Name: "Other", Code: "DAT100", Provider: "fake", OrganizationID: 2, CourseCreatorID: teacher.ID}
+	if err := db.CreateCourse(teacher.ID, other); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.GetWebhookDeliveries(teacherCtx, &pb.WebhookRequest{CourseID: other.ID, WebhookID: webhook.ID}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetWebhookDeliveries() through another course: got error %v, want %v", err, codes.NotFound)
+	}
+	if _, err := ags.DeleteWebhook(teacherCtx, &pb.WebhookRequest{CourseID: other.ID, WebhookID: webhook.ID}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteWebhook() through another course: got error %v, want %v", err, codes.NotFound)
+	}
+
+	if _, err := callWithAccessControl(studentCtx, ags, "DeleteWebhook", request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteWebhook() by student: got error %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.DeleteWebhook(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	webhooks, err = ags.GetWebhooks(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks.GetWebhooks()) != 0 {
+		t.Errorf("GetWebhooks() after DeleteWebhook() returned %d webhooks, want %d", len(webhooks.GetWebhooks()), 0)
+	}
+}
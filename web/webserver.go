@@ -28,19 +28,35 @@ var (
 	idleTimeout  = 5 * time.Minute
 )
 
-// New starts a new web server
-func New(ags *AutograderService, public, httpAddr string) {
+// New starts a new web server. Session cookies are signed with the given
+// comma-separated session keys, newest first; see auth.SessionKeys.
+func New(ags *AutograderService, public, httpAddr, sessionKeys string) {
 	entryPoint := filepath.Join(public, "index.html")
 	if _, err := os.Stat(entryPoint); os.IsNotExist(err) {
 		ags.logger.Fatalf("file not found %s", entryPoint)
 	}
 
-	store := newStore([]byte("secret"))
+	keyPairs, err := auth.SessionKeys(sessionKeys)
+	if err != nil {
+		ags.logger.Fatalf("invalid session keys: %v", err)
+	}
+	if len(keyPairs) == 0 {
+		ags.logger.Warn("no session keys configured; using a random key, so users must log in again after restarts")
+		key, err := auth.NewSessionKey()
+		if err != nil {
+			ags.logger.Fatalf("failed to create session key: %v", err)
+		}
+		keyPairs = append(keyPairs, key, nil)
+	}
+	store := newStore(keyPairs...)
 	gothic.Store = store
+	// users may be redirected to QuickFeed's own URLs after login and logout
+	auth.AllowRedirectHosts(ags.bh.BaseURL)
 	e := newServer(ags, store)
 
 	enabled := enableProviders(ags.logger, ags.bh.BaseURL)
 	registerWebhooks(ags, e, enabled)
+	e.POST("/chat/:id/command", ags.ChatCommand)
 	registerAuth(ags, e)
 
 	registerFrontend(e, entryPoint, public)
@@ -62,6 +78,7 @@ func newServer(ags *AutograderService, store sessions.Store) *echo.Echo {
 
 func newStore(keyPairs ...[]byte) sessions.Store {
 	store := sessions.NewCookieStore(keyPairs...)
+	store.MaxAge(int(auth.SessionMaxAge.Seconds()))
 	store.Options.HttpOnly = true
 	store.Options.Secure = true
 	return store
@@ -105,7 +122,7 @@ func enableProviders(l *zap.SugaredLogger, baseURL string) map[string]bool {
 
 func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]bool) {
 	if enabled["github"] {
-		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.notifier, ags.scms, ags.bh.Secret, ags.bh.BaseURL)
 		e.POST("/hook/github/events", func(c echo.Context) error {
 			ghHook.Handle(c.Response(), c.Request())
 			return nil
@@ -113,7 +130,7 @@ func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]b
 	}
 	if enabled["gitlab"] {
 		// TODO(meling) fix gitlab
-		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
+		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.notifier, ags.scms, ags.bh.Secret, ags.bh.BaseURL)
 		e.POST("/hook/gitlab/events", func(c echo.Context) error {
 			glHook.Handle(c.Response(), c.Request())
 			return nil
@@ -137,7 +154,7 @@ func registerAuth(ags *AutograderService, e *echo.Echo) {
 	oauth2 := e.Group("/auth/:provider", withProvider, auth.PreAuth(logger, ags.db))
 	oauth2.GET("", auth.OAuth2Login(logger, ags.db))
 	oauth2.GET("/callback", auth.OAuth2Callback(logger, ags.db))
-	e.GET("/logout", auth.OAuth2Logout(logger))
+	e.GET("/logout", auth.OAuth2Logout(logger, ags.db))
 }
 
 func registerFrontend(e *echo.Echo, entryPoint, public string) {